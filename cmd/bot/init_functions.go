@@ -0,0 +1,49 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"LinkTracker/internal/application"
+	"LinkTracker/internal/application/bot"
+	"LinkTracker/internal/infrastructure/clients"
+	"LinkTracker/internal/infrastructure/httpapi/health"
+	"LinkTracker/internal/infrastructure/server"
+	"LinkTracker/internal/infrastructure/serviceauth"
+)
+
+// InitScrapperClient создаёт клиент scrapper с учётными данными сервиса и возвращает TLS-конфигурацию сервера bot;
+// она равна nil, если взаимный TLS выключен.
+func InitScrapperClient(config *application.Config) (*clients.ScrapperHTTPClient, *tls.Config, error) {
+	credentials, serverTLS, err := serviceauth.Setup(config.ServiceAuth.Token,
+		config.ServiceAuth.CertFile, config.ServiceAuth.KeyFile, config.ServiceAuth.CAFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not set up service auth: %w", err)
+	}
+
+	scrapperHTTPClient, err := clients.NewScrapperHTTPClient(config.BotConfig.ScrapperBaseURL,
+		config.BotConfig.ScrapperClientTimeout, credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return scrapperHTTPClient, serverTLS, nil
+}
+
+// InitServer собирает HTTP-сервер bot. Все маршруты, кроме служебных, доступны только scrapper.
+func InitServer(b *bot.Bot, scrapperHTTPClient *clients.ScrapperHTTPClient, tgClient *clients.TelegramHTTPClient,
+	config *application.Config, serverTLS *tls.Config) *http.Server {
+	routing := server.InitBotRouting(b, []health.Component{
+		{Name: "scrapper", Checker: health.CheckerFunc(scrapperHTTPClient.Ping)},
+		{Name: "telegram", Checker: health.CheckerFunc(tgClient.Ping)},
+	})
+
+	return server.InitServer(
+		config.BotConfig.Address,
+		serviceauth.Middleware(config.ServiceAuth.Token, serverTLS != nil, routing),
+		config.BotConfig.ReadTimeout,
+		config.BotConfig.WriteTimeout,
+		serverTLS,
+	)
+}
@@ -7,11 +7,14 @@ import (
 	"log/slog"
 	"net/http"
 	"sync"
+	_ "time/tzdata" // часовые пояса пользователей не зависят от наличия tzdata в образе
 
 	"LinkTracker/internal/application"
 	"LinkTracker/internal/application/bot"
+	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/clients"
 	"LinkTracker/internal/infrastructure/server"
+	"LinkTracker/internal/tracing"
 )
 
 func main() {
@@ -24,7 +27,19 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	scrapperHTTPClient, err := clients.NewScrapperHTTPClient(config.BotConfig.ScrapperBaseURL, config.BotConfig.ScrapperClientTimeout)
+	shutdownTracing, err := tracing.Setup(ctx, "bot", config.TracingConfig.OTLPEndpoint, config.TracingConfig.SampleRatio)
+	if err != nil {
+		fmt.Printf("Error setting up tracing: %v\n", err)
+		return
+	}
+
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("tracing shutdown failed", "error", err)
+		}
+	}()
+
+	scrapperHTTPClient, serverTLS, err := InitScrapperClient(config)
 	if err != nil {
 		fmt.Printf("Error creating scrapper client: %v\n", err)
 		return
@@ -36,11 +51,11 @@ func main() {
 		return
 	}
 
-	Bot := bot.NewBot(scrapperHTTPClient, tgClient)
-	serv := server.InitServer(config.BotConfig.Address,
-		server.InitBotRouting(Bot),
-		config.BotConfig.ReadTimeout,
-		config.BotConfig.WriteTimeout)
+	Bot := bot.NewBot(scrapperHTTPClient, tgClient, domain.MessageFormat{
+		ParseMode:          domain.ParseMode(config.BotConfig.NotificationParseMode),
+		DisableLinkPreview: config.BotConfig.DisableLinkPreview,
+	}, config.BotConfig.GroupAdminOnly)
+	serv := InitServer(Bot, scrapperHTTPClient, tgClient, config, serverTLS)
 
 	wg := &sync.WaitGroup{}
 
@@ -58,7 +73,7 @@ func main() {
 		Bot.Run(ctx)
 	}()
 
-	if err := serv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	if err := server.ListenAndServe(serv); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		slog.Error("server failed to start or finished with error", "error", err)
 	} else {
 		slog.Info("Server stopped gracefully")
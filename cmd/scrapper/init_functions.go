@@ -2,15 +2,25 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"sync"
 
 	"LinkTracker/internal/application"
 	"LinkTracker/internal/application/scrapper"
 	"LinkTracker/internal/application/scrapper/linkchecker"
+	"LinkTracker/internal/application/scrapper/notifier"
 	"LinkTracker/internal/infrastructure/clients"
+	"LinkTracker/internal/infrastructure/httpapi/health"
 	"LinkTracker/internal/infrastructure/repository/postgresql/goqurepo"
 	pgxrepo "LinkTracker/internal/infrastructure/repository/postgresql/pgx_repo"
+	"LinkTracker/internal/infrastructure/server"
+	"LinkTracker/internal/infrastructure/serviceauth"
+
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 func InitLinksSourceHandlers() []linkchecker.LinkSourceHandler {
@@ -20,8 +30,97 @@ func InitLinksSourceHandlers() []linkchecker.LinkSourceHandler {
 	}
 }
 
-func InitRepositories(ctx context.Context, dbConfig application.DBConfig, accessType string) (
-	scrapper.UserRepo, scrapper.LinkRepo, scrapper.StateRepo, error) {
+// InitScrapper собирает scrapper: репозитории поверх pool, проверку ссылок и уведомления через bot.
+func InitScrapper(pool *pgxpool.Pool, botHTTPClient *clients.BotHTTPClient, config *application.ScrapperConfig) *scrapper.Scrapper {
+	userRepo, linkRepo, stateManager, deliveryRepo, updateRepo := InitRepositories(pool, config.DBAccessType)
+
+	linkChecker := linkchecker.NewLinkChecker(linkRepo, InitLinksSourceHandlers(),
+		config.SizeLinksPage,
+		config.CheckLinksWorkers,
+		config.ProbeLinksOnAdd,
+		config.FailuresToWarn,
+	)
+
+	return scrapper.NewScrapper(userRepo, linkRepo, stateManager, deliveryRepo, updateRepo,
+		config.Interval,
+		config.StateTTL,
+		notifier.NewHTTPNotifier(botHTTPClient),
+		linkChecker,
+	)
+}
+
+// InitBotClient создаёт клиент bot с учётными данными сервиса и возвращает TLS-конфигурацию сервера scrapper;
+// она равна nil, если взаимный TLS выключен.
+func InitBotClient(config *application.Config) (*clients.BotHTTPClient, *tls.Config, error) {
+	credentials, serverTLS, err := serviceauth.Setup(config.ServiceAuth.Token,
+		config.ServiceAuth.CertFile, config.ServiceAuth.KeyFile, config.ServiceAuth.CAFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not set up service auth: %w", err)
+	}
+
+	botHTTPClient, err := clients.NewBotHTTPClient(config.ScrapConfig.BotBaseURL, config.ScrapConfig.BotClientTimeout, credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return botHTTPClient, serverTLS, nil
+}
+
+// InitServer собирает HTTP-сервер scrapper. Все маршруты, кроме служебных, доступны только bot.
+func InitServer(scrap *scrapper.Scrapper, pool *pgxpool.Pool, botHTTPClient *clients.BotHTTPClient,
+	config *application.Config, serverTLS *tls.Config) *http.Server {
+	routing := server.InitScrapperRouting(scrap, []health.Component{
+		{Name: "postgres", Checker: health.CheckerFunc(pool.Ping)},
+		{Name: "scheduler", Checker: health.CheckerFunc(scrap.CheckScheduler)},
+		{Name: "bot", Checker: health.CheckerFunc(botHTTPClient.Ping)},
+	}, config.ScrapConfig.AdminToken)
+
+	return server.InitServer(
+		config.ScrapConfig.Address,
+		serviceauth.Middleware(config.ServiceAuth.Token, serverTLS != nil, routing),
+		config.ScrapConfig.ReadTimeout,
+		config.ScrapConfig.WriteTimeout,
+		serverTLS,
+	)
+}
+
+// InitPublicServer собирает отдельный сервер публичного API /api/v1 без внутренних маршрутов. Возвращает nil,
+// если PUBLIC_API_ADDRESS не задан.
+func InitPublicServer(scrap *scrapper.Scrapper, config *application.ScrapperConfig) *http.Server {
+	if config.PublicAPIAddress == "" {
+		slog.Info("Public API disabled")
+		return nil
+	}
+
+	return server.InitServer(
+		config.PublicAPIAddress,
+		server.InitPublicAPIRouting(scrap),
+		config.ReadTimeout,
+		config.WriteTimeout,
+		nil,
+	)
+}
+
+// StartPublicServer запускает сервер публичного API в отдельной горутине, если он включён.
+func StartPublicServer(wg *sync.WaitGroup, serv *http.Server) {
+	if serv == nil {
+		return
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		slog.Info("Public API started", "address", serv.Addr)
+
+		if err := serv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Public API server failed", "error", err)
+		}
+	}()
+}
+
+func InitPool(ctx context.Context, dbConfig application.DBConfig) (*pgxpool.Pool, error) {
 	connStr := "postgres://" + dbConfig.PostgresUser +
 		":" + dbConfig.PostgresPassword +
 		"@postgres:5432/" + dbConfig.PostgresDB + "?pool_max_conns=10"
@@ -29,13 +128,20 @@ func InitRepositories(ctx context.Context, dbConfig application.DBConfig, access
 	pool, err := pgxrepo.NewPool(ctx, connStr)
 	if err != nil {
 		fmt.Printf("Error creating pool: %v\n", err)
-		return nil, nil, nil, err
+		return nil, err
 	}
 
+	return pool, nil
+}
+
+func InitRepositories(pool *pgxpool.Pool, accessType string) (
+	scrapper.UserRepo, scrapper.LinkRepo, scrapper.StateRepo, scrapper.DeliveryRepo, scrapper.UpdateRepo) {
 	var (
-		userRepo  scrapper.UserRepo
-		linkRepo  scrapper.LinkRepo
-		stateRepo scrapper.StateRepo
+		userRepo     scrapper.UserRepo
+		linkRepo     scrapper.LinkRepo
+		stateRepo    scrapper.StateRepo
+		deliveryRepo scrapper.DeliveryRepo
+		updateRepo   scrapper.UpdateRepo
 	)
 
 	if accessType == "GOQU" {
@@ -44,13 +150,17 @@ func InitRepositories(ctx context.Context, dbConfig application.DBConfig, access
 		userRepo = goqurepo.NewUserRepoGoqu(pool)
 		linkRepo = goqurepo.NewLinkRepoGoqu(pool)
 		stateRepo = goqurepo.NewStateRepoGoqu(pool)
+		deliveryRepo = goqurepo.NewDeliveryRepoGoqu(pool)
+		updateRepo = goqurepo.NewUpdateRepoGoqu(pool)
 
-		return userRepo, linkRepo, stateRepo, nil
+		return userRepo, linkRepo, stateRepo, deliveryRepo, updateRepo
 	}
 
 	userRepo = pgxrepo.NewUserRepo(pool)
 	linkRepo = pgxrepo.NewLinkRepo(pool)
 	stateRepo = pgxrepo.NewStateRepoPgx(pool)
+	deliveryRepo = pgxrepo.NewDeliveryRepoPgx(pool)
+	updateRepo = pgxrepo.NewUpdateRepoPgx(pool)
 
-	return userRepo, linkRepo, stateRepo, nil
+	return userRepo, linkRepo, stateRepo, deliveryRepo, updateRepo
 }
@@ -6,17 +6,15 @@ import (
 	"log/slog"
 	"net/http"
 	"sync"
+	_ "time/tzdata" // часовые пояса пользователей не зависят от наличия tzdata в образе
 
 	"LinkTracker/internal/application"
-	"LinkTracker/internal/application/scrapper"
-	"LinkTracker/internal/application/scrapper/linkchecker"
-	"LinkTracker/internal/application/scrapper/notifier"
-	"LinkTracker/internal/infrastructure/clients"
 	"LinkTracker/internal/infrastructure/server"
+	"LinkTracker/internal/tracing"
 )
 
 func main() {
-	config, err := application.ReadYAMLConfig()
+	config, err := application.ReadScrapperConfig()
 	if err != nil {
 		slog.Error("Error reading config", "error", err)
 		return
@@ -25,38 +23,35 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	userRepo, linkRepo, stateManager, err := InitRepositories(ctx, config.DBConfig, config.ScrapConfig.DBAccessType)
+	shutdownTracing, err := tracing.Setup(ctx, "scrapper", config.TracingConfig.OTLPEndpoint, config.TracingConfig.SampleRatio)
 	if err != nil {
-		slog.Error("Error initializing repositories", "error", err)
+		slog.Error("Error setting up tracing", "error", err)
 		return
 	}
 
-	botHTTPClient, err := clients.NewBotHTTPClient(config.ScrapConfig.BotBaseURL, config.ScrapConfig.BotClientTimeout)
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("Tracing shutdown failed", "error", err)
+		}
+	}()
+
+	pool, err := InitPool(ctx, config.DBConfig)
 	if err != nil {
-		slog.Error("Error creating bot client", "error", err)
+		slog.Error("Error creating database pool", "error", err)
 		return
 	}
 
-	linkSourceHandlers := InitLinksSourceHandlers()
-	linkChecker := linkchecker.NewLinkChecker(linkRepo, linkSourceHandlers,
-		config.ScrapConfig.SizeLinksPage,
-		config.ScrapConfig.CheckLinksWorkers,
-	)
-
-	messageNotifier := notifier.NewHTTPNotifier(botHTTPClient)
+	defer pool.Close()
 
-	scrap := scrapper.NewScrapper(userRepo, linkRepo, stateManager,
-		config.ScrapConfig.Interval,
-		messageNotifier,
-		linkChecker,
-	)
+	botHTTPClient, serverTLS, err := InitBotClient(config)
+	if err != nil {
+		slog.Error("Error creating bot client", "error", err)
+		return
+	}
 
-	serv := server.InitServer(
-		config.ScrapConfig.Address,
-		server.InitScrapperRouting(scrap),
-		config.ScrapConfig.ReadTimeout,
-		config.ScrapConfig.WriteTimeout,
-	)
+	scrap := InitScrapper(pool, botHTTPClient, &config.ScrapConfig)
+	serv := InitServer(scrap, pool, botHTTPClient, config, serverTLS)
+	publicServ := InitPublicServer(scrap, &config.ScrapConfig)
 
 	var wg sync.WaitGroup
 
@@ -64,9 +59,11 @@ func main() {
 
 	go func() {
 		defer wg.Done()
-		application.StopScrapperSignalReceiving(ctx, cancel, serv)
+		application.StopScrapperSignalReceiving(ctx, cancel, serv, publicServ)
 	}()
 
+	StartPublicServer(&wg, publicServ)
+
 	wg.Add(1)
 
 	go func() {
@@ -77,7 +74,7 @@ func main() {
 		}
 	}()
 
-	if err := serv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	if err := server.ListenAndServe(serv); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		slog.Error("Server failed to start or finished with error", "error", err)
 	} else {
 		slog.Info("Server stopped gracefully")
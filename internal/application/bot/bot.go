@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"net/url"
 	"strings"
 	"sync"
+	"time"
 	"unicode/utf8"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -20,51 +23,92 @@ const (
 	WaitingDelete
 	WaitingSetTagsWaitingLink
 	WaitingSetTagsWaitingTags
+	WaitingImport
 )
 
-const errorText = "Не удалось выполнить операцию"
+const (
+	errorText           = "Не удалось выполнить операцию"
+	unsupportedLinkText = "Поддерживается только gitHub(https://github.com/{owner}/{repo}) и " +
+		"stackOverflow(https://stackoverflow.com/questions/{id}). Повторите команду /track"
+	sourceNotFoundText = "Репозиторий или вопрос по этой ссылке не найден. Проверьте адрес и повторите команду /track"
+	setTagsPromptText  = "Отправьте новые теги разделённые пробелами. Если не хотите добавлять теги отправьте '-' без кавычек"
+)
 
 type StateManager interface {
-	CreateState(ctx context.Context, tgID int64, state int) error
+	CreateState(ctx context.Context, tgID, userID int64, state int) error
 	DeleteState(ctx context.Context, tgID int64) error
-	GetState(ctx context.Context, tgID int64) (state int, link domain.Link, err error)
+	GetState(ctx context.Context, tgID int64) (state int, userID int64, link domain.Link, err error)
 	UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error
 }
 
 type ScrapperClient interface {
 	RegisterUser(ctx context.Context, tgID int64) error
 	DeleteUser(ctx context.Context, tgID int64) error
+	IssueToken(ctx context.Context, tgID int64) (string, error)
 	AddLink(ctx context.Context, tgID int64, link *domain.Link) error
+	AddLinks(ctx context.Context, tgID int64, links []domain.Link) ([]domain.LinkImportResult, error)
 	GetLinks(ctx context.Context, tgID int64) ([]domain.Link, error)
+	GetLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error)
 	RemoveLink(ctx context.Context, tgID int64, link *domain.Link) error
 	UpdateLink(ctx context.Context, tgID int64, link *domain.Link) error
+	GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error)
+	UpdateSettings(ctx context.Context, tgID int64, update *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error)
+	SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error
+	PauseLinks(ctx context.Context, tgID int64, selector domain.LinkSelector, until time.Time) ([]domain.Link, error)
+	ResumeLinks(ctx context.Context, tgID int64, selector domain.LinkSelector) ([]domain.Link, error)
+	GetTags(ctx context.Context, tgID int64) ([]domain.TagCount, error)
+	RenameTag(ctx context.Context, tgID int64, oldTag, newTag string) (int64, error)
+	RemoveLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error)
+	GetLinkUpdates(ctx context.Context, tgID, linkID, limit, offset int64) ([]domain.UpdateRecord, int64, error)
+	SearchLinks(ctx context.Context, tgID int64, query string, limit int64) ([]domain.SearchResult, error)
 	StateManager
 }
 
 type TelegramClient interface {
 	SendMessage(ctx context.Context, tgID int64, text string)
+	SendFormattedMessage(ctx context.Context, tgID int64, text string, format domain.MessageFormat)
+	SendMessageWithKeyboard(ctx context.Context, tgID int64, text string, keyboard domain.InlineKeyboard)
+	EditMessageKeyboard(ctx context.Context, tgID int64, messageID int, keyboard domain.InlineKeyboard)
+	EditMessage(ctx context.Context, tgID int64, messageID int, text string, keyboard domain.InlineKeyboard)
+	AnswerCallback(ctx context.Context, callbackID, text string)
+	SendDocument(ctx context.Context, tgID int64, fileName string, data []byte)
+	DownloadFile(ctx context.Context, fileID string) ([]byte, error)
+	BotUsername() string
+	IsChatAdmin(ctx context.Context, chatID, userID int64) (bool, error)
 	ReceiveMessage(messageCh chan domain.Message)
 	StopReceiveMessage()
 }
 
 type Bot struct {
-	scrapper ScrapperClient
-	tgAPI    TelegramClient
+	scrapper           ScrapperClient
+	tgAPI              TelegramClient
+	notificationFormat domain.MessageFormat
+	groupAdminOnly     bool
 }
 
-func NewBot(scrapperClient ScrapperClient, tgAPI TelegramClient) *Bot {
-	slog.Info("Bot create")
+func NewBot(scrapperClient ScrapperClient, tgAPI TelegramClient, notificationFormat domain.MessageFormat,
+	groupAdminOnly bool) *Bot {
+	if _, ok := markups[notificationFormat.ParseMode]; !ok {
+		slog.Warn("Unknown notification parse mode, plain text is used", "parseMode", notificationFormat.ParseMode)
+		notificationFormat.ParseMode = domain.ParseModePlain
+	}
+
+	slog.Info("Bot create", "parseMode", notificationFormat.ParseMode,
+		"disableLinkPreview", notificationFormat.DisableLinkPreview, "groupAdminOnly", groupAdminOnly)
 
 	return &Bot{
-		scrapper: scrapperClient,
-		tgAPI:    tgAPI,
+		scrapper:           scrapperClient,
+		tgAPI:              tgAPI,
+		notificationFormat: notificationFormat,
+		groupAdminOnly:     groupAdminOnly,
 	}
 }
 
-func (bot *Bot) UpdateSend(ctx context.Context, tgIDs []int64, linkURL, description string) {
-	for _, tgID := range tgIDs {
-		message := fmt.Sprintf("Было обновление: %s\n%s", linkURL, description)
-		bot.tgAPI.SendMessage(ctx, tgID, message)
+func (bot *Bot) UpdateSend(ctx context.Context, update *domain.LinkUpdate) {
+	message := formatUpdate(update, bot.notificationFormat.ParseMode)
+
+	for _, tgID := range update.TgIDs {
+		bot.tgAPI.SendFormattedMessage(ctx, tgID, message, bot.notificationFormat)
 	}
 }
 
@@ -84,10 +128,7 @@ func (bot *Bot) Run(ctx context.Context) {
 			defer wg.Done()
 
 			for msg := range jobs[workerID] {
-				responseText := bot.HandleMessage(ctx, msg.TgID, msg.Text)
-				if responseText != "" {
-					bot.tgAPI.SendMessage(ctx, msg.TgID, responseText)
-				}
+				bot.handleIncoming(ctx, &msg)
 			}
 		}(i)
 	}
@@ -102,7 +143,12 @@ func (bot *Bot) Run(ctx context.Context) {
 		defer wg.Done()
 
 		for message := range messageChannel {
+			// У групп и каналов отрицательные идентификаторы
 			numWorker := message.TgID % int64(workerCount)
+			if numWorker < 0 {
+				numWorker = -numWorker
+			}
+
 			jobs[numWorker] <- message
 		}
 	}()
@@ -120,28 +166,94 @@ func (bot *Bot) Run(ctx context.Context) {
 	slog.Info("Bot shutdown")
 }
 
+// handleIncoming обрабатывает сообщение или нажатие кнопки в отдельном трейсе, в который попадают
+// запросы к scrapper и Telegram.
+func (bot *Bot) handleIncoming(ctx context.Context, msg *domain.Message) {
+	ctx, span := tracing.Start(ctx, "bot.handle_message", attribute.Bool("callback", msg.IsCallback()))
+	defer span.End()
+
+	if msg.IsCallback() {
+		bot.HandleCallback(ctx, msg)
+		return
+	}
+
+	bot.sendResponse(ctx, msg.TgID, bot.HandleChatMessage(ctx, msg))
+}
+
+// HandleMessage обрабатывает сообщение из личного чата, где идентификатор пользователя совпадает
+// с идентификатором чата.
 func (bot *Bot) HandleMessage(ctx context.Context, id int64, text string) string {
+	return bot.handleUserMessage(ctx, id, id, text)
+}
+
+// handleUserMessage обрабатывает команду или ответ участника userID в чате tgID. Диалог, начатый
+// командой, закрепляется за этим участником.
+func (bot *Bot) handleUserMessage(ctx context.Context, tgID, userID int64, text string) string {
 	if firstRune, _ := utf8.DecodeRuneInString(text); firstRune == '/' {
-		return bot.handleCommand(ctx, id, text)
+		return bot.handleCommand(ctx, tgID, userID, text)
 	}
 
-	return bot.changeState(ctx, id, text)
+	return bot.changeState(ctx, tgID, text)
 }
 
-func (bot *Bot) handleCommand(ctx context.Context, tgID int64, text string) string {
-	switch text {
+func (bot *Bot) handleCommand(ctx context.Context, tgID, userID int64, text string) string {
+	command, args := splitCommand(text)
+
+	switch command {
 	case "/start":
 		return bot.commandStart(ctx, tgID)
 	case "/help":
 		return bot.commandHelp(tgID)
 	case "/track":
-		return bot.commandTrack(ctx, tgID)
+		if len(args) > 0 {
+			return bot.commandTrackWithArgs(ctx, tgID, args)
+		}
+
+		return bot.commandTrack(ctx, tgID, userID)
 	case "/untrack":
-		return bot.commandUntrack(ctx, tgID)
+		if len(args) > 0 {
+			if tag, ok := parseTagRef(args[0]); ok {
+				return bot.commandUntrackTag(ctx, tgID, tag)
+			}
+
+			return bot.commandUntrackWithArgs(ctx, tgID, args[0])
+		}
+
+		return bot.commandUntrack(ctx, tgID, userID)
 	case "/list":
-		return bot.commandList(ctx, tgID)
+		return bot.commandList(ctx, tgID, args)
+	case "/tags":
+		return bot.commandTags(ctx, tgID)
+	case "/renametag":
+		return bot.commandRenameTag(ctx, tgID, args)
+	case "/history":
+		return bot.commandHistory(ctx, tgID, args)
+	case "/search":
+		return bot.commandSearch(ctx, tgID, args)
 	case "/settags":
-		return bot.commandSetTags(ctx, tgID)
+		if len(args) > 0 {
+			return bot.commandSetTagsWithArgs(ctx, tgID, userID, args[0], args[1:])
+		}
+
+		return bot.commandSetTags(ctx, tgID, userID)
+	case "/import":
+		return bot.commandImport(ctx, tgID, userID, text)
+	case "/export":
+		return bot.commandExport(ctx, tgID, args)
+	case "/mode":
+		return bot.commandMode(ctx, tgID, args)
+	case "/timezone":
+		return bot.commandTimezone(ctx, tgID, args)
+	case "/quiet":
+		return bot.commandQuiet(ctx, tgID, args)
+	case "/pause":
+		return bot.commandPause(ctx, tgID, args)
+	case "/resume":
+		return bot.commandResume(ctx, tgID, args)
+	case "/token":
+		return bot.commandToken(ctx, tgID)
+	case "/cancel":
+		return bot.commandCancel(ctx, tgID)
 	default:
 		responseText := "Команда не распознана. Введите /help , чтобы увидеть список доступных команд"
 		return responseText
@@ -149,7 +261,7 @@ func (bot *Bot) handleCommand(ctx context.Context, tgID int64, text string) stri
 }
 
 func (bot *Bot) changeState(ctx context.Context, tgID int64, text string) string {
-	state, link, err := bot.scrapper.GetState(ctx, tgID)
+	state, _, link, err := bot.scrapper.GetState(ctx, tgID)
 	if err != nil {
 		return ""
 	}
@@ -167,6 +279,8 @@ func (bot *Bot) changeState(ctx context.Context, tgID int64, text string) string
 		return bot.stateSetTagsWaitingLink(ctx, tgID, text)
 	case WaitingSetTagsWaitingTags:
 		return bot.stateSetTagsWaitingTags(ctx, tgID, text, &link)
+	case WaitingImport:
+		return bot.stateWaitImport(ctx, tgID, text)
 	default:
 		return ""
 	}
@@ -196,13 +310,37 @@ func (bot *Bot) commandHelp(id int64) string {
 		"/help - Помощь по командам\n" +
 		"/track - Начать отслеживание ссылки\n" +
 		"/untrack - Прекратить отслеживание\n" +
-		"/list - Список отслеживаемых ссылок"
+		"/settags - Изменить теги у ссылки\n" +
+		"/list - Список отслеживаемых ссылок\n" +
+		"/tags - Список тегов с количеством ссылок\n" +
+		"/renametag - Переименовать тег\n" +
+		"/history - История обновлений ссылки\n" +
+		"/search - Поиск по ссылкам, тегам и истории обновлений\n" +
+		"/import - Импортировать ссылки списком или файлом (txt, csv, opml)\n" +
+		"/export - Выгрузить ссылки в файл (/export txt - в текстовом формате)\n" +
+		"/mode - Режим доставки уведомлений: сразу или сводкой раз в час/день\n" +
+		"/timezone - Часовой пояс для ежедневной сводки\n" +
+		"/quiet - Тихие часы, когда уведомления копятся и приходят одним сообщением\n" +
+		"/pause - Приостановить уведомления, не удаляя ссылки\n" +
+		"/resume - Возобновить уведомления\n" +
+		"/token - Выпустить токен для API /api/v1 (только в личном чате)\n" +
+		"/cancel - Отменить текущее действие\n\n" +
+		"Команды можно вызывать сразу с аргументами:\n" +
+		"/track <ссылка> tags:тег1,тег2 filter:фильтр1,фильтр2\n" +
+		"/untrack <ссылка, linkID или #тег>\n" +
+		"/settags <ссылка или linkID> тег1 тег2\n" +
+		"/list [тег] [страница] [sort:recent|url|tag]\n" +
+		"/renametag <старый тег> <новый тег>\n" +
+		"/history <ссылка или linkID> [количество]\n" +
+		"/search <запрос>\n" +
+		"/pause [ссылка, linkID или тег] [30m, 12h, 3d, 2w]\n" +
+		"/resume [ссылка, linkID или тег]"
 
 	return responseText
 }
 
-func (bot *Bot) commandTrack(ctx context.Context, tgID int64) string {
-	err := bot.scrapper.CreateState(ctx, tgID, WaitingLink)
+func (bot *Bot) commandTrack(ctx context.Context, tgID, userID int64) string {
+	err := bot.scrapper.CreateState(ctx, tgID, userID, WaitingLink)
 	if err != nil {
 		slog.Error("Command /track failed", "error", err, "chatId", tgID)
 		return errorText
@@ -215,48 +353,105 @@ func (bot *Bot) commandTrack(ctx context.Context, tgID int64) string {
 	return responseText
 }
 
-func (bot *Bot) commandUntrack(ctx context.Context, tgID int64) string {
+func (bot *Bot) commandUntrack(ctx context.Context, tgID, userID int64) string {
 	slog.Info("Command /untrack execution", "chatId", tgID)
 
-	err := bot.scrapper.CreateState(ctx, tgID, WaitingDelete)
+	err := bot.scrapper.CreateState(ctx, tgID, userID, WaitingDelete)
 	if err != nil {
 		return errorText
 	}
 
+	if bot.sendLinksKeyboard(ctx, tgID, "Выберите ссылку для удаления или введите её адрес", callbackUntrack) {
+		return ""
+	}
+
 	responseText := "Введите адрес ссылки для удаления"
 
 	return responseText
 }
 
-func (bot *Bot) commandSetTags(ctx context.Context, tgID int64) string {
+func (bot *Bot) commandSetTags(ctx context.Context, tgID, userID int64) string {
 	slog.Info("Command /settags execution", "chatId", tgID)
 
-	err := bot.scrapper.CreateState(ctx, tgID, WaitingSetTagsWaitingLink)
+	err := bot.scrapper.CreateState(ctx, tgID, userID, WaitingSetTagsWaitingLink)
 	if err != nil {
 		return errorText
 	}
 
+	if bot.sendLinksKeyboard(ctx, tgID, "Выберите ссылку, для которой хотите изменить тег/теги, "+
+		"или введите её адрес", callbackSetTags) {
+		return ""
+	}
+
 	responseText := "Введите ссылку, для которой хотите изменить тег/теги"
 
 	return responseText
 }
 
-func (bot *Bot) commandList(ctx context.Context, tgID int64) string {
-	list, err := bot.scrapper.GetLinks(ctx, tgID)
+func (bot *Bot) commandTrackWithArgs(ctx context.Context, tgID int64, args []string) string {
+	rawURL, tags, filters := parseTrackArgs(args)
+
+	valid, validURL := validateLink(rawURL)
+	if !valid {
+		slog.Info("Command /track rejected link", "chatId", tgID, "link", rawURL)
+		return unsupportedLinkText
+	}
+
+	slog.Info("Command /track with arguments execution", "chatId", tgID)
+
+	return bot.addLink(ctx, tgID, &domain.Link{URL: validURL, Tags: tags, Filters: filters})
+}
+
+func (bot *Bot) commandUntrackWithArgs(ctx context.Context, tgID int64, linkRef string) string {
+	slog.Info("Command /untrack with arguments execution", "chatId", tgID)
+
+	link, err := bot.findLink(ctx, tgID, linkRef)
 	if err != nil {
-		slog.Error("Command /list failed", "error", err.Error(), "chatId", tgID)
+		slog.Error("Command /untrack failed", "error", err.Error(), "chatId", tgID)
+		return errorText + ". Данная ссылка не найдена"
+	}
 
-		return errorText
+	return bot.stateWaitDelete(ctx, tgID, link.URL)
+}
+
+func (bot *Bot) commandSetTagsWithArgs(ctx context.Context, tgID, userID int64, linkRef string, tags []string) string {
+	slog.Info("Command /settags with arguments execution", "chatId", tgID)
+
+	link, err := bot.findLink(ctx, tgID, linkRef)
+	if err != nil {
+		slog.Error("Command /settags failed", "error", err.Error(), "chatId", tgID)
+		return errorText + ". Данная ссылка не найдена"
 	}
 
-	slog.Info("Command /list done", "chatId", tgID)
+	if len(tags) == 0 {
+		err = bot.scrapper.CreateState(ctx, tgID, userID, WaitingSetTagsWaitingTags)
+		if err != nil {
+			slog.Error("Command /settags failed", "error", err.Error(), "chatId", tgID)
+			return errorText
+		}
+
+		err = bot.scrapper.UpdateState(ctx, tgID, WaitingSetTagsWaitingTags, link)
+		if err != nil {
+			slog.Error("Command /settags failed", "error", err.Error(), "chatId", tgID)
+			return errorText
+		}
 
-	if len(list) == 0 {
-		responseText := "Список отслеживаемых ссылок пуст. Добавьте ссылки с помощью /track"
-		return responseText
+		return setTagsPromptText
 	}
 
-	responseText := listLinksToString(list)
+	return bot.stateSetTagsWaitingTags(ctx, tgID, strings.Join(tags, " "), link)
+}
+
+func (bot *Bot) commandCancel(ctx context.Context, tgID int64) string {
+	err := bot.scrapper.DeleteState(ctx, tgID)
+	if err != nil {
+		slog.Error("Command /cancel failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /cancel done", "chatId", tgID)
+
+	responseText := "Текущее действие отменено"
 
 	return responseText
 }
@@ -274,10 +469,7 @@ func (bot *Bot) stateWaitLink(ctx context.Context, tgID int64, text string, link
 
 		slog.Info("stateWaitLink  done", "chatId", tgID)
 
-		responseText := "Поддерживается только gitHub(https://github.com/{owner}/{repo}) и " +
-			"stackOverflow(https://stackoverflow.com/questions/{id}). Повторите команду /track"
-
-		return responseText
+		return unsupportedLinkText
 	}
 
 	link.URL = validURL
@@ -328,39 +520,61 @@ func (bot *Bot) stateWaitFilters(ctx context.Context, tgID int64, text string, l
 		link.Filters = strings.Split(text, " ")
 	}
 
+	return bot.addLink(ctx, tgID, link)
+}
+
+func (bot *Bot) addLink(ctx context.Context, tgID int64, link *domain.Link) string {
 	err := bot.scrapper.AddLink(ctx, tgID, link)
 	if err != nil {
-		if errors.As(err, &domain.ErrAPI{}) && (err.(domain.ErrAPI).ExceptionMessage == domain.ErrLinkAlreadyTracking{}.Error()) {
-			err = bot.scrapper.DeleteState(ctx, tgID)
-			if err != nil {
-				return errorText
-			}
-
-			slog.Info("stateWaitTags  done", "chatId", tgID)
-
-			responseText := "Данная ссылка уже отслеживается"
+		responseText, ok := addLinkErrorText(err)
+		if !ok {
+			slog.Error("addLink failed", "error", err.Error(), "chatId", tgID)
+			return errorText
+		}
 
-			return responseText
+		err = bot.scrapper.DeleteState(ctx, tgID)
+		if err != nil {
+			return errorText
 		}
 
-		slog.Error("stateWaitFilters failed", "error", err.Error(), "chatId", tgID)
+		slog.Info("addLink done", "chatId", tgID)
 
-		return errorText
+		return responseText
 	}
 
 	err = bot.scrapper.DeleteState(ctx, tgID)
 	if err != nil {
-		slog.Error("stateWaitFilters failed", "error", err.Error(), "chatId", tgID)
+		slog.Error("addLink failed", "error", err.Error(), "chatId", tgID)
 		return errorText
 	}
 
-	slog.Info("stateWaitTags  done", "chatId", tgID)
+	slog.Info("addLink done", "chatId", tgID)
 
 	responseText := "Ссылка отслеживается"
 
 	return responseText
 }
 
+// addLinkErrorText возвращает ответ пользователю на ошибку добавления ссылки, которую scrapper
+// отклонил по существу: ссылка уже отслеживается, не поддерживается или источник не найден.
+func addLinkErrorText(err error) (string, bool) {
+	var apiErr domain.ErrAPI
+	if !errors.As(err, &apiErr) {
+		return "", false
+	}
+
+	switch {
+	case apiErr.ExceptionMessage == domain.ErrLinkAlreadyTracking{}.Error():
+		return "Данная ссылка уже отслеживается", true
+	case apiErr.ExceptionName == "UNSUPPORTED_LINK":
+		return unsupportedLinkText, true
+	case apiErr.ExceptionName == "SOURCE_NOT_FOUND":
+		return sourceNotFoundText, true
+	default:
+		return "", false
+	}
+}
+
 func (bot *Bot) stateWaitDelete(ctx context.Context, tgID int64, text string) string {
 	link := text
 
@@ -411,9 +625,7 @@ func (bot *Bot) stateSetTagsWaitingLink(ctx context.Context, tgID int64, text st
 
 	slog.Info("stateSetTagsWaitingLink done", "chatId", tgID)
 
-	responseText := "Отправьте новые теги разделённые пробелами. Если не хотите добавлять теги отправьте '-' без кавычек"
-
-	return responseText
+	return setTagsPromptText
 }
 
 func (bot *Bot) stateSetTagsWaitingTags(ctx context.Context, tgID int64, text string, link *domain.Link) string {
@@ -436,46 +648,22 @@ func (bot *Bot) stateSetTagsWaitingTags(ctx context.Context, tgID int64, text st
 	return responseText
 }
 
+// validateLink проверяет, что ссылка ведёт на репозиторий GitHub или вопрос StackOverflow,
+// и возвращает её канонический вид.
 func validateLink(link string) (valid bool, validURL string) {
-	parsedURL, err := url.Parse(link)
+	validURL, err := domain.CanonicalURL(link)
 	if err != nil {
-		slog.Error("validateLink failed", "error", err.Error(), "link", link)
+		slog.Info("validateLink failed", "error", err.Error(), "link", link)
 		return false, ""
 	}
 
-	const (
-		github        = "github.com"
-		stackoverflow = "stackoverflow.com"
-	)
-
-	parts := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
-
-	if parsedURL.Host == github && len(parts) >= 2 {
-		validURL, err := url.JoinPath(parsedURL.Scheme+"://"+parsedURL.Host, parts[0], parts[1])
-		if err != nil {
-			slog.Error("validateLink failed", "error", err.Error(), "link", link)
-			return false, ""
-		}
-
-		return true, validURL
-	}
-
-	if parsedURL.Host == stackoverflow && len(parts) >= 2 && parts[0] == "questions" {
-		validURL, err := url.JoinPath(parsedURL.Scheme+"://"+parsedURL.Host, parts[0], parts[1])
-		if err != nil {
-			slog.Error("validateLink failed", "error", err.Error(), "link", link)
-			return false, ""
-		}
-
-		return true, validURL
-	}
-
-	return false, ""
+	return true, validURL
 }
 
 func formatLink(link *domain.Link) string {
 	var parts []string
 	parts = append(parts, fmt.Sprintf("linkID: %d Url: %s", link.ID, link.URL))
+	parts = append(parts, formatLinkMetadata(&link.Metadata)...)
 
 	if len(link.Tags) > 0 {
 		parts = append(parts, "Tags: "+strings.Join(link.Tags, " "))
@@ -485,42 +673,64 @@ func formatLink(link *domain.Link) string {
 		parts = append(parts, "Filters: "+strings.Join(link.Filters, " "))
 	}
 
-	return strings.Join(parts, " ")
-}
+	if link.Paused {
+		paused := "⏸ на паузе"
+		if !link.PausedUntil.IsZero() {
+			paused += " до " + link.PausedUntil.UTC().Format(pauseTimeLayout)
+		}
 
-func listLinksToString(links []domain.Link) string {
-	tagsLinks := make(map[string][]domain.Link)
+		parts = append(parts, paused)
+	}
 
-	for _, link := range links {
-		for _, tag := range link.Tags {
-			tagsLinks[tag] = append(tagsLinks[tag], link)
-		}
+	if link.Health.IsFailing() {
+		parts = append(parts, fmt.Sprintf("⚠️ не удаётся проверить, ошибок подряд: %d", link.Health.ConsecutiveFailures))
 	}
 
-	var sb strings.Builder
+	return strings.Join(parts, " ")
+}
 
-	sb.WriteString("Список отслеживаемых ссылок:\n")
+// metadataDescriptionLength — сколько символов описания репозитория показывать в списке ссылок.
+const metadataDescriptionLength = 100
 
-	if len(tagsLinks) > 0 {
-		for tag, taggedlinks := range tagsLinks {
-			sb.WriteString(tag + ": \n")
+var sourceStatusTexts = map[domain.SourceStatus]string{
+	domain.SourceArchived: "📦 в архиве",
+	domain.SourceClosed:   "🔒 вопрос закрыт",
+	domain.SourceDeleted:  "🗑 источник удалён",
+}
 
-			for _, link := range taggedlinks {
-				sb.WriteString(formatLink(&link) + "\n")
-			}
+// formatLinkMetadata описывает источник ссылки: название, описание, число звёзд или ответов и статус,
+// если источник больше не обновляется. У закрытого вопроса описание — причина закрытия.
+func formatLinkMetadata(metadata *domain.LinkMetadata) []string {
+	var parts []string
+
+	if metadata.Title != "" {
+		parts = append(parts, "«"+metadata.Title+"»")
+	}
 
-			sb.WriteString("\n")
+	if metadata.Description != "" && metadata.Status != domain.SourceClosed {
+		description := metadata.Description
+		if runes := []rune(description); len(runes) > metadataDescriptionLength {
+			description = string(runes[:metadataDescriptionLength-1]) + "…"
 		}
+
+		parts = append(parts, description)
 	}
 
-	sb.WriteString("Без тегов: \n")
+	if metadata.Stars > 0 {
+		parts = append(parts, fmt.Sprintf("★ %d", metadata.Stars))
+	}
 
-	for i := range links {
-		if len(links[i].Tags) == 0 {
-			sb.WriteString(formatLink(&links[i]))
-			sb.WriteString("\n")
+	if metadata.Answers > 0 {
+		parts = append(parts, fmt.Sprintf("Ответов: %d", metadata.Answers))
+	}
+
+	if status, ok := sourceStatusTexts[metadata.Status]; ok {
+		if metadata.Status == domain.SourceClosed && metadata.Description != "" {
+			status += " (" + metadata.Description + ")"
 		}
+
+		parts = append(parts, status)
 	}
 
-	return sb.String()
+	return parts
 }
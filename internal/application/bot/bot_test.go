@@ -3,12 +3,16 @@ package bot_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"LinkTracker/internal/application/bot"
 	"LinkTracker/internal/domain"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"LinkTracker/internal/application/bot/mocks"
 )
@@ -20,6 +24,7 @@ const (
 	WaitingDelete
 	WaitingSetTagsWaitingLink
 	WaitingSetTagsWaitingTags
+	WaitingImport
 	commandTrack       = "/track"
 	gitExampleURL      = "https://github.com/example/example"
 	trackGoodResponse1 = "Введите адрес ссылки (поддерживается только gitHub и stackOverFlow)"
@@ -33,7 +38,7 @@ func Test_Bot_HandleMessage_Start_Success(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 	tgID := int64(123)
 	text := "/start"
 
@@ -52,7 +57,7 @@ func Test_Bot_HandleMessage_Start_Error(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 	tgID := int64(123)
 	text := "/start"
 
@@ -69,7 +74,7 @@ func Test_Bot_HandleMessage_Help(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 	tgID := int64(123)
 	text := "/help"
 	expectedText := "📝Доступные команды:\n\n" +
@@ -77,7 +82,31 @@ func Test_Bot_HandleMessage_Help(t *testing.T) {
 		"/help - Помощь по командам\n" +
 		"/track - Начать отслеживание ссылки\n" +
 		"/untrack - Прекратить отслеживание\n" +
-		"/list - Список отслеживаемых ссылок"
+		"/settags - Изменить теги у ссылки\n" +
+		"/list - Список отслеживаемых ссылок\n" +
+		"/tags - Список тегов с количеством ссылок\n" +
+		"/renametag - Переименовать тег\n" +
+		"/history - История обновлений ссылки\n" +
+		"/search - Поиск по ссылкам, тегам и истории обновлений\n" +
+		"/import - Импортировать ссылки списком или файлом (txt, csv, opml)\n" +
+		"/export - Выгрузить ссылки в файл (/export txt - в текстовом формате)\n" +
+		"/mode - Режим доставки уведомлений: сразу или сводкой раз в час/день\n" +
+		"/timezone - Часовой пояс для ежедневной сводки\n" +
+		"/quiet - Тихие часы, когда уведомления копятся и приходят одним сообщением\n" +
+		"/pause - Приостановить уведомления, не удаляя ссылки\n" +
+		"/resume - Возобновить уведомления\n" +
+		"/token - Выпустить токен для API /api/v1 (только в личном чате)\n" +
+		"/cancel - Отменить текущее действие\n\n" +
+		"Команды можно вызывать сразу с аргументами:\n" +
+		"/track <ссылка> tags:тег1,тег2 filter:фильтр1,фильтр2\n" +
+		"/untrack <ссылка, linkID или #тег>\n" +
+		"/settags <ссылка или linkID> тег1 тег2\n" +
+		"/list [тег] [страница] [sort:recent|url|tag]\n" +
+		"/renametag <старый тег> <новый тег>\n" +
+		"/history <ссылка или linkID> [количество]\n" +
+		"/search <запрос>\n" +
+		"/pause [ссылка, linkID или тег] [30m, 12h, 3d, 2w]\n" +
+		"/resume [ссылка, linkID или тег]"
 
 	responseText := Bot.HandleMessage(ctx, tgID, text)
 
@@ -88,7 +117,7 @@ func Test_Bot_HandleMessage_Track(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := commandTrack
@@ -104,12 +133,12 @@ func Test_Bot_HandleMessage_Track(t *testing.T) {
 	linkWithTags := domain.Link{URL: gitExampleURL, Tags: []string{oneTag}}
 	linkWithFilters := domain.Link{URL: gitExampleURL, Tags: []string{oneTag}, Filters: []string{"filter"}}
 
-	scrapper.On("CreateState", ctx, tgID, WaitingLink).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingLink).Return(nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, tgID, emptyLink, nil).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingTags, &linkWithURL).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingTags, linkWithURL, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingTags, tgID, linkWithURL, nil).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingFilters, &linkWithTags).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, linkWithTags, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, tgID, linkWithTags, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	scrapper.On("AddLink", ctx, tgID, &linkWithFilters).Return(nil).Once()
 
@@ -128,7 +157,7 @@ func Test_Bot_HandleMessage_Track_AlreadyExist(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := commandTrack
@@ -155,21 +184,21 @@ func Test_Bot_HandleMessage_Track_AlreadyExist(t *testing.T) {
 	linkWithoutFilters := domain.Link{URL: gitExampleURL, Tags: []string{}, Filters: []string{}}
 	errExpected := domain.ErrAPI{ExceptionMessage: domain.ErrLinkAlreadyTracking{}.Error()}
 
-	scrapper.On("CreateState", ctx, tgID, WaitingLink).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingLink).Return(nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, tgID, emptyLink, nil).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingTags, &linkWithURL).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingTags, linkWithURL, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingTags, tgID, linkWithURL, nil).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingFilters, &linkWithTags).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, linkWithTags, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, tgID, linkWithTags, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	scrapper.On("AddLink", ctx, tgID, &linkWithFilters).Return(nil).Once()
 
-	scrapper.On("CreateState", ctx, tgID, WaitingLink).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingLink).Return(nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, tgID, emptyLink, nil).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingTags, &linkWithURL).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingTags, linkWithURL, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingTags, tgID, linkWithURL, nil).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingFilters, &linkWithoutTags).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, linkWithoutTags, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, tgID, linkWithoutTags, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	scrapper.On("AddLink", ctx, tgID, &linkWithoutFilters).Return(errExpected).Once()
 
@@ -192,11 +221,46 @@ func Test_Bot_HandleMessage_Track_AlreadyExist(t *testing.T) {
 	assert.Equal(t, expectedResponse8, response8)
 }
 
+func Test_Bot_HandleMessage_Track_RejectedByScrapper(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              domain.ErrAPI
+		expectedResponse string
+	}{
+		{name: "Unsupported link", err: domain.ErrAPI{Code: "400", ExceptionName: "UNSUPPORTED_LINK"},
+			expectedResponse: "Поддерживается только gitHub(https://github.com/{owner}/{repo}) и " +
+				"stackOverflow(https://stackoverflow.com/questions/{id}). Повторите команду /track"},
+		{name: "Source not found", err: domain.ErrAPI{Code: "422", ExceptionName: "SOURCE_NOT_FOUND"},
+			expectedResponse: "Репозиторий или вопрос по этой ссылке не найден. Проверьте адрес и повторите команду /track"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scrapper := &mocks.ScrapperClient{}
+			Bot := bot.NewBot(scrapper, &mocks.TelegramClient{}, domain.MessageFormat{}, false)
+
+			tgID := int64(123)
+			linkWithTags := domain.Link{URL: gitExampleURL, Tags: []string{oneTag}}
+			linkWithoutFilters := domain.Link{URL: gitExampleURL, Tags: []string{oneTag}, Filters: []string{}}
+
+			scrapper.On("GetState", ctx, tgID).Return(WaitingFilters, tgID, linkWithTags, nil).Once()
+			scrapper.On("AddLink", ctx, tgID, &linkWithoutFilters).Return(tt.err).Once()
+			scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+
+			response := Bot.HandleMessage(ctx, tgID, "-")
+
+			assert.Equal(t, tt.expectedResponse, response)
+			scrapper.AssertExpectations(t)
+		})
+	}
+}
+
 func Test_Bot_HandleMessage_Track_InvalidLink(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := commandTrack
@@ -206,8 +270,8 @@ func Test_Bot_HandleMessage_Track_InvalidLink(t *testing.T) {
 		"stackOverflow(https://stackoverflow.com/questions/{id}). Повторите команду /track"
 	emptyLink := domain.Link{}
 
-	scrapper.On("CreateState", ctx, tgID, WaitingLink).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingLink).Return(nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingLink, tgID, emptyLink, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	response1 := Bot.HandleMessage(ctx, tgID, message1)
 	response2 := Bot.HandleMessage(ctx, tgID, message2)
@@ -220,7 +284,7 @@ func Test_Bot_HandleMessage_UnTrack(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := "/untrack"
@@ -230,8 +294,9 @@ func Test_Bot_HandleMessage_UnTrack(t *testing.T) {
 	emptyLink := domain.Link{}
 	linkWithURL := domain.Link{URL: gitExampleURL}
 
-	scrapper.On("CreateState", ctx, tgID, WaitingDelete).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingDelete, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingDelete).Return(nil).Once()
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{}, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingDelete, tgID, emptyLink, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	scrapper.On("RemoveLink", ctx, tgID, &linkWithURL).Return(nil).Once()
 
@@ -246,7 +311,7 @@ func Test_Bot_HandleMessage_UnTrack_Error(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := "/untrack"
@@ -256,8 +321,9 @@ func Test_Bot_HandleMessage_UnTrack_Error(t *testing.T) {
 	emptyLink := domain.Link{}
 	linkWithURL := domain.Link{URL: gitExampleURL}
 
-	scrapper.On("CreateState", ctx, tgID, WaitingDelete).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingDelete, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingDelete).Return(nil).Once()
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{}, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingDelete, tgID, emptyLink, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	scrapper.On("RemoveLink", ctx, tgID, &linkWithURL).Return(errors.New("some_errors")).Once()
 
@@ -272,7 +338,7 @@ func Test_Bot_HandleMessage_List(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := "/list"
@@ -296,7 +362,7 @@ func Test_Bot_HandleMessage_SetTags(t *testing.T) {
 	ctx := context.Background()
 	scrapper := &mocks.ScrapperClient{}
 	tgClient := &mocks.TelegramClient{}
-	Bot := bot.NewBot(scrapper, tgClient)
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
 
 	tgID := int64(123)
 	message1 := "/settags"
@@ -306,11 +372,14 @@ func Test_Bot_HandleMessage_SetTags(t *testing.T) {
 	linkWithURL := domain.Link{URL: gitExampleURL, Tags: []string{}, Filters: []string{}, ID: 0}
 	linkWithTags := domain.Link{URL: gitExampleURL, Tags: []string{oneTag}, Filters: []string{}, ID: 0}
 
-	scrapper.On("CreateState", ctx, tgID, WaitingSetTagsWaitingLink).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingSetTagsWaitingLink, emptyLink, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingSetTagsWaitingLink).Return(nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingSetTagsWaitingLink, tgID, emptyLink, nil).Once()
 	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{linkWithURL}, nil)
+	tgClient.On("SendMessageWithKeyboard", ctx, tgID, mock.Anything, domain.InlineKeyboard{
+		{{Text: "github.com/example/example", Data: "settags:0"}},
+	}).Once()
 	scrapper.On("UpdateState", ctx, tgID, WaitingSetTagsWaitingTags, &linkWithURL).Return(nil).Once()
-	scrapper.On("GetState", ctx, tgID).Return(WaitingSetTagsWaitingTags, linkWithURL, nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingSetTagsWaitingTags, tgID, linkWithURL, nil).Once()
 	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
 	scrapper.On("UpdateLink", ctx, tgID, &linkWithTags).Return(nil).Once()
 
@@ -318,7 +387,7 @@ func Test_Bot_HandleMessage_SetTags(t *testing.T) {
 	response2 := Bot.HandleMessage(ctx, tgID, message2)
 	response3 := Bot.HandleMessage(ctx, tgID, message3)
 
-	expectedResponse1 := "Введите ссылку, для которой хотите изменить тег/теги"
+	expectedResponse1 := ""
 	expectedResponse2 := "Отправьте новые теги разделённые пробелами. Если не хотите добавлять теги отправьте '-' без кавычек"
 	expectedResponse3 := "Теги успешно изменены"
 
@@ -326,3 +395,1128 @@ func Test_Bot_HandleMessage_SetTags(t *testing.T) {
 	assert.Equal(t, expectedResponse2, response2)
 	assert.Equal(t, expectedResponse3, response3)
 }
+
+func Test_Bot_HandleMessage_Cancel(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	message1 := commandTrack
+	message2 := "/cancel"
+	expectedResponse1 := trackGoodResponse1
+	expectedResponse2 := "Текущее действие отменено"
+
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingLink).Return(nil).Once()
+	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+
+	response1 := Bot.HandleMessage(ctx, tgID, message1)
+	response2 := Bot.HandleMessage(ctx, tgID, message2)
+
+	assert.Equal(t, expectedResponse1, response1)
+	assert.Equal(t, expectedResponse2, response2)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Cancel_Error(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+
+	scrapper.On("DeleteState", ctx, tgID).Return(errors.New("some error")).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, "/cancel")
+
+	assert.Equal(t, "Не удалось выполнить операцию", response)
+}
+
+func Test_Bot_HandleCallback_Untrack(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	link := domain.Link{URL: gitExampleURL, ID: 7}
+	msg := domain.Message{TgID: tgID, CallbackID: "callback", CallbackData: "untrack:7"}
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{link}, nil).Once()
+	scrapper.On("RemoveLink", ctx, tgID, &domain.Link{URL: gitExampleURL}).Return(nil).Once()
+	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+	tgClient.On("SendMessage", ctx, tgID, "Ссылка успешно удалена").Once()
+	tgClient.On("AnswerCallback", ctx, "callback", "").Once()
+
+	Bot.HandleCallback(ctx, &msg)
+
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleCallback_SetTags(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	link := domain.Link{URL: gitExampleURL, ID: 7, Tags: []string{}, Filters: []string{}}
+	msg := domain.Message{TgID: tgID, UserID: tgID, CallbackID: "callback", CallbackData: "settags:7"}
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{link}, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingSetTagsWaitingTags).Return(nil).Once()
+	scrapper.On("UpdateState", ctx, tgID, WaitingSetTagsWaitingTags, &link).Return(nil).Once()
+	tgClient.On("SendMessage", ctx, tgID,
+		"Отправьте новые теги разделённые пробелами. Если не хотите добавлять теги отправьте '-' без кавычек").Once()
+	tgClient.On("AnswerCallback", ctx, "callback", "").Once()
+
+	Bot.HandleCallback(ctx, &msg)
+
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleCallback_Page(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	messageID := 42
+	links := make([]domain.Link, 0, 7)
+
+	for i := int64(1); i <= 7; i++ {
+		links = append(links, domain.Link{URL: fmt.Sprintf("https://github.com/example/repo%d", i), ID: i})
+	}
+
+	msg := domain.Message{TgID: tgID, CallbackID: "callback", CallbackData: "page:untrack:1", MessageID: messageID}
+	expectedKeyboard := domain.InlineKeyboard{
+		{{Text: "github.com/example/repo6", Data: "untrack:6"}},
+		{{Text: "github.com/example/repo7", Data: "untrack:7"}},
+		{{Text: "◀", Data: "page:untrack:0"}},
+	}
+
+	scrapper.On("GetLinks", ctx, tgID).Return(links, nil).Once()
+	tgClient.On("EditMessageKeyboard", ctx, tgID, messageID, expectedKeyboard).Once()
+	tgClient.On("AnswerCallback", ctx, "callback", "").Once()
+
+	Bot.HandleCallback(ctx, &msg)
+
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_TrackWithArgs(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	message := "/track https://github.com/example/example/issues tags:infra,ci filter:user!=bot"
+	link := domain.Link{URL: gitExampleURL, Tags: []string{"infra", "ci"}, Filters: []string{"user!=bot"}}
+
+	scrapper.On("AddLink", ctx, tgID, &link).Return(nil).Once()
+	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, message)
+
+	assert.Equal(t, trackGoodResponse4, response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_TrackWithArgs_InvalidLink(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	response := Bot.HandleMessage(ctx, 123, "/track https://example.com/example tags:infra")
+
+	expectedResponse := "Поддерживается только gitHub(https://github.com/{owner}/{repo}) и " +
+		"stackOverflow(https://stackoverflow.com/questions/{id}). Повторите команду /track"
+
+	assert.Equal(t, expectedResponse, response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_UntrackWithArgs(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{{URL: gitExampleURL, ID: 5}}, nil).Once()
+	scrapper.On("RemoveLink", ctx, tgID, &domain.Link{URL: gitExampleURL}).Return(nil).Once()
+	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, "/untrack 5")
+
+	assert.Equal(t, "Ссылка успешно удалена", response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_UntrackWithArgs_NotFound(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{{URL: gitExampleURL, ID: 5}}, nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, "/untrack https://github.com/example/other")
+
+	assert.Equal(t, "Не удалось выполнить операцию. Данная ссылка не найдена", response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_SetTagsWithArgs(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	link := domain.Link{URL: gitExampleURL, ID: 5, Tags: []string{}, Filters: []string{}}
+	linkWithTags := domain.Link{URL: gitExampleURL, ID: 5, Tags: []string{"tag1", "tag2"}, Filters: []string{}}
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{link}, nil).Once()
+	scrapper.On("UpdateLink", ctx, tgID, &linkWithTags).Return(nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, "/settags 5 tag1 tag2")
+
+	assert.Equal(t, "Теги успешно изменены", response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_SetTagsWithArgs_Partial(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	link := domain.Link{URL: gitExampleURL, ID: 5, Tags: []string{}, Filters: []string{}}
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{link}, nil).Once()
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingSetTagsWaitingTags).Return(nil).Once()
+	scrapper.On("UpdateState", ctx, tgID, WaitingSetTagsWaitingTags, &link).Return(nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, "/settags "+gitExampleURL)
+
+	assert.Equal(t, "Отправьте новые теги разделённые пробелами. Если не хотите добавлять теги отправьте '-' без кавычек", response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_ImportList(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	message := "/import\n" +
+		gitExampleURL + " tags:infra,ci\n" +
+		"https://example.com/example\n" +
+		"\n" +
+		"https://stackoverflow.com/questions/1/title filter:user!=bot"
+	links := []domain.Link{
+		{URL: gitExampleURL, Tags: []string{"infra", "ci"}, Filters: []string{}},
+		{URL: "https://stackoverflow.com/questions/1", Tags: []string{}, Filters: []string{"user!=bot"}},
+	}
+	results := []domain.LinkImportResult{
+		{Link: domain.Link{URL: gitExampleURL, ID: 1}},
+		{Link: links[1], Error: domain.ErrLinkAlreadyTracking{}.Error()},
+	}
+
+	scrapper.On("AddLinks", ctx, tgID, links).Return(results, nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, message)
+
+	expectedResponse := "Импорт завершён. Добавлено: 1, с ошибками: 2\n\n" +
+		"Ошибки:\n" +
+		"строка 2: https://example.com/example — неподдерживаемая ссылка\n" +
+		"строка 4: https://stackoverflow.com/questions/1 — ссылка уже отслеживается"
+
+	assert.Equal(t, expectedResponse, response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Import_WaitingList(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	links := []domain.Link{{URL: gitExampleURL, Tags: []string{}, Filters: []string{}}}
+
+	scrapper.On("CreateState", ctx, tgID, tgID, WaitingImport).Return(nil).Once()
+	scrapper.On("GetState", ctx, tgID).Return(WaitingImport, tgID, domain.Link{}, nil).Once()
+	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+	scrapper.On("AddLinks", ctx, tgID, links).Return([]domain.LinkImportResult{{Link: links[0]}}, nil).Once()
+
+	response := Bot.HandleMessage(ctx, tgID, "/import")
+	assert.Equal(t, "Отправьте список ссылок сообщением (по одной на строку, в формате "+
+		"<ссылка> tags:тег1,тег2 filter:фильтр1,фильтр2) или файлом .txt, .csv или .opml", response)
+
+	response = Bot.HandleMessage(ctx, tgID, gitExampleURL)
+	assert.Equal(t, "Импорт завершён. Добавлено: 1, с ошибками: 0", response)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleDocument_CSV(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	msg := domain.Message{TgID: tgID, Document: &domain.Document{FileID: "file", FileName: "links.csv"}}
+	data := "url,tags,filters\n" +
+		gitExampleURL + ",\"infra ci\",user!=bot\n" +
+		"not a link,,\n"
+	links := []domain.Link{{URL: gitExampleURL, Tags: []string{"infra", "ci"}, Filters: []string{"user!=bot"}}}
+
+	scrapper.On("GetState", ctx, tgID).Return(WaitingImport, tgID, domain.Link{}, nil).Once()
+	scrapper.On("DeleteState", ctx, tgID).Return(nil).Once()
+	tgClient.On("DownloadFile", ctx, "file").Return([]byte(data), nil).Once()
+	scrapper.On("AddLinks", ctx, tgID, links).Return([]domain.LinkImportResult{{Link: links[0]}}, nil).Once()
+
+	response := Bot.HandleDocument(ctx, &msg)
+
+	expectedResponse := "Импорт завершён. Добавлено: 1, с ошибками: 1\n\n" +
+		"Ошибки:\n" +
+		"строка 3: not a link — неподдерживаемая ссылка"
+
+	assert.Equal(t, expectedResponse, response)
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleDocument_TooManyLinks(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	msg := domain.Message{TgID: tgID, Text: "/import", Document: &domain.Document{FileID: "file", FileName: "links.txt"}}
+
+	var data strings.Builder
+	for i := range domain.MaxImportLinks + 1 {
+		fmt.Fprintf(&data, "https://github.com/owner/repo%d\n", i)
+	}
+
+	tgClient.On("DownloadFile", ctx, "file").Return([]byte(data.String()), nil).Once()
+
+	response := Bot.HandleDocument(ctx, &msg)
+
+	assert.Equal(t, "Слишком много ссылок: 101. За один импорт можно добавить не больше 100, разделите список на части", response)
+	scrapper.AssertNotCalled(t, "AddLinks", mock.Anything, mock.Anything, mock.Anything)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleDocument_OPMLWithCaption(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	msg := domain.Message{TgID: tgID, Text: "/import", Document: &domain.Document{FileID: "file", FileName: "feeds.opml"}}
+	data := `<?xml version="1.0"?>
+<opml version="2.0">
+  <body>
+    <outline text="Open source">
+      <outline text="example" htmlUrl="https://github.com/example/example" category="/go,/infra"/>
+    </outline>
+    <outline text="question" xmlUrl="https://stackoverflow.com/questions/1"/>
+  </body>
+</opml>`
+	links := []domain.Link{
+		{URL: gitExampleURL, Tags: []string{"Open_source", "go", "infra"}, Filters: []string{}},
+		{URL: "https://stackoverflow.com/questions/1", Tags: []string{}, Filters: []string{}},
+	}
+
+	tgClient.On("DownloadFile", ctx, "file").Return([]byte(data), nil).Once()
+	scrapper.On("AddLinks", ctx, tgID, links).Return([]domain.LinkImportResult{{Link: links[0]}, {Link: links[1]}}, nil).Once()
+
+	response := Bot.HandleDocument(ctx, &msg)
+
+	assert.Equal(t, "Импорт завершён. Добавлено: 2, с ошибками: 0", response)
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleDocument_WithoutImport(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	msg := domain.Message{TgID: tgID, Document: &domain.Document{FileID: "file", FileName: "links.txt"}}
+
+	scrapper.On("GetState", ctx, tgID).Return(-1, int64(0), domain.Link{}, errors.New("state not found")).Once()
+
+	response := Bot.HandleDocument(ctx, &msg)
+
+	assert.Equal(t, "Чтобы импортировать ссылки из файла, отправьте команду /import, а затем файл", response)
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Export(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	tgID := int64(123)
+	links := []domain.Link{
+		{URL: gitExampleURL, ID: 1, Tags: []string{"infra", "ci"}, Filters: []string{"user!=bot"}},
+		{URL: "https://stackoverflow.com/questions/1", ID: 2, Tags: []string{}, Filters: []string{}},
+	}
+
+	scrapper.On("GetLinks", ctx, tgID).Return(links, nil).Twice()
+	tgClient.On("SendDocument", ctx, tgID, "links.csv", []byte("url,tags,filters\n"+
+		gitExampleURL+",infra ci,user!=bot\n"+
+		"https://stackoverflow.com/questions/1,,\n")).Once()
+	tgClient.On("SendDocument", ctx, tgID, "links.txt", []byte(gitExampleURL+" tags:infra,ci filter:user!=bot\n"+
+		"https://stackoverflow.com/questions/1\n")).Once()
+
+	assert.Equal(t, "", Bot.HandleMessage(ctx, tgID, "/export"))
+	assert.Equal(t, "", Bot.HandleMessage(ctx, tgID, "/export txt"))
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_UpdateSend_HTML(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	format := domain.MessageFormat{ParseMode: domain.ParseModeHTML, DisableLinkPreview: true}
+	Bot := bot.NewBot(scrapper, tgClient, format, false)
+
+	update := domain.LinkUpdate{
+		Link:  domain.Link{URL: gitExampleURL},
+		TgIDs: []int64{1, 2},
+		Details: domain.UpdateDetails{
+			Kind:      "Issue",
+			Title:     "Fix <script> & co",
+			URL:       gitExampleURL + "/issues/1",
+			Author:    "octocat",
+			AuthorURL: "https://github.com/octocat",
+			CreatedAt: time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC),
+			Preview:   "a < b",
+		},
+	}
+	expectedMessage := `Было обновление: <a href="https://github.com/example/example">https://github.com/example/example</a>` + "\n" +
+		`<b>Issue:</b> <a href="https://github.com/example/example/issues/1">Fix &lt;script&gt; &amp; co</a>` + "\n" +
+		`Автор: <a href="https://github.com/octocat">octocat</a>` + "\n" +
+		"Создано: 02.01.2025 03:04 UTC\n" +
+		"<blockquote>a &lt; b</blockquote>"
+
+	tgClient.On("SendFormattedMessage", ctx, int64(1), expectedMessage, format).Once()
+	tgClient.On("SendFormattedMessage", ctx, int64(2), expectedMessage, format).Once()
+
+	Bot.UpdateSend(ctx, &update)
+
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_UpdateSend_MarkdownV2(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	format := domain.MessageFormat{ParseMode: domain.ParseModeMarkdownV2}
+	Bot := bot.NewBot(scrapper, tgClient, format, false)
+
+	update := domain.LinkUpdate{
+		Link:  domain.Link{URL: "https://stackoverflow.com/questions/1"},
+		TgIDs: []int64{1},
+		Details: domain.UpdateDetails{
+			Kind:    "Answer",
+			Title:   "Why (a.b)?",
+			URL:     "https://stackoverflow.com/a/2",
+			Author:  "user_1",
+			Preview: "line1\nline-2!",
+		},
+	}
+	expectedMessage := "Было обновление: [https://stackoverflow\\.com/questions/1](https://stackoverflow.com/questions/1)\n" +
+		"*Answer:* [Why \\(a\\.b\\)?](https://stackoverflow.com/a/2)\n" +
+		"Автор: user\\_1\n" +
+		">line1\n>line\\-2\\!"
+
+	tgClient.On("SendFormattedMessage", ctx, int64(1), expectedMessage, format).Once()
+
+	Bot.UpdateSend(ctx, &update)
+
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_UpdateSend_PlainDescription(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{ParseMode: "unknown"}, false)
+
+	update := domain.LinkUpdate{Link: domain.Link{URL: gitExampleURL}, TgIDs: []int64{1}, Description: "<b>raw</b>"}
+
+	tgClient.On("SendFormattedMessage", ctx, int64(1), "Было обновление: "+gitExampleURL+"\n<b>raw</b>",
+		domain.MessageFormat{}).Once()
+
+	Bot.UpdateSend(ctx, &update)
+
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_DigestSend_HTML(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	format := domain.MessageFormat{ParseMode: domain.ParseModeHTML}
+	Bot := bot.NewBot(scrapper, tgClient, format, false)
+
+	digest := domain.Digest{
+		TgID: 1,
+		Mode: domain.DeliveryDaily,
+		Updates: []domain.LinkUpdate{
+			{
+				Link: domain.Link{URL: gitExampleURL},
+				Details: domain.UpdateDetails{
+					Kind:      "Issue",
+					Title:     "First",
+					URL:       gitExampleURL + "/issues/1",
+					Author:    "octocat",
+					CreatedAt: time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC),
+				},
+			},
+			{Link: domain.Link{URL: "https://stackoverflow.com/questions/1"}, Description: "Answer: a < b\nUser: x"},
+			{Link: domain.Link{URL: gitExampleURL}, Details: domain.UpdateDetails{Kind: "Pull request", Title: "Second"}},
+		},
+	}
+	expectedMessage := "<b>Сводка обновлений за день: 3</b>\n\n" +
+		`<a href="https://github.com/example/example">https://github.com/example/example</a> (2)` + "\n" +
+		`• <b>Issue:</b> <a href="https://github.com/example/example/issues/1">First</a> — octocat — 02.01.2025 03:04 UTC` + "\n" +
+		"• <b>Pull request:</b> Second\n\n" +
+		`<a href="https://stackoverflow.com/questions/1">https://stackoverflow.com/questions/1</a> (1)` + "\n" +
+		"• Answer: a &lt; b"
+
+	tgClient.On("SendFormattedMessage", ctx, int64(1), expectedMessage, format).Once()
+
+	Bot.DigestSend(ctx, &digest)
+
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_DigestSend_SplitsLongDigest(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	digest := domain.Digest{TgID: 1, Mode: domain.DeliveryHourly}
+	for i := 0; i < 200; i++ {
+		digest.Updates = append(digest.Updates, domain.LinkUpdate{
+			Link:        domain.Link{URL: fmt.Sprintf("%s%d", gitExampleURL, i)},
+			Description: "Issue: something changed in the repository",
+		})
+	}
+
+	var messages []string
+
+	tgClient.On("SendFormattedMessage", ctx, int64(1), mock.Anything, domain.MessageFormat{}).
+		Run(func(args mock.Arguments) {
+			messages = append(messages, args.String(2))
+		})
+
+	Bot.DigestSend(ctx, &digest)
+
+	assert.Greater(t, len(messages), 1)
+
+	for _, message := range messages {
+		assert.LessOrEqual(t, len([]rune(message)), 4096)
+	}
+}
+
+func Test_Bot_HandleMessage_ModeDaily(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	mode, digestTime := domain.DeliveryDaily, 21*60+30
+	updated := domain.DefaultDeliverySettings()
+	updated.Mode, updated.DigestTime = mode, digestTime
+
+	scrapper.On("UpdateSettings", ctx, tgID, &domain.DeliverySettingsUpdate{Mode: &mode, DigestTime: &digestTime}).
+		Return(updated, nil).Once()
+
+	responseText := Bot.HandleMessage(ctx, tgID, "/mode daily 21:30")
+
+	assert.Equal(t, "Режим доставки изменён: сводка раз в день в 21:30", responseText)
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_ModeInvalid(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+
+	responseText := Bot.HandleMessage(ctx, 123, "/mode hourly 10:00")
+
+	assert.Contains(t, responseText, "Время можно указать только для режима daily")
+	scrapper.AssertNotCalled(t, "UpdateSettings", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Bot_HandleMessage_ModeTag(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("SetTagMode", ctx, tgID, "work", domain.DeliveryImmediate).Return(nil).Once()
+	scrapper.On("SetTagMode", ctx, tgID, "news", domain.DeliveryMode("")).Return(nil).Once()
+
+	assert.Equal(t, "Режим доставки для тега work: сразу", Bot.HandleMessage(ctx, tgID, "/mode tag work immediate"))
+	assert.Equal(t, "Для тега news используется общий режим доставки",
+		Bot.HandleMessage(ctx, tgID, "/mode tag news default"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_ModeShowSettings(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	settings := domain.DeliverySettings{
+		Mode:       domain.DeliveryDaily,
+		DigestTime: 9 * 60,
+		Timezone:   "Europe/Moscow",
+		TagModes:   map[string]domain.DeliveryMode{"work": domain.DeliveryImmediate},
+	}
+
+	scrapper.On("GetSettings", ctx, tgID).Return(settings, nil).Once()
+
+	responseText := Bot.HandleMessage(ctx, tgID, "/mode")
+
+	assert.Contains(t, responseText, "Режим доставки: сводка раз в день в 09:00\n"+
+		"Часовой пояс: Europe/Moscow\n"+
+		"Тихие часы выключены\n"+
+		"Режимы тегов:\n"+
+		"  work — сразу")
+}
+
+func Test_Bot_HandleMessage_Timezone(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	timezone := "+03:00"
+	updated := domain.DefaultDeliverySettings()
+	updated.Timezone = timezone
+
+	scrapper.On("UpdateSettings", ctx, tgID, &domain.DeliverySettingsUpdate{Timezone: &timezone}).
+		Return(updated, nil).Once()
+
+	assert.Equal(t, "Часовой пояс изменён: +03:00", Bot.HandleMessage(ctx, tgID, "/timezone +03:00"))
+	assert.Equal(t, "Неизвестный часовой пояс. Укажите часовой пояс, например: /timezone Europe/Moscow или /timezone +03:00",
+		Bot.HandleMessage(ctx, tgID, "/timezone Mars/Olympus"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Quiet(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	quiet := domain.QuietHours{Start: 23 * 60, End: 8 * 60}
+	timezone := "Europe/Moscow"
+	updated := domain.DefaultDeliverySettings()
+	updated.Quiet, updated.Timezone = quiet, timezone
+
+	scrapper.On("UpdateSettings", ctx, tgID, &domain.DeliverySettingsUpdate{Quiet: &quiet, Timezone: &timezone}).
+		Return(updated, nil).Once()
+	scrapper.On("UpdateSettings", ctx, tgID, &domain.DeliverySettingsUpdate{Quiet: &domain.QuietHours{}}).
+		Return(domain.DefaultDeliverySettings(), nil).Once()
+
+	assert.Equal(t, "Тихие часы: 23:00-08:00 (Europe/Moscow)",
+		Bot.HandleMessage(ctx, tgID, "/quiet 23:00-08:00 Europe/Moscow"))
+	assert.Equal(t, "Тихие часы выключены", Bot.HandleMessage(ctx, tgID, "/quiet off"))
+	assert.Equal(t, "Начало и конец тихих часов должны различаться", Bot.HandleMessage(ctx, tgID, "/quiet 10:00-10:00"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleChatMessage_GroupCommandWithMention(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, true)
+	chatID := int64(-100123)
+
+	tgClient.On("BotUsername").Return("LinkTrackerBot")
+	scrapper.On("GetLinks", ctx, chatID).Return([]domain.Link{}, nil).Once()
+
+	msg := domain.Message{TgID: chatID, UserID: 42, ChatType: domain.ChatSupergroup, Text: "/list@LinkTrackerBot"}
+	responseText := Bot.HandleChatMessage(ctx, &msg)
+
+	assert.NotEmpty(t, responseText)
+
+	msg.Text = "/list@OtherBot"
+	assert.Empty(t, Bot.HandleChatMessage(ctx, &msg))
+	scrapper.AssertExpectations(t)
+	tgClient.AssertNotCalled(t, "IsChatAdmin", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Bot_HandleChatMessage_GroupAdminOnly(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, true)
+	chatID := int64(-100123)
+
+	tgClient.On("BotUsername").Return("LinkTrackerBot")
+	tgClient.On("IsChatAdmin", ctx, chatID, int64(42)).Return(false, nil)
+	tgClient.On("IsChatAdmin", ctx, chatID, int64(7)).Return(true, nil)
+	scrapper.On("AddLink", ctx, chatID, &domain.Link{URL: gitExampleURL, Tags: []string{}, Filters: []string{}}).
+		Return(nil).Once()
+	scrapper.On("DeleteState", ctx, chatID).Return(nil).Once()
+
+	msg := domain.Message{TgID: chatID, UserID: 42, ChatType: domain.ChatGroup, Text: "/track@LinkTrackerBot " + gitExampleURL}
+	assert.Equal(t, "В этом чате менять подписки и настройки могут только администраторы", Bot.HandleChatMessage(ctx, &msg))
+
+	msg.UserID = 7
+	assert.Equal(t, trackGoodResponse4, Bot.HandleChatMessage(ctx, &msg))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleChatMessage_GroupTextOutsideDialog(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, true)
+	chatID := int64(-100123)
+
+	scrapper.On("GetState", ctx, chatID).Return(0, int64(0), domain.Link{}, errors.New("state not found")).Once()
+
+	msg := domain.Message{TgID: chatID, UserID: 42, ChatType: domain.ChatGroup, Text: "всем привет"}
+	assert.Empty(t, Bot.HandleChatMessage(ctx, &msg))
+	scrapper.AssertExpectations(t)
+	tgClient.AssertNotCalled(t, "IsChatAdmin", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Bot_HandleChatMessage_GroupDialogOfAnotherUser(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	chatID := int64(-100123)
+	linkWithURL := domain.Link{URL: gitExampleURL}
+
+	scrapper.On("CreateState", ctx, chatID, int64(42), WaitingLink).Return(nil).Once()
+	scrapper.On("GetState", ctx, chatID).Return(WaitingLink, int64(42), domain.Link{}, nil).Times(3)
+	scrapper.On("UpdateState", ctx, chatID, WaitingTags, &linkWithURL).Return(nil).Once()
+
+	msg := domain.Message{TgID: chatID, UserID: 42, ChatType: domain.ChatGroup, Text: commandTrack}
+	assert.Equal(t, trackGoodResponse1, Bot.HandleChatMessage(ctx, &msg))
+
+	// Ответ другого участника не продолжает чужой диалог
+	other := domain.Message{TgID: chatID, UserID: 43, ChatType: domain.ChatGroup, Text: gitExampleURL}
+	assert.Empty(t, Bot.HandleChatMessage(ctx, &other))
+
+	msg.Text = gitExampleURL
+	assert.Equal(t, trackGoodResponse2, Bot.HandleChatMessage(ctx, &msg))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleChatMessage_TokenOutsidePrivateChat(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	chatID := int64(-100123)
+
+	tgClient.On("BotUsername").Return("LinkTrackerBot")
+
+	for _, chatType := range []domain.ChatType{domain.ChatGroup, domain.ChatSupergroup, domain.ChatChannel} {
+		msg := domain.Message{TgID: chatID, UserID: 42, ChatType: chatType, Text: "/token@LinkTrackerBot"}
+		assert.Equal(t, "Токен API можно получить только в личном чате с ботом", Bot.HandleChatMessage(ctx, &msg),
+			"chat type %s", chatType)
+	}
+
+	scrapper.AssertNotCalled(t, "IssueToken", mock.Anything, mock.Anything)
+}
+
+func Test_Bot_HandleCallback_GroupAdminOnly(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, true)
+	chatID := int64(-100123)
+
+	msg := domain.Message{
+		TgID: chatID, UserID: 42, ChatType: domain.ChatSupergroup, CallbackID: "callback", CallbackData: "untrack:7",
+	}
+
+	tgClient.On("IsChatAdmin", ctx, chatID, int64(42)).Return(false, nil).Once()
+	tgClient.On("AnswerCallback", ctx, "callback", "В этом чате менять подписки и настройки могут только администраторы").Once()
+
+	Bot.HandleCallback(ctx, &msg)
+
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Pause(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+	paused := []domain.Link{{ID: 7, URL: gitExampleURL, Paused: true}}
+
+	scrapper.On("PauseLinks", ctx, tgID, domain.LinkSelector{}, time.Time{}).Return(paused, nil).Once()
+	scrapper.On("PauseLinks", ctx, tgID, domain.LinkSelector{ID: 7}, mock.MatchedBy(func(until time.Time) bool {
+		return time.Until(until) > 71*time.Hour && time.Until(until) <= 72*time.Hour
+	})).Return(paused, nil).Once()
+	scrapper.On("PauseLinks", ctx, tgID, domain.LinkSelector{Tag: "work"}, mock.MatchedBy(func(until time.Time) bool {
+		return time.Until(until) > 11*time.Hour && time.Until(until) <= 12*time.Hour
+	})).Return(nil, domain.ErrLinkNotExist{}).Once()
+
+	assert.Equal(t, "Уведомления приостановлены для ссылок: 1. Возобновить — /resume", Bot.HandleMessage(ctx, tgID, "/pause"))
+	assert.Contains(t, Bot.HandleMessage(ctx, tgID, "/pause 7 3d"), "Уведомления приостановлены для ссылок: 1 до ")
+	assert.Equal(t, "Ссылки не найдены. Посмотрите список с помощью /list", Bot.HandleMessage(ctx, tgID, "/pause #work 12h"))
+	assert.Contains(t, Bot.HandleMessage(ctx, tgID, "/pause 7 soon"), "Использование: /pause")
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Resume(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("ResumeLinks", ctx, tgID, domain.LinkSelector{URL: gitExampleURL}).
+		Return([]domain.Link{{ID: 7, URL: gitExampleURL}}, nil).Once()
+
+	assert.Equal(t, "Уведомления возобновлены для ссылок: 1", Bot.HandleMessage(ctx, tgID, "/resume "+gitExampleURL))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_ListShowsPause(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+	until := time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{{ID: 7, URL: gitExampleURL, Paused: true, PausedUntil: until}}, nil)
+
+	assert.Contains(t, Bot.HandleMessage(ctx, tgID, "/list"), "⏸ на паузе до 02.01.2030 15:04 UTC")
+}
+
+func Test_Bot_HandleMessage_ListByTag(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("GetLinksByTag", ctx, tgID, "work").
+		Return([]domain.Link{{ID: 7, URL: gitExampleURL, Tags: []string{"work"}}}, nil).Once()
+	scrapper.On("GetLinksByTag", ctx, tgID, "empty").Return([]domain.Link{}, nil).Once()
+
+	responseText := Bot.HandleMessage(ctx, tgID, "/list #work")
+
+	assert.Contains(t, responseText, "Ссылки с тегом work:")
+	assert.Contains(t, responseText, gitExampleURL)
+	assert.Equal(t, "Ссылки с таким тегом не найдены. Посмотрите список тегов с помощью /tags",
+		Bot.HandleMessage(ctx, tgID, "/list empty"))
+	scrapper.AssertExpectations(t)
+	scrapper.AssertNotCalled(t, "GetLinks", ctx, tgID)
+}
+
+func Test_Bot_HandleMessage_UntrackTag(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("RemoveLinksByTag", ctx, tgID, "work").
+		Return([]domain.Link{{ID: 1}, {ID: 2}}, nil).Once()
+	scrapper.On("RemoveLinksByTag", ctx, tgID, "old").Return(nil, domain.ErrTagNotExist{Tag: "old"}).Once()
+
+	assert.Equal(t, "Прекращено отслеживание ссылок с тегом work: 2", Bot.HandleMessage(ctx, tgID, "/untrack #work"))
+	assert.Equal(t, "Ссылки с таким тегом не найдены. Посмотрите список тегов с помощью /tags",
+		Bot.HandleMessage(ctx, tgID, "/untrack tag:old"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Tags(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("GetTags", ctx, tgID).Return([]domain.TagCount{{Tag: "go", Links: 2}, {Tag: "work", Links: 1}}, nil)
+
+	assert.Equal(t, "Теги:\n#go — 2\n#work — 1\n", Bot.HandleMessage(ctx, tgID, "/tags"))
+}
+
+func Test_Bot_HandleMessage_RenameTag(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("RenameTag", ctx, tgID, "work", "job").Return(int64(3), nil).Once()
+	scrapper.On("RenameTag", ctx, tgID, "old", "new").Return(int64(0), domain.ErrTagNotExist{Tag: "old"}).Once()
+
+	assert.Equal(t, "Тег work переименован в job у ссылок: 3", Bot.HandleMessage(ctx, tgID, "/renametag #work job"))
+	assert.Equal(t, "Ссылки с таким тегом не найдены. Посмотрите список тегов с помощью /tags",
+		Bot.HandleMessage(ctx, tgID, "/renametag old new"))
+	assert.Equal(t, "Использование: /renametag <старый тег> <новый тег>", Bot.HandleMessage(ctx, tgID, "/renametag work"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_History(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{{ID: 7, URL: gitExampleURL}, {ID: 8, URL: "https://github.com/a/b"}}, nil)
+	scrapper.On("GetLinkUpdates", ctx, tgID, int64(7), int64(5), int64(0)).Return([]domain.UpdateRecord{
+		{
+			ID: 2, LinkID: 7,
+			Details: domain.UpdateDetails{
+				Kind: "Pull Request", Title: "Fix bug", Author: "octocat", URL: gitExampleURL + "/pull/2",
+				CreatedAt: time.Date(2025, 3, 2, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		{ID: 1, LinkID: 7, Description: "Обновление", DetectedAt: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)},
+	}, int64(4), nil).Once()
+	scrapper.On("GetLinkUpdates", ctx, tgID, int64(8), int64(20), int64(0)).Return(nil, int64(0), nil).Once()
+
+	assert.Equal(t, "Последние обновления "+gitExampleURL+" (2 из 4):\n"+
+		"\n02.03.2025 10:30 UTC\nPull Request: Fix bug\nАвтор: octocat\n"+gitExampleURL+"/pull/2\n"+
+		"\n01.03.2025 09:00 UTC\nОбновление\n", Bot.HandleMessage(ctx, tgID, "/history "+gitExampleURL))
+	assert.Equal(t, "Для этой ссылки пока нет сохранённых обновлений", Bot.HandleMessage(ctx, tgID, "/history 8 50"))
+	assert.Equal(t, "Не удалось выполнить операцию. Данная ссылка не найдена", Bot.HandleMessage(ctx, tgID, "/history 9"))
+	assert.Equal(t, "Использование: /history <ссылка или linkID> [количество], например /history 12 10",
+		Bot.HandleMessage(ctx, tgID, "/history 7 -1"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_Search(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("SearchLinks", ctx, tgID, "memory leak", int64(10)).Return([]domain.SearchResult{
+		{
+			Link: domain.Link{ID: 1, URL: gitExampleURL, Tags: []string{"go"}},
+			Update: &domain.UpdateRecord{
+				Details: domain.UpdateDetails{Kind: "Issue", Title: "Memory leak", CreatedAt: time.Date(2025, 3, 2, 10, 30, 0, 0, time.UTC)},
+			},
+		},
+		{Link: domain.Link{ID: 2, URL: "https://github.com/leak/memory"}},
+	}, nil).Once()
+	scrapper.On("SearchLinks", ctx, tgID, "nothing", int64(10)).Return(nil, nil).Once()
+
+	assert.Equal(t, "Результаты поиска «memory leak»:\n"+
+		"\n1. linkID: 1 Url: "+gitExampleURL+" Tags: go\n↳ 02.03.2025 10:30 UTC Issue: Memory leak\n"+
+		"\n2. linkID: 2 Url: https://github.com/leak/memory\n", Bot.HandleMessage(ctx, tgID, "/search memory leak"))
+	assert.Equal(t, "По запросу ничего не найдено", Bot.HandleMessage(ctx, tgID, "/search nothing"))
+	assert.Equal(t, "Использование: /search <запрос>, например /search golang memory leak", Bot.HandleMessage(ctx, tgID, "/search !?"))
+	scrapper.AssertExpectations(t)
+}
+
+func Test_Bot_HandleMessage_ListSorting(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{
+		{ID: 1, URL: "https://github.com/b/b", Tags: []string{"work", "go"},
+			LastUpdated: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, URL: "https://github.com/a/a", Tags: []string{"go"},
+			LastUpdated: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, URL: "https://github.com/c/c"},
+	}, nil)
+
+	// Группы тегов всегда в алфавитном порядке, ссылки внутри группы — по адресу
+	expectedByTag := "Список отслеживаемых ссылок:\n" +
+		"go: \n" +
+		"linkID: 2 Url: https://github.com/a/a Tags: go\n" +
+		"linkID: 1 Url: https://github.com/b/b Tags: work go\n" +
+		"\nwork: \n" +
+		"linkID: 1 Url: https://github.com/b/b Tags: work go\n" +
+		"\nБез тегов: \n" +
+		"linkID: 3 Url: https://github.com/c/c\n"
+
+	for range 3 {
+		assert.Equal(t, expectedByTag, Bot.HandleMessage(ctx, tgID, "/list"))
+	}
+
+	assert.Equal(t, "Список отслеживаемых ссылок:\n"+
+		"linkID: 2 Url: https://github.com/a/a Tags: go Обновлено: 02.03.2025 00:00 UTC\n"+
+		"linkID: 1 Url: https://github.com/b/b Tags: work go Обновлено: 01.03.2025 00:00 UTC\n"+
+		"linkID: 3 Url: https://github.com/c/c\n", Bot.HandleMessage(ctx, tgID, "/list sort:recent"))
+	assert.Equal(t, "Список отслеживаемых ссылок:\n"+
+		"linkID: 2 Url: https://github.com/a/a Tags: go\n"+
+		"linkID: 1 Url: https://github.com/b/b Tags: work go\n"+
+		"linkID: 3 Url: https://github.com/c/c\n", Bot.HandleMessage(ctx, tgID, "/list sort:url"))
+
+	usage := "Использование: /list [тег] [страница] [sort:recent|url|tag], например /list 2 или /list #work sort:recent"
+	assert.Equal(t, usage, Bot.HandleMessage(ctx, tgID, "/list sort:date"))
+	assert.Equal(t, usage, Bot.HandleMessage(ctx, tgID, "/list 0"))
+	assert.Equal(t, usage, Bot.HandleMessage(ctx, tgID, "/list work go"))
+}
+
+func Test_Bot_HandleMessage_ListMetadata(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{
+		{ID: 1, URL: "https://github.com/a/a", Metadata: domain.LinkMetadata{Title: "a/a", Description: "Repo",
+			Stars: 42, Status: domain.SourceArchived}},
+		{ID: 2, URL: "https://stackoverflow.com/questions/1", Metadata: domain.LinkMetadata{Title: "Question",
+			Description: "Duplicate", Answers: 3, Status: domain.SourceClosed}},
+		{ID: 3, URL: "https://stackoverflow.com/questions/2", Metadata: domain.LinkMetadata{Title: "Open",
+			Status: domain.SourceActive}},
+	}, nil)
+
+	assert.Equal(t, "Список отслеживаемых ссылок:\n"+
+		"linkID: 1 Url: https://github.com/a/a «a/a» Repo ★ 42 📦 в архиве\n"+
+		"linkID: 2 Url: https://stackoverflow.com/questions/1 «Question» Ответов: 3 🔒 вопрос закрыт (Duplicate)\n"+
+		"linkID: 3 Url: https://stackoverflow.com/questions/2 «Open»\n", Bot.HandleMessage(ctx, tgID, "/list sort:url"))
+}
+
+func Test_Bot_HandleMessage_ListFailingLink(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	Bot := bot.NewBot(scrapper, &mocks.TelegramClient{}, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("GetLinks", ctx, tgID).Return([]domain.Link{
+		{ID: 1, URL: "https://github.com/a/a", Health: domain.LinkHealth{LastCheckedAt: time.Now(),
+			LastError: "status not ok, status code [500]", ConsecutiveFailures: 6}},
+		{ID: 2, URL: "https://github.com/b/b", Health: domain.LinkHealth{LastCheckedAt: time.Now()}},
+	}, nil)
+
+	assert.Equal(t, "Список отслеживаемых ссылок:\n"+
+		"linkID: 1 Url: https://github.com/a/a ⚠️ не удаётся проверить, ошибок подряд: 6\n"+
+		"linkID: 2 Url: https://github.com/b/b\n", Bot.HandleMessage(ctx, tgID, "/list sort:url"))
+}
+
+func Test_Bot_HandleMessage_ListPagination(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	links := make([]domain.Link, 0, 25)
+	for i := range 25 {
+		links = append(links, domain.Link{ID: int64(i), URL: fmt.Sprintf("https://github.com/example/repo%02d", i)})
+	}
+
+	scrapper.On("GetLinks", ctx, tgID).Return(links, nil)
+	tgClient.On("SendMessageWithKeyboard", ctx, tgID,
+		mock.MatchedBy(func(text string) bool {
+			return strings.Contains(text, "repo19") && !strings.Contains(text, "repo20") &&
+				strings.HasSuffix(text, "\nСтраница 1 из 2")
+		}),
+		domain.InlineKeyboard{{{Text: "▶", Data: "list:url:1"}}}).Once()
+	tgClient.On("SendMessageWithKeyboard", ctx, tgID,
+		mock.MatchedBy(func(text string) bool {
+			return strings.Contains(text, "repo20") && strings.HasSuffix(text, "\nСтраница 2 из 2")
+		}),
+		domain.InlineKeyboard{{{Text: "◀", Data: "list:url:0"}}}).Twice()
+
+	assert.Empty(t, Bot.HandleMessage(ctx, tgID, "/list sort:url"))
+	assert.Empty(t, Bot.HandleMessage(ctx, tgID, "/list sort:url 2"))
+	// Номер страницы за пределами списка приводится к последней странице
+	assert.Empty(t, Bot.HandleMessage(ctx, tgID, "/list 9 sort:url"))
+	tgClient.AssertExpectations(t)
+}
+
+func Test_Bot_HandleCallback_ListPage(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, true)
+	tgID := int64(-100)
+
+	links := make([]domain.Link, 0, 21)
+	for i := range 21 {
+		links = append(links, domain.Link{ID: int64(i), URL: fmt.Sprintf("https://github.com/example/repo%02d", i),
+			Tags: []string{"a:b"}})
+	}
+
+	msg := domain.Message{TgID: tgID, ChatType: domain.ChatSupergroup, MessageID: 5, CallbackID: "callback",
+		CallbackData: "list:recent:1:a:b"}
+
+	scrapper.On("GetLinksByTag", ctx, tgID, "a:b").Return(links, nil).Once()
+	tgClient.On("EditMessage", ctx, tgID, 5,
+		"Ссылки с тегом a:b:\nlinkID: 20 Url: https://github.com/example/repo20 Tags: a:b\n\nСтраница 2 из 2",
+		domain.InlineKeyboard{{{Text: "◀", Data: "list:recent:0:a:b"}}}).Once()
+	tgClient.On("AnswerCallback", ctx, "callback", "").Once()
+
+	Bot.HandleCallback(ctx, &msg)
+
+	scrapper.AssertExpectations(t)
+	tgClient.AssertExpectations(t)
+	tgClient.AssertNotCalled(t, "IsChatAdmin", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Bot_HandleMessage_Token(t *testing.T) {
+	ctx := context.Background()
+	scrapper := &mocks.ScrapperClient{}
+	tgClient := &mocks.TelegramClient{}
+	Bot := bot.NewBot(scrapper, tgClient, domain.MessageFormat{}, false)
+	tgID := int64(123)
+
+	scrapper.On("IssueToken", ctx, tgID).Return("lt_token", nil).Once()
+	scrapper.On("IssueToken", ctx, tgID).Return("", errors.New("scrapper unavailable")).Once()
+
+	responseText := Bot.HandleChatMessage(ctx, &domain.Message{TgID: tgID, UserID: tgID, ChatType: domain.ChatPrivate, Text: "/token"})
+	assert.Contains(t, responseText, "\n\nlt_token\n\n")
+	assert.Contains(t, responseText, "Authorization: Bearer")
+
+	assert.Equal(t, "Не удалось выполнить операцию", Bot.HandleMessage(ctx, tgID, "/token"))
+	scrapper.AssertExpectations(t)
+}
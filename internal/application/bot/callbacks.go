@@ -0,0 +1,195 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	callbackUntrack = "untrack"
+	callbackSetTags = "settags"
+	callbackPage    = "page"
+
+	linksKeyboardPageSize = 5
+	maxButtonTextLength   = 48
+)
+
+func (bot *Bot) HandleCallback(ctx context.Context, msg *domain.Message) {
+	answerText := ""
+
+	defer func() {
+		bot.tgAPI.AnswerCallback(ctx, msg.CallbackID, answerText)
+	}()
+
+	parts := strings.Split(msg.CallbackData, ":")
+	if len(parts) < 2 {
+		slog.Error("Unknown callback", "data", msg.CallbackData, "chatId", msg.TgID)
+		return
+	}
+
+	// Листание списков ничего не меняет, поэтому доступно всем участникам группы
+	readOnly := parts[0] == callbackPage || parts[0] == callbackList
+	if !readOnly && msg.ChatType.IsGroup() && bot.groupAdminOnly && !bot.isChatAdmin(ctx, msg) {
+		answerText = adminOnlyText
+		return
+	}
+
+	switch parts[0] {
+	case callbackUntrack:
+		bot.sendResponse(ctx, msg.TgID, bot.callbackUntrack(ctx, msg.TgID, parts[1]))
+	case callbackSetTags:
+		bot.sendResponse(ctx, msg.TgID, bot.callbackSetTags(ctx, msg.TgID, msg.UserID, parts[1]))
+	case callbackPage:
+		if len(parts) < 3 {
+			slog.Error("Unknown callback", "data", msg.CallbackData, "chatId", msg.TgID)
+			return
+		}
+
+		bot.callbackPage(ctx, msg, parts[1], parts[2])
+	case callbackList:
+		bot.callbackList(ctx, msg, parts[1:])
+	default:
+		slog.Error("Unknown callback", "data", msg.CallbackData, "chatId", msg.TgID)
+	}
+}
+
+// sendResponse отправляет ответ на команду. Telegram не принимает сообщения длиннее maxMessageLength,
+// поэтому длинный ответ делится на несколько сообщений по строкам.
+func (bot *Bot) sendResponse(ctx context.Context, tgID int64, text string) {
+	if text == "" {
+		return
+	}
+
+	for _, part := range splitMessage([]string{text}, maxMessageLength) {
+		bot.tgAPI.SendMessage(ctx, tgID, part)
+	}
+}
+
+func (bot *Bot) callbackUntrack(ctx context.Context, tgID int64, rawLinkID string) string {
+	link, err := bot.findLink(ctx, tgID, rawLinkID)
+	if err != nil {
+		slog.Error("callbackUntrack failed", "error", err.Error(), "chatId", tgID)
+		return errorText + ". Данная ссылка не найдена"
+	}
+
+	return bot.stateWaitDelete(ctx, tgID, link.URL)
+}
+
+func (bot *Bot) callbackSetTags(ctx context.Context, tgID, userID int64, rawLinkID string) string {
+	return bot.commandSetTagsWithArgs(ctx, tgID, userID, rawLinkID, nil)
+}
+
+func (bot *Bot) callbackPage(ctx context.Context, msg *domain.Message, action, rawPage string) {
+	page, err := strconv.Atoi(rawPage)
+	if err != nil {
+		slog.Error("callbackPage failed", "error", err.Error(), "chatId", msg.TgID)
+		return
+	}
+
+	links, err := bot.scrapper.GetLinks(ctx, msg.TgID)
+	if err != nil {
+		slog.Error("callbackPage failed", "error", err.Error(), "chatId", msg.TgID)
+		return
+	}
+
+	bot.tgAPI.EditMessageKeyboard(ctx, msg.TgID, msg.MessageID, linksKeyboard(links, action, page))
+}
+
+// sendLinksKeyboard отправляет пользователю список его ссылок в виде кнопок.
+// Возвращает false, если клавиатуру отправить не удалось и нужно ответить обычным текстом.
+func (bot *Bot) sendLinksKeyboard(ctx context.Context, tgID int64, text, action string) bool {
+	links, err := bot.scrapper.GetLinks(ctx, tgID)
+	if err != nil {
+		slog.Error("sendLinksKeyboard failed", "error", err.Error(), "chatId", tgID)
+		return false
+	}
+
+	if len(links) == 0 {
+		return false
+	}
+
+	bot.tgAPI.SendMessageWithKeyboard(ctx, tgID, text, linksKeyboard(links, action, 0))
+
+	return true
+}
+
+// findLink ищет ссылку пользователя по linkID или по адресу.
+func (bot *Bot) findLink(ctx context.Context, tgID int64, linkRef string) (*domain.Link, error) {
+	links, err := bot.scrapper.GetLinks(ctx, tgID)
+	if err != nil {
+		return nil, err
+	}
+
+	linkID, err := strconv.ParseInt(linkRef, 10, 64)
+	isID := err == nil
+
+	if !isID {
+		if valid, validURL := validateLink(linkRef); valid {
+			linkRef = validURL
+		}
+	}
+
+	for i := range links {
+		if (isID && links[i].ID == linkID) || (!isID && links[i].URL == linkRef) {
+			return &links[i], nil
+		}
+	}
+
+	return nil, domain.ErrLinkNotExist{}
+}
+
+// linksKeyboard строит страницу клавиатуры: по кнопке на ссылку и строку навигации между страницами.
+func linksKeyboard(links []domain.Link, action string, page int) domain.InlineKeyboard {
+	pages := (len(links) + linksKeyboardPageSize - 1) / linksKeyboardPageSize
+	page = max(0, min(page, pages-1))
+
+	start := page * linksKeyboardPageSize
+	end := min(start+linksKeyboardPageSize, len(links))
+
+	keyboard := make(domain.InlineKeyboard, 0, end-start+1)
+
+	for i := start; i < end; i++ {
+		keyboard = append(keyboard, []domain.InlineButton{{
+			Text: buttonText(links[i].URL),
+			Data: fmt.Sprintf("%s:%d", action, links[i].ID),
+		}})
+	}
+
+	if pages > 1 {
+		var navigation []domain.InlineButton
+
+		if page > 0 {
+			navigation = append(navigation, domain.InlineButton{
+				Text: "◀",
+				Data: fmt.Sprintf("%s:%s:%d", callbackPage, action, page-1),
+			})
+		}
+
+		if page < pages-1 {
+			navigation = append(navigation, domain.InlineButton{
+				Text: "▶",
+				Data: fmt.Sprintf("%s:%s:%d", callbackPage, action, page+1),
+			})
+		}
+
+		keyboard = append(keyboard, navigation)
+	}
+
+	return keyboard
+}
+
+func buttonText(linkURL string) string {
+	text := strings.TrimPrefix(strings.TrimPrefix(linkURL, "https://"), "http://")
+
+	runes := []rune(text)
+	if len(runes) > maxButtonTextLength {
+		return string(runes[:maxButtonTextLength-1]) + "…"
+	}
+
+	return text
+}
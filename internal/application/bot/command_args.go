@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"strings"
+)
+
+// splitCommand отделяет команду от её аргументов: "/track url tags:a" -> "/track", ["url", "tags:a"].
+// Упоминание бота в команде отбрасывается: "/track@LinkTrackerBot url" -> "/track", ["url"].
+func splitCommand(text string) (command string, args []string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	command, _ = splitMention(fields[0])
+
+	return command, fields[1:]
+}
+
+// splitMention разделяет команду и имя бота, к которому она обращена: "/list@LinkTrackerBot" ->
+// "/list", "LinkTrackerBot". Для команды без упоминания имя пустое.
+func splitMention(command string) (name, mention string) {
+	name, mention, _ = strings.Cut(command, "@")
+
+	return name, mention
+}
+
+// commandBody возвращает текст после команды с сохранением переводов строк: "/import@bot\na\nb" -> "a\nb".
+func commandBody(text string) string {
+	text = strings.TrimSpace(text)
+
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(text, fields[0]))
+}
+
+// parseTrackArgs разбирает аргументы команды /track: <url> [tags:a,b] [filter:f1,f2].
+// Аргументы без префикса после ссылки считаются тегами.
+func parseTrackArgs(args []string) (rawURL string, tags, filters []string) {
+	tags, filters = []string{}, []string{}
+
+	if len(args) == 0 {
+		return "", tags, filters
+	}
+
+	for _, arg := range args[1:] {
+		switch {
+		case strings.HasPrefix(arg, "tags:"), strings.HasPrefix(arg, "tag:"):
+			tags = append(tags, splitList(arg[strings.Index(arg, ":")+1:])...)
+		case strings.HasPrefix(arg, "filters:"), strings.HasPrefix(arg, "filter:"):
+			filters = append(filters, splitList(arg[strings.Index(arg, ":")+1:])...)
+		default:
+			tags = append(tags, arg)
+		}
+	}
+
+	return args[0], tags, filters
+}
+
+func splitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
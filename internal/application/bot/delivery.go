@@ -0,0 +1,239 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	modeUsageText = "Использование:\n" +
+		"/mode immediate - присылать обновления сразу\n" +
+		"/mode hourly - присылать сводку раз в час\n" +
+		"/mode daily 09:00 - присылать сводку раз в день в указанное время\n" +
+		"/mode tag <тег> immediate|hourly|daily|default - режим для ссылок с тегом\n" +
+		"/timezone Europe/Moscow или /timezone +03:00 - часовой пояс для ежедневной сводки"
+	timezoneUsageText = "Укажите часовой пояс, например: /timezone Europe/Moscow или /timezone +03:00"
+	quietUsageText    = "Использование:\n" +
+		"/quiet 23:00-08:00 - не присылать уведомления ночью, накопленные придут одним сообщением утром\n" +
+		"/quiet 23:00-08:00 Europe/Moscow - то же с указанием часового пояса\n" +
+		"/quiet off - выключить тихие часы\n" +
+		"Ссылки с тегом в режиме immediate (/mode tag <тег> immediate) доставляются и в тихие часы"
+	quietOff       = "off"
+	tagModeDefault = "default"
+)
+
+var deliveryModeNames = map[domain.DeliveryMode]string{
+	domain.DeliveryImmediate: "сразу",
+	domain.DeliveryHourly:    "сводка раз в час",
+	domain.DeliveryDaily:     "сводка раз в день",
+}
+
+// DigestSend отправляет пользователю сводку накопленных обновлений.
+func (bot *Bot) DigestSend(ctx context.Context, digest *domain.Digest) {
+	for _, message := range formatDigest(digest, bot.notificationFormat.ParseMode) {
+		bot.tgAPI.SendFormattedMessage(ctx, digest.TgID, message, bot.notificationFormat)
+	}
+}
+
+func (bot *Bot) commandMode(ctx context.Context, tgID int64, args []string) string {
+	if len(args) == 0 {
+		return bot.showSettings(ctx, tgID)
+	}
+
+	if args[0] == "tag" {
+		return bot.commandModeTag(ctx, tgID, args[1:])
+	}
+
+	mode, ok := domain.ParseDeliveryMode(args[0])
+	if !ok {
+		return "Неизвестный режим доставки.\n\n" + modeUsageText
+	}
+
+	update := domain.DeliverySettingsUpdate{Mode: &mode}
+
+	if len(args) > 1 {
+		if mode != domain.DeliveryDaily {
+			return "Время можно указать только для режима daily.\n\n" + modeUsageText
+		}
+
+		digestTime, err := domain.ParseDigestTime(args[1])
+		if err != nil {
+			return "Время сводки нужно указать в формате ЧЧ:ММ, например 09:00"
+		}
+
+		update.DigestTime = &digestTime
+	}
+
+	settings, err := bot.scrapper.UpdateSettings(ctx, tgID, &update)
+	if err != nil {
+		slog.Error("Command /mode failed", "error", err, "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /mode done", "chatId", tgID, "mode", settings.Mode)
+
+	return "Режим доставки изменён: " + formatDeliveryMode(&settings, settings.Mode)
+}
+
+func (bot *Bot) commandModeTag(ctx context.Context, tgID int64, args []string) string {
+	if len(args) != 2 {
+		return modeUsageText
+	}
+
+	tag := args[0]
+
+	var mode domain.DeliveryMode
+
+	if args[1] != tagModeDefault {
+		parsedMode, ok := domain.ParseDeliveryMode(args[1])
+		if !ok {
+			return "Неизвестный режим доставки.\n\n" + modeUsageText
+		}
+
+		mode = parsedMode
+	}
+
+	err := bot.scrapper.SetTagMode(ctx, tgID, tag, mode)
+	if err != nil {
+		slog.Error("Command /mode tag failed", "error", err, "chatId", tgID, "tag", tag)
+		return errorText
+	}
+
+	slog.Info("Command /mode tag done", "chatId", tgID, "tag", tag, "mode", mode)
+
+	if mode == "" {
+		return "Для тега " + tag + " используется общий режим доставки"
+	}
+
+	return "Режим доставки для тега " + tag + ": " + deliveryModeNames[mode]
+}
+
+func (bot *Bot) commandTimezone(ctx context.Context, tgID int64, args []string) string {
+	if len(args) == 0 {
+		settings, err := bot.scrapper.GetSettings(ctx, tgID)
+		if err != nil {
+			slog.Error("Command /timezone failed", "error", err, "chatId", tgID)
+			return errorText
+		}
+
+		return "Часовой пояс: " + settings.Timezone + "\n" + timezoneUsageText
+	}
+
+	timezone := args[0]
+	if _, err := domain.LoadTimezone(timezone); err != nil {
+		return "Неизвестный часовой пояс. " + timezoneUsageText
+	}
+
+	settings, err := bot.scrapper.UpdateSettings(ctx, tgID, &domain.DeliverySettingsUpdate{Timezone: &timezone})
+	if err != nil {
+		slog.Error("Command /timezone failed", "error", err, "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /timezone done", "chatId", tgID, "timezone", settings.Timezone)
+
+	return "Часовой пояс изменён: " + settings.Timezone
+}
+
+func (bot *Bot) commandQuiet(ctx context.Context, tgID int64, args []string) string {
+	if len(args) == 0 {
+		settings, err := bot.scrapper.GetSettings(ctx, tgID)
+		if err != nil {
+			slog.Error("Command /quiet failed", "error", err, "chatId", tgID)
+			return errorText
+		}
+
+		return formatQuietHours(&settings) + "\n\n" + quietUsageText
+	}
+
+	var update domain.DeliverySettingsUpdate
+
+	if args[0] == quietOff {
+		update.Quiet = &domain.QuietHours{}
+	} else {
+		quiet, err := domain.ParseQuietHours(args[0])
+		if err != nil {
+			return "Тихие часы нужно указать в формате ЧЧ:ММ-ЧЧ:ММ.\n\n" + quietUsageText
+		}
+
+		if quiet.IsEmpty() {
+			return "Начало и конец тихих часов должны различаться"
+		}
+
+		update.Quiet = &quiet
+	}
+
+	if len(args) > 1 {
+		timezone := args[1]
+		if _, err := domain.LoadTimezone(timezone); err != nil {
+			return "Неизвестный часовой пояс. " + timezoneUsageText
+		}
+
+		update.Timezone = &timezone
+	}
+
+	settings, err := bot.scrapper.UpdateSettings(ctx, tgID, &update)
+	if err != nil {
+		slog.Error("Command /quiet failed", "error", err, "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /quiet done", "chatId", tgID, "quietHours", settings.Quiet.String())
+
+	return formatQuietHours(&settings)
+}
+
+func (bot *Bot) showSettings(ctx context.Context, tgID int64) string {
+	settings, err := bot.scrapper.GetSettings(ctx, tgID)
+	if err != nil {
+		slog.Error("Command /mode failed", "error", err, "chatId", tgID)
+		return errorText
+	}
+
+	lines := []string{
+		"Режим доставки: " + formatDeliveryMode(&settings, settings.Mode),
+		"Часовой пояс: " + settings.Timezone,
+		formatQuietHours(&settings),
+	}
+
+	if len(settings.TagModes) > 0 {
+		tags := make([]string, 0, len(settings.TagModes))
+		for tag := range settings.TagModes {
+			tags = append(tags, tag)
+		}
+
+		sort.Strings(tags)
+
+		lines = append(lines, "Режимы тегов:")
+		for _, tag := range tags {
+			lines = append(lines, "  "+tag+" — "+formatDeliveryMode(&settings, settings.TagModes[tag]))
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n\n" + modeUsageText
+}
+
+func formatDeliveryMode(settings *domain.DeliverySettings, mode domain.DeliveryMode) string {
+	name, ok := deliveryModeNames[mode]
+	if !ok {
+		return string(mode)
+	}
+
+	if mode == domain.DeliveryDaily {
+		name += " в " + domain.FormatDigestTime(settings.DigestTime)
+	}
+
+	return name
+}
+
+func formatQuietHours(settings *domain.DeliverySettings) string {
+	if settings.Quiet.IsEmpty() {
+		return "Тихие часы выключены"
+	}
+
+	return "Тихие часы: " + settings.Quiet.String() + " (" + settings.Timezone + ")"
+}
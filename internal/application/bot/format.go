@@ -0,0 +1,249 @@
+package bot
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"LinkTracker/internal/domain"
+)
+
+const updateTimeLayout = "02.01.2006 15:04 MST"
+
+// markup описывает, как оформить элементы уведомления в конкретной разметке Telegram.
+type markup struct {
+	escape func(text string) string
+	bold   func(text string) string
+	link   func(text, linkURL string) string
+	quote  func(text string) string
+}
+
+var markups = map[domain.ParseMode]markup{
+	domain.ParseModePlain: {
+		escape: func(text string) string { return text },
+		bold:   func(text string) string { return text },
+		link: func(text, linkURL string) string {
+			if text == linkURL {
+				return text
+			}
+
+			return text + " (" + linkURL + ")"
+		},
+		quote: func(text string) string { return text },
+	},
+	domain.ParseModeHTML: {
+		escape: html.EscapeString,
+		bold:   func(text string) string { return "<b>" + text + "</b>" },
+		link: func(text, linkURL string) string {
+			return `<a href="` + html.EscapeString(linkURL) + `">` + html.EscapeString(text) + "</a>"
+		},
+		quote: func(text string) string { return "<blockquote>" + text + "</blockquote>" },
+	},
+	domain.ParseModeMarkdownV2: {
+		escape: escapeMarkdownV2,
+		bold:   func(text string) string { return "*" + text + "*" },
+		link: func(text, linkURL string) string {
+			return "[" + escapeMarkdownV2(text) + "](" + escapeMarkdownV2URL(linkURL) + ")"
+		},
+		quote: func(text string) string { return ">" + strings.ReplaceAll(text, "\n", "\n>") },
+	},
+}
+
+var (
+	markdownV2Replacer = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`, "~", `\~`,
+		"`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`, "|", `\|`,
+		"{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+	)
+	markdownV2URLReplacer = strings.NewReplacer(`\`, `\\`, ")", `\)`)
+)
+
+func escapeMarkdownV2(text string) string {
+	return markdownV2Replacer.Replace(text)
+}
+
+func escapeMarkdownV2URL(linkURL string) string {
+	return markdownV2URLReplacer.Replace(linkURL)
+}
+
+// formatUpdate оформляет уведомление об обновлении: кликабельные ссылка и заголовок события,
+// автор со ссылкой на профиль, время и превью текста. Если сведений о событии нет,
+// используется текстовое описание.
+func formatUpdate(update *domain.LinkUpdate, parseMode domain.ParseMode) string {
+	m, ok := markups[parseMode]
+	if !ok {
+		m = markups[domain.ParseModePlain]
+	}
+
+	lines := []string{"Было обновление: " + formatLinkText(m, update.Link.URL, update.Link.URL)}
+
+	details := &update.Details
+	if details.IsEmpty() {
+		if update.Description != "" {
+			lines = append(lines, m.escape(update.Description))
+		}
+
+		return strings.Join(lines, "\n")
+	}
+
+	if details.Title != "" {
+		title := formatLinkText(m, details.Title, details.URL)
+		if details.Kind != "" {
+			title = m.bold(m.escape(details.Kind+":")) + " " + title
+		}
+
+		lines = append(lines, title)
+	}
+
+	if details.Author != "" {
+		lines = append(lines, m.escape("Автор: ")+formatLinkText(m, details.Author, details.AuthorURL))
+	}
+
+	if !details.CreatedAt.IsZero() {
+		lines = append(lines, m.escape("Создано: "+details.CreatedAt.In(time.UTC).Format(updateTimeLayout)))
+	}
+
+	if details.Preview != "" {
+		lines = append(lines, m.quote(m.escape(details.Preview)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// formatLinkText оформляет text ссылкой, если linkURL — корректный http(s) адрес.
+func formatLinkText(m markup, text, linkURL string) string {
+	parsedURL, err := url.Parse(linkURL)
+	if linkURL == "" || err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return m.escape(text)
+	}
+
+	return m.link(text, linkURL)
+}
+
+// maxMessageLength — ограничение Telegram на длину текста одного сообщения.
+const maxMessageLength = 4096
+
+var digestTitles = map[domain.DeliveryMode]string{
+	domain.DeliveryHourly: "Сводка обновлений за час",
+	domain.DeliveryDaily:  "Сводка обновлений за день",
+	domain.DeliveryQuiet:  "Обновления за тихие часы",
+}
+
+// formatDigest оформляет сводку: обновления сгруппированы по ссылкам, по строке на событие.
+// Длинная сводка делится на несколько сообщений по границам групп и строк.
+func formatDigest(digest *domain.Digest, parseMode domain.ParseMode) []string {
+	m, ok := markups[parseMode]
+	if !ok {
+		m = markups[domain.ParseModePlain]
+	}
+
+	title, ok := digestTitles[digest.Mode]
+	if !ok {
+		title = "Сводка обновлений"
+	}
+
+	var (
+		order  []string
+		groups = make(map[string][]*domain.LinkUpdate)
+	)
+
+	for i := range digest.Updates {
+		linkURL := digest.Updates[i].Link.URL
+		if _, ok := groups[linkURL]; !ok {
+			order = append(order, linkURL)
+		}
+
+		groups[linkURL] = append(groups[linkURL], &digest.Updates[i])
+	}
+
+	blocks := []string{m.bold(m.escape(fmt.Sprintf("%s: %d", title, len(digest.Updates))))}
+
+	for _, linkURL := range order {
+		updates := groups[linkURL]
+		lines := []string{formatLinkText(m, linkURL, linkURL) + m.escape(fmt.Sprintf(" (%d)", len(updates)))}
+
+		for _, update := range updates {
+			lines = append(lines, m.escape("• ")+formatDigestItem(m, update))
+		}
+
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+
+	return splitMessage(blocks, maxMessageLength)
+}
+
+func formatDigestItem(m markup, update *domain.LinkUpdate) string {
+	details := &update.Details
+	if details.IsEmpty() {
+		description, _, _ := strings.Cut(update.Description, "\n")
+		return m.escape(description)
+	}
+
+	var parts []string
+
+	if details.Title != "" {
+		title := formatLinkText(m, details.Title, details.URL)
+		if details.Kind != "" {
+			title = m.bold(m.escape(details.Kind+":")) + " " + title
+		}
+
+		parts = append(parts, title)
+	}
+
+	if details.Author != "" {
+		parts = append(parts, formatLinkText(m, details.Author, details.AuthorURL))
+	}
+
+	if !details.CreatedAt.IsZero() {
+		parts = append(parts, m.escape(details.CreatedAt.In(time.UTC).Format(updateTimeLayout)))
+	}
+
+	return strings.Join(parts, m.escape(" — "))
+}
+
+// splitMessage собирает блоки в сообщения не длиннее limit символов. Блок, который не помещается
+// в сообщение целиком, делится по строкам.
+func splitMessage(blocks []string, limit int) []string {
+	var (
+		messages []string
+		current  strings.Builder
+	)
+
+	appendPart := func(part, separator string) {
+		if current.Len() > 0 && utf8.RuneCountInString(current.String())+utf8.RuneCountInString(separator+part) > limit {
+			messages = append(messages, current.String())
+			current.Reset()
+		}
+
+		if current.Len() > 0 {
+			current.WriteString(separator)
+		}
+
+		current.WriteString(part)
+	}
+
+	for _, block := range blocks {
+		if utf8.RuneCountInString(block) <= limit {
+			appendPart(block, "\n\n")
+			continue
+		}
+
+		for i, line := range strings.Split(block, "\n") {
+			separator := "\n"
+			if i == 0 {
+				separator = "\n\n"
+			}
+
+			appendPart(line, separator)
+		}
+	}
+
+	if current.Len() > 0 {
+		messages = append(messages, current.String())
+	}
+
+	return messages
+}
@@ -0,0 +1,103 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"LinkTracker/internal/domain"
+)
+
+const adminOnlyText = "В этом чате менять подписки и настройки могут только администраторы"
+
+// adminCommands — команды, которые меняют подписки или настройки чата. В группах при включённом
+// groupAdminOnly они доступны только администраторам, /help, /list и /export доступны всем.
+var adminCommands = map[string]bool{
+	"/start":     true,
+	"/track":     true,
+	"/untrack":   true,
+	"/settags":   true,
+	"/renametag": true,
+	"/import":    true,
+	"/mode":      true,
+	"/timezone":  true,
+	"/quiet":     true,
+	"/pause":     true,
+	"/resume":    true,
+	"/cancel":    true,
+}
+
+// HandleChatMessage обрабатывает входящее сообщение или файл с учётом типа чата. Подписки принадлежат
+// чату, поэтому в группе все участники работают с общим списком ссылок.
+func (bot *Bot) HandleChatMessage(ctx context.Context, msg *domain.Message) string {
+	if msg.ChatType.IsGroup() {
+		if responseText, ok := bot.checkGroupMessage(ctx, msg); !ok {
+			return responseText
+		}
+	}
+
+	// Токен, отправленный в группу или канал, увидят все их участники
+	if command, _ := splitCommand(msg.Text); command == "/token" && msg.ChatType != domain.ChatPrivate {
+		return privateOnlyText
+	}
+
+	if msg.Document != nil {
+		return bot.HandleDocument(ctx, msg)
+	}
+
+	return bot.handleUserMessage(ctx, msg.TgID, msg.UserID, msg.Text)
+}
+
+// checkGroupMessage решает, обрабатывать ли сообщение из группы. Команды для других ботов и сообщения
+// вне диалога с ботом или из диалога другого участника пропускаются, а команды, меняющие подписки,
+// при включённом groupAdminOnly выполняются только для администраторов чата.
+func (bot *Bot) checkGroupMessage(ctx context.Context, msg *domain.Message) (responseText string, ok bool) {
+	command := ""
+
+	if firstRune, _ := utf8.DecodeRuneInString(msg.Text); firstRune == '/' {
+		name, mention := splitMention(strings.Fields(msg.Text)[0])
+		if mention != "" && !strings.EqualFold(mention, bot.tgAPI.BotUsername()) {
+			return "", false
+		}
+
+		command = name
+	} else if _, userID, _, err := bot.scrapper.GetState(ctx, msg.TgID); err != nil || userID != msg.UserID {
+		// Обычная переписка участников группы интересна боту только внутри диалога, который начал
+		// сам отправитель
+		return "", false
+	}
+
+	// Ответы внутри диалога продолжают команду, поэтому проверяются так же, как она
+	if !bot.groupAdminOnly || (command != "" && !adminCommands[command]) {
+		return "", true
+	}
+
+	if bot.isChatAdmin(ctx, msg) {
+		return "", true
+	}
+
+	slog.Info("Group command rejected", "chatId", msg.TgID, "userId", msg.UserID, "command", command)
+
+	if command == "" {
+		return "", false
+	}
+
+	return adminOnlyText, false
+}
+
+// isChatAdmin проверяет права отправителя. Сообщения от имени самого чата отправляют только
+// администраторы, поэтому они не требуют запроса к Telegram.
+func (bot *Bot) isChatAdmin(ctx context.Context, msg *domain.Message) bool {
+	if msg.UserID == 0 {
+		return true
+	}
+
+	isAdmin, err := bot.tgAPI.IsChatAdmin(ctx, msg.TgID, msg.UserID)
+	if err != nil {
+		slog.Error("isChatAdmin failed", "error", err.Error(), "chatId", msg.TgID, "userId", msg.UserID)
+		return false
+	}
+
+	return isAdmin
+}
@@ -0,0 +1,123 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	historyUsageText    = "Использование: /history <ссылка или linkID> [количество], например /history 12 10"
+	historyEmptyText    = "Для этой ссылки пока нет сохранённых обновлений"
+	historyNotFoundText = errorText + ". Данная ссылка не найдена"
+	defaultHistorySize  = 5
+	maxHistorySize      = 20
+	historyTimeLayout   = "02.01.2006 15:04 UTC"
+)
+
+func (bot *Bot) commandHistory(ctx context.Context, tgID int64, args []string) string {
+	if len(args) == 0 || len(args) > 2 {
+		return historyUsageText
+	}
+
+	limit := int64(defaultHistorySize)
+
+	if len(args) == 2 {
+		size, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || size <= 0 {
+			return historyUsageText
+		}
+
+		limit = min(size, maxHistorySize)
+	}
+
+	link, err := bot.findLink(ctx, tgID, args[0])
+	if err != nil {
+		slog.Error("Command /history failed", "error", err.Error(), "chatId", tgID)
+
+		if errors.As(err, &domain.ErrLinkNotExist{}) {
+			return historyNotFoundText
+		}
+
+		return errorText
+	}
+
+	records, total, err := bot.scrapper.GetLinkUpdates(ctx, tgID, link.ID, limit, 0)
+	if err != nil {
+		slog.Error("Command /history failed", "error", err.Error(), "chatId", tgID, "linkId", link.ID)
+
+		if errors.As(err, &domain.ErrLinkNotExist{}) {
+			return historyNotFoundText
+		}
+
+		return errorText
+	}
+
+	slog.Info("Command /history done", "chatId", tgID, "linkId", link.ID, "updates", len(records))
+
+	if len(records) == 0 {
+		return historyEmptyText
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Последние обновления %s (%d из %d):\n", link.URL, len(records), total)
+
+	for i := range records {
+		sb.WriteString("\n" + formatUpdateRecord(&records[i]) + "\n")
+	}
+
+	return sb.String()
+}
+
+// formatUpdateRecord кратко описывает событие из истории: время, тип с заголовком, автора и адрес.
+func formatUpdateRecord(record *domain.UpdateRecord) string {
+	details := &record.Details
+
+	lines := []string{updateRecordTime(record).Format(historyTimeLayout)}
+
+	if summary := updateRecordSummary(record); summary != "" {
+		lines = append(lines, summary)
+	}
+
+	if details.Author != "" {
+		lines = append(lines, "Автор: "+details.Author)
+	}
+
+	if details.URL != "" {
+		lines = append(lines, details.URL)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// updateRecordSummary возвращает тип и заголовок события, а если их нет — описание обновления.
+func updateRecordSummary(record *domain.UpdateRecord) string {
+	details := &record.Details
+
+	switch {
+	case details.Kind != "" && details.Title != "":
+		return details.Kind + ": " + details.Title
+	case details.Kind != "":
+		return details.Kind
+	case details.Title != "":
+		return details.Title
+	default:
+		return record.Description
+	}
+}
+
+// updateRecordTime возвращает время события, а если источник его не сообщил — время обнаружения.
+func updateRecordTime(record *domain.UpdateRecord) time.Time {
+	if record.Details.CreatedAt.IsZero() {
+		return record.DetectedAt.UTC()
+	}
+
+	return record.Details.CreatedAt.UTC()
+}
@@ -0,0 +1,521 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"strings"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatText = "txt"
+
+	maxImportReportErrors = 30
+
+	importPromptText = "Отправьте список ссылок сообщением (по одной на строку, в формате " +
+		"<ссылка> tags:тег1,тег2 filter:фильтр1,фильтр2) или файлом .txt, .csv или .opml"
+	importUsageText = "Чтобы импортировать ссылки из файла, отправьте команду /import, а затем файл"
+)
+
+// importEntry — ссылка из импортируемого списка вместе с номером строки, на которой она записана.
+// Причина, по которой ссылку не удалось импортировать, записывается в reason.
+type importEntry struct {
+	line   int
+	link   domain.Link
+	reason string
+}
+
+type opmlOutline struct {
+	line     int
+	text     string
+	url      string
+	category string
+}
+
+func (bot *Bot) commandImport(ctx context.Context, tgID, userID int64, text string) string {
+	if body := commandBody(text); body != "" {
+		slog.Info("Command /import with list execution", "chatId", tgID)
+		return bot.importLinks(ctx, tgID, parseImportText(body))
+	}
+
+	err := bot.scrapper.CreateState(ctx, tgID, userID, WaitingImport)
+	if err != nil {
+		slog.Error("Command /import failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /import done", "chatId", tgID)
+
+	return importPromptText
+}
+
+func (bot *Bot) commandExport(ctx context.Context, tgID int64, args []string) string {
+	format := exportFormatCSV
+	if len(args) > 0 {
+		format = strings.ToLower(args[0])
+	}
+
+	if format != exportFormatCSV && format != exportFormatText {
+		return "Поддерживаются форматы csv и txt, например: /export txt"
+	}
+
+	links, err := bot.scrapper.GetLinks(ctx, tgID)
+	if err != nil {
+		slog.Error("Command /export failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	if len(links) == 0 {
+		responseText := "Список отслеживаемых ссылок пуст. Добавьте ссылки с помощью /track или /import"
+		return responseText
+	}
+
+	var data []byte
+
+	switch format {
+	case exportFormatText:
+		data = exportText(links)
+	default:
+		data, err = exportCSV(links)
+		if err != nil {
+			slog.Error("Command /export failed", "error", err.Error(), "chatId", tgID)
+			return errorText
+		}
+	}
+
+	bot.tgAPI.SendDocument(ctx, tgID, "links."+format, data)
+
+	slog.Info("Command /export done", "chatId", tgID, "format", format, "links", len(links))
+
+	return ""
+}
+
+func (bot *Bot) stateWaitImport(ctx context.Context, tgID int64, text string) string {
+	err := bot.scrapper.DeleteState(ctx, tgID)
+	if err != nil {
+		slog.Error("stateWaitImport failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	return bot.importLinks(ctx, tgID, parseImportText(text))
+}
+
+// HandleDocument импортирует ссылки из присланного файла. Файл принимается после команды /import
+// или вместе с ней в подписи.
+func (bot *Bot) HandleDocument(ctx context.Context, msg *domain.Message) string {
+	if command, _ := splitCommand(msg.Text); command != "/import" {
+		state, _, _, err := bot.scrapper.GetState(ctx, msg.TgID)
+		if err != nil || state != WaitingImport {
+			return importUsageText
+		}
+
+		err = bot.scrapper.DeleteState(ctx, msg.TgID)
+		if err != nil {
+			slog.Error("HandleDocument failed", "error", err.Error(), "chatId", msg.TgID)
+			return errorText
+		}
+	}
+
+	data, err := bot.tgAPI.DownloadFile(ctx, msg.Document.FileID)
+	if err != nil {
+		slog.Error("HandleDocument failed", "error", err.Error(), "chatId", msg.TgID)
+
+		if errors.As(err, &domain.ErrFileTooLarge{}) {
+			return errorText + ". Файл слишком большой"
+		}
+
+		return errorText
+	}
+
+	entries, err := parseImportFile(msg.Document.FileName, data)
+	if err != nil {
+		slog.Error("HandleDocument failed", "error", err.Error(), "chatId", msg.TgID)
+		return errorText + ". Не удалось разобрать файл: " + err.Error()
+	}
+
+	slog.Info("HandleDocument parsed file", "chatId", msg.TgID, "fileName", msg.Document.FileName,
+		"entries", len(entries))
+
+	return bot.importLinks(ctx, msg.TgID, entries)
+}
+
+// importLinks проверяет ссылки, отправляет поддерживаемые в scrapper и возвращает отчёт
+// с причиной ошибки для каждой строки, которую не удалось импортировать.
+func (bot *Bot) importLinks(ctx context.Context, tgID int64, entries []importEntry) string {
+	if len(entries) == 0 {
+		return "Не найдено ни одной ссылки для импорта"
+	}
+
+	if len(entries) > domain.MaxImportLinks {
+		slog.Info("importLinks rejected", "chatId", tgID, "entries", len(entries))
+
+		return fmt.Sprintf("Слишком много ссылок: %d. За один импорт можно добавить не больше %d, "+
+			"разделите список на части", len(entries), domain.MaxImportLinks)
+	}
+
+	valid := make([]int, 0, len(entries))
+	links := make([]domain.Link, 0, len(entries))
+
+	for i := range entries {
+		if entries[i].reason != "" {
+			continue
+		}
+
+		ok, validURL := validateLink(entries[i].link.URL)
+		if !ok {
+			entries[i].reason = "неподдерживаемая ссылка"
+			continue
+		}
+
+		entries[i].link.URL = validURL
+		valid = append(valid, i)
+		links = append(links, entries[i].link)
+	}
+
+	if len(links) > 0 {
+		bot.addImportedLinks(ctx, tgID, entries, valid, links)
+	}
+
+	slog.Info("importLinks done", "chatId", tgID, "entries", len(entries))
+
+	return importReport(entries)
+}
+
+// addImportedLinks отправляет ссылки в scrapper одним запросом и записывает в entries[valid[i]]
+// причину, по которой не удалось добавить links[i].
+func (bot *Bot) addImportedLinks(ctx context.Context, tgID int64, entries []importEntry, valid []int, links []domain.Link) {
+	results, err := bot.scrapper.AddLinks(ctx, tgID, links)
+	if err == nil && len(results) != len(links) {
+		err = fmt.Errorf("expected %d results, got %d", len(links), len(results))
+	}
+
+	if err != nil {
+		slog.Error("importLinks failed", "error", err.Error(), "chatId", tgID)
+
+		for _, i := range valid {
+			entries[i].reason = "не удалось добавить ссылку"
+		}
+
+		return
+	}
+
+	for j, result := range results {
+		switch result.Error {
+		case "":
+		case domain.ErrLinkAlreadyTracking{}.Error():
+			entries[valid[j]].reason = "ссылка уже отслеживается"
+		case domain.ErrWrongURL{}.Error(), domain.ErrUnsupportedHost{}.Error():
+			entries[valid[j]].reason = "ссылка не поддерживается"
+		case domain.ErrSourceNotFound{}.Error():
+			entries[valid[j]].reason = "репозиторий или вопрос не найден"
+		default:
+			entries[valid[j]].reason = "не удалось добавить ссылку"
+		}
+	}
+}
+
+func importReport(entries []importEntry) string {
+	var failed []importEntry
+
+	for _, entry := range entries {
+		if entry.reason != "" {
+			failed = append(failed, entry)
+		}
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Импорт завершён. Добавлено: %d, с ошибками: %d", len(entries)-len(failed), len(failed))
+
+	if len(failed) == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("\n\nОшибки:\n")
+
+	for i, entry := range failed {
+		if i == maxImportReportErrors {
+			fmt.Fprintf(&sb, "… и ещё %d\n", len(failed)-maxImportReportErrors)
+			break
+		}
+
+		if entry.link.URL != "" {
+			fmt.Fprintf(&sb, "строка %d: %s — %s\n", entry.line, entry.link.URL, entry.reason)
+		} else {
+			fmt.Fprintf(&sb, "строка %d: %s\n", entry.line, entry.reason)
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// parseImportFile выбирает формат по расширению файла, а для файлов без известного
+// расширения — по содержимому.
+func parseImportFile(fileName string, data []byte) ([]importEntry, error) {
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".csv":
+		return parseImportCSV(data)
+	case ".opml", ".xml":
+		return parseImportOPML(data)
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("<?xml")) || bytes.HasPrefix(trimmed, []byte("<opml")) {
+		return parseImportOPML(data)
+	}
+
+	return parseImportText(string(data)), nil
+}
+
+// parseImportText разбирает список, в котором каждая строка записана как аргументы /track.
+// Пустые строки и строки, начинающиеся с '#', пропускаются.
+func parseImportText(text string) []importEntry {
+	var entries []importEntry
+
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rawURL, tags, filters := parseTrackArgs(strings.Fields(line))
+		entries = append(entries, importEntry{
+			line: i + 1,
+			link: domain.Link{URL: rawURL, Tags: tags, Filters: filters},
+		})
+	}
+
+	return entries
+}
+
+// parseImportCSV разбирает CSV с колонками url, tags, filters. Теги и фильтры внутри колонки
+// разделяются пробелами, запятыми или точками с запятой. Строка заголовка необязательна.
+func parseImportCSV(data []byte) ([]importEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var entries []importEntry
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		rawURL := strings.TrimSpace(record[0])
+
+		if rawURL == "" || strings.HasPrefix(rawURL, "#") ||
+			(len(entries) == 0 && (strings.EqualFold(rawURL, "url") || strings.EqualFold(rawURL, "link"))) {
+			continue
+		}
+
+		entry := importEntry{line: line, link: domain.Link{URL: rawURL, Tags: []string{}, Filters: []string{}}}
+
+		if len(record) > 1 {
+			entry.link.Tags = splitCSVList(record[1])
+		}
+
+		if len(record) > 2 {
+			entry.link.Filters = splitCSVList(record[2])
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parseImportOPML разбирает OPML-файл. Ссылкой считается htmlUrl, а если его нет — xmlUrl.
+// Теги берутся из атрибута category и из названий папок, в которые вложена запись.
+func parseImportOPML(data []byte) ([]importEntry, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		entries  []importEntry
+		folders  []string
+		isFeed   []bool
+		seenOPML bool
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if element.Name.Local == "opml" {
+				seenOPML = true
+			}
+
+			if element.Name.Local != "outline" {
+				continue
+			}
+
+			line, _ := decoder.InputPos()
+			outline := parseOPMLOutline(element, line)
+
+			if outline.url == "" {
+				folders = append(folders, outline.text)
+				isFeed = append(isFeed, false)
+
+				continue
+			}
+
+			isFeed = append(isFeed, true)
+			entries = append(entries, outline.toImportEntry(folders))
+		case xml.EndElement:
+			if element.Name.Local != "outline" || len(isFeed) == 0 {
+				continue
+			}
+
+			if !isFeed[len(isFeed)-1] {
+				folders = folders[:len(folders)-1]
+			}
+
+			isFeed = isFeed[:len(isFeed)-1]
+		}
+	}
+
+	if !seenOPML {
+		return nil, errors.New("not an OPML document")
+	}
+
+	return entries, nil
+}
+
+func parseOPMLOutline(element xml.StartElement, line int) opmlOutline {
+	outline := opmlOutline{line: line}
+
+	var xmlURL, htmlURL, title string
+
+	for _, attr := range element.Attr {
+		switch attr.Name.Local {
+		case "text":
+			outline.text = strings.TrimSpace(attr.Value)
+		case "title":
+			title = strings.TrimSpace(attr.Value)
+		case "xmlUrl":
+			xmlURL = strings.TrimSpace(attr.Value)
+		case "htmlUrl":
+			htmlURL = strings.TrimSpace(attr.Value)
+		case "category":
+			outline.category = attr.Value
+		}
+	}
+
+	if outline.text == "" {
+		outline.text = title
+	}
+
+	outline.url = htmlURL
+	if outline.url == "" {
+		outline.url = xmlURL
+	}
+
+	return outline
+}
+
+func (o opmlOutline) toImportEntry(folders []string) importEntry {
+	tags := []string{}
+
+	for _, folder := range folders {
+		tags = appendTag(tags, folder)
+	}
+
+	for _, category := range strings.Split(o.category, ",") {
+		tags = appendTag(tags, strings.Trim(strings.TrimSpace(category), "/"))
+	}
+
+	return importEntry{line: o.line, link: domain.Link{URL: o.url, Tags: tags, Filters: []string{}}}
+}
+
+// appendTag добавляет тег, заменяя пробелы на '_': теги в боте разделяются пробелами.
+func appendTag(tags []string, tag string) []string {
+	tag = strings.Join(strings.Fields(tag), "_")
+	if tag == "" {
+		return tags
+	}
+
+	for _, existing := range tags {
+		if existing == tag {
+			return tags
+		}
+	}
+
+	return append(tags, tag)
+}
+
+func splitCSVList(value string) []string {
+	items := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == ',' || r == ';'
+	})
+	if items == nil {
+		return []string{}
+	}
+
+	return items
+}
+
+// exportCSV выгружает ссылки в формате, который принимает parseImportCSV.
+func exportCSV(links []domain.Link) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write([]string{"url", "tags", "filters"}); err != nil {
+		return nil, err
+	}
+
+	for _, link := range links {
+		err := writer.Write([]string{link.URL, strings.Join(link.Tags, " "), strings.Join(link.Filters, " ")})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
+
+// exportText выгружает ссылки в формате аргументов /track, который принимает parseImportText.
+func exportText(links []domain.Link) []byte {
+	var sb strings.Builder
+
+	for _, link := range links {
+		sb.WriteString(link.URL)
+
+		if len(link.Tags) > 0 {
+			sb.WriteString(" tags:" + strings.Join(link.Tags, ","))
+		}
+
+		if len(link.Filters) > 0 {
+			sb.WriteString(" filter:" + strings.Join(link.Filters, ","))
+		}
+
+		sb.WriteString("\n")
+	}
+
+	return []byte(sb.String())
+}
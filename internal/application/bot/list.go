@@ -0,0 +1,305 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	callbackList = "list"
+
+	listSortTag    = "tag"
+	listSortURL    = "url"
+	listSortRecent = "recent"
+
+	listPageSize = 20
+	// listPageTextLimit оставляет запас до maxMessageLength под заголовок и номер страницы.
+	listPageTextLimit = 3500
+	// maxCallbackDataLength — ограничение Telegram на размер данных inline-кнопки в байтах.
+	maxCallbackDataLength = 64
+
+	listUsageText = "Использование: /list [тег] [страница] [sort:recent|url|tag], например /list 2 или " +
+		"/list #work sort:recent"
+	emptyListText = "Список отслеживаемых ссылок пуст. Добавьте ссылки с помощью /track"
+	untaggedGroup = "Без тегов"
+)
+
+// listQuery описывает запрошенную страницу списка ссылок. Страницы нумеруются с нуля.
+type listQuery struct {
+	tag  string
+	sort string
+	page int
+}
+
+// listEntry — строка списка и группа, под заголовком которой она выводится. У несгруппированного списка
+// группа пустая.
+type listEntry struct {
+	group string
+	line  string
+}
+
+// parseListArgs разбирает аргументы /list: тег, номер страницы и порядок сортировки в любом порядке.
+func parseListArgs(args []string) (listQuery, bool) {
+	query := listQuery{sort: listSortTag}
+	pageSet := false
+
+	for _, arg := range args {
+		page, pageErr := strconv.Atoi(arg)
+
+		switch {
+		case strings.HasPrefix(arg, "sort:"):
+			query.sort = strings.TrimPrefix(arg, "sort:")
+			if query.sort != listSortTag && query.sort != listSortURL && query.sort != listSortRecent {
+				return listQuery{}, false
+			}
+		case pageErr == nil && !pageSet:
+			if page < 1 {
+				return listQuery{}, false
+			}
+
+			query.page, pageSet = page-1, true
+		case query.tag == "":
+			query.tag = arg
+			if tag, ok := parseTagRef(arg); ok {
+				query.tag = tag
+			}
+		default:
+			return listQuery{}, false
+		}
+	}
+
+	return query, true
+}
+
+func (bot *Bot) commandList(ctx context.Context, tgID int64, args []string) string {
+	query, ok := parseListArgs(args)
+	if !ok {
+		return listUsageText
+	}
+
+	links, err := bot.listLinks(ctx, tgID, query.tag)
+	if err != nil {
+		slog.Error("Command /list failed", "error", err.Error(), "chatId", tgID, "tag", query.tag)
+		return errorText
+	}
+
+	slog.Info("Command /list done", "chatId", tgID, "tag", query.tag, "sort", query.sort, "links", len(links))
+
+	if len(links) == 0 {
+		if query.tag != "" {
+			return tagNotFoundText
+		}
+
+		return emptyListText
+	}
+
+	text, keyboard := renderListPage(links, &query)
+	if len(keyboard) == 0 {
+		return text
+	}
+
+	bot.tgAPI.SendMessageWithKeyboard(ctx, tgID, text, keyboard)
+
+	return ""
+}
+
+// callbackList перелистывает список ссылок, заменяя текст и кнопки исходного сообщения.
+func (bot *Bot) callbackList(ctx context.Context, msg *domain.Message, params []string) {
+	if len(params) < 2 {
+		slog.Error("Unknown callback", "data", msg.CallbackData, "chatId", msg.TgID)
+		return
+	}
+
+	page, err := strconv.Atoi(params[1])
+	if err != nil {
+		slog.Error("callbackList failed", "error", err.Error(), "chatId", msg.TgID)
+		return
+	}
+
+	// Тег может содержать двоеточие, поэтому собирается из всех оставшихся частей
+	query := listQuery{sort: params[0], page: page, tag: strings.Join(params[2:], ":")}
+
+	links, err := bot.listLinks(ctx, msg.TgID, query.tag)
+	if err != nil {
+		slog.Error("callbackList failed", "error", err.Error(), "chatId", msg.TgID)
+		return
+	}
+
+	if len(links) == 0 {
+		bot.tgAPI.EditMessage(ctx, msg.TgID, msg.MessageID, emptyListText, nil)
+		return
+	}
+
+	text, keyboard := renderListPage(links, &query)
+	bot.tgAPI.EditMessage(ctx, msg.TgID, msg.MessageID, text, keyboard)
+}
+
+func (bot *Bot) listLinks(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	if tag == "" {
+		return bot.scrapper.GetLinks(ctx, tgID)
+	}
+
+	return bot.scrapper.GetLinksByTag(ctx, tgID, tag)
+}
+
+// renderListPage возвращает текст страницы query.page и кнопки навигации, если страниц несколько.
+// Номер страницы за пределами списка приводится к ближайшей существующей.
+func renderListPage(links []domain.Link, query *listQuery) (string, domain.InlineKeyboard) {
+	pages := paginateList(listEntries(links, query))
+	query.page = max(0, min(query.page, len(pages)-1))
+
+	var sb strings.Builder
+
+	if query.tag != "" {
+		sb.WriteString("Ссылки с тегом " + query.tag + ":\n")
+	} else {
+		sb.WriteString("Список отслеживаемых ссылок:\n")
+	}
+
+	for i, entry := range pages[query.page] {
+		if entry.group != "" && (i == 0 || entry.group != pages[query.page][i-1].group) {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+
+			sb.WriteString(entry.group + ": \n")
+		}
+
+		sb.WriteString(entry.line + "\n")
+	}
+
+	if len(pages) == 1 {
+		return sb.String(), nil
+	}
+
+	fmt.Fprintf(&sb, "\nСтраница %d из %d", query.page+1, len(pages))
+
+	return sb.String(), listKeyboard(query, len(pages))
+}
+
+// listEntries упорядочивает ссылки. При сортировке по тегам ссылки группируются по тегам в алфавитном
+// порядке, ссылка с несколькими тегами попадает в каждую свою группу, ссылки без тегов идут последними.
+func listEntries(links []domain.Link, query *listQuery) []listEntry {
+	sorted := slices.Clone(links)
+
+	slices.SortStableFunc(sorted, func(a, b domain.Link) int {
+		if query.sort == listSortRecent {
+			if cmp := b.LastUpdated.Compare(a.LastUpdated); cmp != 0 {
+				return cmp
+			}
+		}
+
+		return strings.Compare(a.URL, b.URL)
+	})
+
+	entries := make([]listEntry, 0, len(sorted))
+
+	if query.sort != listSortTag || query.tag != "" {
+		for i := range sorted {
+			entries = append(entries, listEntry{line: formatListLine(&sorted[i], query.sort)})
+		}
+
+		return entries
+	}
+
+	var tags []string
+
+	for i := range sorted {
+		tags = append(tags, sorted[i].Tags...)
+	}
+
+	slices.Sort(tags)
+
+	for _, tag := range slices.Compact(tags) {
+		for i := range sorted {
+			if slices.Contains(sorted[i].Tags, tag) {
+				entries = append(entries, listEntry{group: tag, line: formatListLine(&sorted[i], query.sort)})
+			}
+		}
+	}
+
+	for i := range sorted {
+		if len(sorted[i].Tags) == 0 {
+			entries = append(entries, listEntry{group: untaggedGroup, line: formatListLine(&sorted[i], query.sort)})
+		}
+	}
+
+	return entries
+}
+
+// formatListLine описывает ссылку одной строкой. Слишком длинная строка обрезается, чтобы страница
+// всегда помещалась в одно сообщение.
+func formatListLine(link *domain.Link, sort string) string {
+	line := formatLink(link)
+	if sort == listSortRecent && !link.LastUpdated.IsZero() {
+		line += " Обновлено: " + link.LastUpdated.UTC().Format(pauseTimeLayout)
+	}
+
+	if runes := []rune(line); len(runes) > listPageTextLimit {
+		return string(runes[:listPageTextLimit-1]) + "…"
+	}
+
+	return line
+}
+
+// paginateList делит строки на страницы не больше listPageSize строк и listPageTextLimit символов.
+func paginateList(entries []listEntry) [][]listEntry {
+	var (
+		pages [][]listEntry
+		page  []listEntry
+		size  int
+	)
+
+	for _, entry := range entries {
+		// С запасом считаем, что перед каждой строкой выводится заголовок группы
+		entrySize := utf8.RuneCountInString(entry.group) + utf8.RuneCountInString(entry.line) + len(": \n\n\n")
+
+		if len(page) > 0 && (len(page) == listPageSize || size+entrySize > listPageTextLimit) {
+			pages = append(pages, page)
+			page, size = nil, 0
+		}
+
+		page = append(page, entry)
+		size += entrySize
+	}
+
+	return append(pages, page)
+}
+
+// listKeyboard строит кнопки перехода на соседние страницы. Если тег не помещается в данные кнопки,
+// клавиатура не строится и листать можно командой /list <тег> <страница>.
+func listKeyboard(query *listQuery, pages int) domain.InlineKeyboard {
+	var navigation []domain.InlineButton
+
+	if query.page > 0 {
+		navigation = append(navigation, domain.InlineButton{Text: "◀", Data: listCallbackData(query, query.page-1)})
+	}
+
+	if query.page < pages-1 {
+		navigation = append(navigation, domain.InlineButton{Text: "▶", Data: listCallbackData(query, query.page+1)})
+	}
+
+	for _, button := range navigation {
+		if len(button.Data) > maxCallbackDataLength {
+			return nil
+		}
+	}
+
+	return domain.InlineKeyboard{navigation}
+}
+
+func listCallbackData(query *listQuery, page int) string {
+	data := fmt.Sprintf("%s:%s:%d", callbackList, query.sort, page)
+	if query.tag != "" {
+		data += ":" + query.tag
+	}
+
+	return data
+}
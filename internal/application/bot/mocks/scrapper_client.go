@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ScrapperClient is an autogenerated mock type for the ScrapperClient type
@@ -70,17 +72,77 @@ func (_c *ScrapperClient_AddLink_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
-// CreateState provides a mock function with given fields: ctx, tgID, state
-func (_m *ScrapperClient) CreateState(ctx context.Context, tgID int64, state int) error {
-	ret := _m.Called(ctx, tgID, state)
+// AddLinks provides a mock function with given fields: ctx, tgID, links
+func (_m *ScrapperClient) AddLinks(ctx context.Context, tgID int64, links []domain.Link) ([]domain.LinkImportResult, error) {
+	ret := _m.Called(ctx, tgID, links)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLinks")
+	}
+
+	var r0 []domain.LinkImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []domain.Link) ([]domain.LinkImportResult, error)); ok {
+		return rf(ctx, tgID, links)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []domain.Link) []domain.LinkImportResult); ok {
+		r0 = rf(ctx, tgID, links)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LinkImportResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []domain.Link) error); ok {
+		r1 = rf(ctx, tgID, links)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_AddLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLinks'
+type ScrapperClient_AddLinks_Call struct {
+	*mock.Call
+}
+
+// AddLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - links []domain.Link
+func (_e *ScrapperClient_Expecter) AddLinks(ctx interface{}, tgID interface{}, links interface{}) *ScrapperClient_AddLinks_Call {
+	return &ScrapperClient_AddLinks_Call{Call: _e.mock.On("AddLinks", ctx, tgID, links)}
+}
+
+func (_c *ScrapperClient_AddLinks_Call) Run(run func(ctx context.Context, tgID int64, links []domain.Link)) *ScrapperClient_AddLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]domain.Link))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_AddLinks_Call) Return(_a0 []domain.LinkImportResult, _a1 error) *ScrapperClient_AddLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_AddLinks_Call) RunAndReturn(run func(context.Context, int64, []domain.Link) ([]domain.LinkImportResult, error)) *ScrapperClient_AddLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateState provides a mock function with given fields: ctx, tgID, userID, state
+func (_m *ScrapperClient) CreateState(ctx context.Context, tgID int64, userID int64, state int) error {
+	ret := _m.Called(ctx, tgID, userID, state)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
-		r0 = rf(ctx, tgID, state)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) error); ok {
+		r0 = rf(ctx, tgID, userID, state)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -96,14 +158,15 @@ type ScrapperClient_CreateState_Call struct {
 // CreateState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
+//   - userID int64
 //   - state int
-func (_e *ScrapperClient_Expecter) CreateState(ctx interface{}, tgID interface{}, state interface{}) *ScrapperClient_CreateState_Call {
-	return &ScrapperClient_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, state)}
+func (_e *ScrapperClient_Expecter) CreateState(ctx interface{}, tgID interface{}, userID interface{}, state interface{}) *ScrapperClient_CreateState_Call {
+	return &ScrapperClient_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, userID, state)}
 }
 
-func (_c *ScrapperClient_CreateState_Call) Run(run func(ctx context.Context, tgID int64, state int)) *ScrapperClient_CreateState_Call {
+func (_c *ScrapperClient_CreateState_Call) Run(run func(ctx context.Context, tgID int64, userID int64, state int)) *ScrapperClient_CreateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
 	})
 	return _c
 }
@@ -113,7 +176,7 @@ func (_c *ScrapperClient_CreateState_Call) Return(_a0 error) *ScrapperClient_Cre
 	return _c
 }
 
-func (_c *ScrapperClient_CreateState_Call) RunAndReturn(run func(context.Context, int64, int) error) *ScrapperClient_CreateState_Call {
+func (_c *ScrapperClient_CreateState_Call) RunAndReturn(run func(context.Context, int64, int64, int) error) *ScrapperClient_CreateState_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -212,6 +275,75 @@ func (_c *ScrapperClient_DeleteUser_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetLinkUpdates provides a mock function with given fields: ctx, tgID, linkID, limit, offset
+func (_m *ScrapperClient) GetLinkUpdates(ctx context.Context, tgID int64, linkID int64, limit int64, offset int64) ([]domain.UpdateRecord, int64, error) {
+	ret := _m.Called(ctx, tgID, linkID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkUpdates")
+	}
+
+	var r0 []domain.UpdateRecord
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) ([]domain.UpdateRecord, int64, error)); ok {
+		return rf(ctx, tgID, linkID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) []domain.UpdateRecord); ok {
+		r0 = rf(ctx, tgID, linkID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UpdateRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64, int64) int64); ok {
+		r1 = rf(ctx, tgID, linkID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int64, int64) error); ok {
+		r2 = rf(ctx, tgID, linkID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ScrapperClient_GetLinkUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkUpdates'
+type ScrapperClient_GetLinkUpdates_Call struct {
+	*mock.Call
+}
+
+// GetLinkUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - linkID int64
+//   - limit int64
+//   - offset int64
+func (_e *ScrapperClient_Expecter) GetLinkUpdates(ctx interface{}, tgID interface{}, linkID interface{}, limit interface{}, offset interface{}) *ScrapperClient_GetLinkUpdates_Call {
+	return &ScrapperClient_GetLinkUpdates_Call{Call: _e.mock.On("GetLinkUpdates", ctx, tgID, linkID, limit, offset)}
+}
+
+func (_c *ScrapperClient_GetLinkUpdates_Call) Run(run func(ctx context.Context, tgID int64, linkID int64, limit int64, offset int64)) *ScrapperClient_GetLinkUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_GetLinkUpdates_Call) Return(_a0 []domain.UpdateRecord, _a1 int64, _a2 error) *ScrapperClient_GetLinkUpdates_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ScrapperClient_GetLinkUpdates_Call) RunAndReturn(run func(context.Context, int64, int64, int64, int64) ([]domain.UpdateRecord, int64, error)) *ScrapperClient_GetLinkUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinks provides a mock function with given fields: ctx, tgID
 func (_m *ScrapperClient) GetLinks(ctx context.Context, tgID int64) ([]domain.Link, error) {
 	ret := _m.Called(ctx, tgID)
@@ -271,8 +403,125 @@ func (_c *ScrapperClient_GetLinks_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// GetLinksByTag provides a mock function with given fields: ctx, tgID, tag
+func (_m *ScrapperClient) GetLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	ret := _m.Called(ctx, tgID, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinksByTag")
+	}
+
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]domain.Link, error)); ok {
+		return rf(ctx, tgID, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []domain.Link); ok {
+		r0 = rf(ctx, tgID, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, tgID, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_GetLinksByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinksByTag'
+type ScrapperClient_GetLinksByTag_Call struct {
+	*mock.Call
+}
+
+// GetLinksByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - tag string
+func (_e *ScrapperClient_Expecter) GetLinksByTag(ctx interface{}, tgID interface{}, tag interface{}) *ScrapperClient_GetLinksByTag_Call {
+	return &ScrapperClient_GetLinksByTag_Call{Call: _e.mock.On("GetLinksByTag", ctx, tgID, tag)}
+}
+
+func (_c *ScrapperClient_GetLinksByTag_Call) Run(run func(ctx context.Context, tgID int64, tag string)) *ScrapperClient_GetLinksByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_GetLinksByTag_Call) Return(_a0 []domain.Link, _a1 error) *ScrapperClient_GetLinksByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_GetLinksByTag_Call) RunAndReturn(run func(context.Context, int64, string) ([]domain.Link, error)) *ScrapperClient_GetLinksByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSettings provides a mock function with given fields: ctx, tgID
+func (_m *ScrapperClient) GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error) {
+	ret := _m.Called(ctx, tgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 domain.DeliverySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.DeliverySettings, error)); ok {
+		return rf(ctx, tgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.DeliverySettings); ok {
+		r0 = rf(ctx, tgID)
+	} else {
+		r0 = ret.Get(0).(domain.DeliverySettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_GetSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSettings'
+type ScrapperClient_GetSettings_Call struct {
+	*mock.Call
+}
+
+// GetSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+func (_e *ScrapperClient_Expecter) GetSettings(ctx interface{}, tgID interface{}) *ScrapperClient_GetSettings_Call {
+	return &ScrapperClient_GetSettings_Call{Call: _e.mock.On("GetSettings", ctx, tgID)}
+}
+
+func (_c *ScrapperClient_GetSettings_Call) Run(run func(ctx context.Context, tgID int64)) *ScrapperClient_GetSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_GetSettings_Call) Return(_a0 domain.DeliverySettings, _a1 error) *ScrapperClient_GetSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_GetSettings_Call) RunAndReturn(run func(context.Context, int64) (domain.DeliverySettings, error)) *ScrapperClient_GetSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetState provides a mock function with given fields: ctx, tgID
-func (_m *ScrapperClient) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
+func (_m *ScrapperClient) GetState(ctx context.Context, tgID int64) (int, int64, domain.Link, error) {
 	ret := _m.Called(ctx, tgID)
 
 	if len(ret) == 0 {
@@ -280,9 +529,10 @@ func (_m *ScrapperClient) GetState(ctx context.Context, tgID int64) (int, domain
 	}
 
 	var r0 int
-	var r1 domain.Link
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, domain.Link, error)); ok {
+	var r1 int64
+	var r2 domain.Link
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, int64, domain.Link, error)); ok {
 		return rf(ctx, tgID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
@@ -291,19 +541,25 @@ func (_m *ScrapperClient) GetState(ctx context.Context, tgID int64) (int, domain
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) domain.Link); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) int64); ok {
 		r1 = rf(ctx, tgID)
 	} else {
-		r1 = ret.Get(1).(domain.Link)
+		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, int64) domain.Link); ok {
 		r2 = rf(ctx, tgID)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(domain.Link)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(3).(func(context.Context, int64) error); ok {
+		r3 = rf(ctx, tgID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
 // ScrapperClient_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
@@ -325,140 +581,606 @@ func (_c *ScrapperClient_GetState_Call) Run(run func(ctx context.Context, tgID i
 	return _c
 }
 
-func (_c *ScrapperClient_GetState_Call) Return(state int, link domain.Link, err error) *ScrapperClient_GetState_Call {
-	_c.Call.Return(state, link, err)
+func (_c *ScrapperClient_GetState_Call) Return(state int, userID int64, link domain.Link, err error) *ScrapperClient_GetState_Call {
+	_c.Call.Return(state, userID, link, err)
 	return _c
 }
 
-func (_c *ScrapperClient_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, domain.Link, error)) *ScrapperClient_GetState_Call {
+func (_c *ScrapperClient_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, int64, domain.Link, error)) *ScrapperClient_GetState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterUser provides a mock function with given fields: ctx, tgID
-func (_m *ScrapperClient) RegisterUser(ctx context.Context, tgID int64) error {
+// GetTags provides a mock function with given fields: ctx, tgID
+func (_m *ScrapperClient) GetTags(ctx context.Context, tgID int64) ([]domain.TagCount, error) {
 	ret := _m.Called(ctx, tgID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterUser")
+		panic("no return value specified for GetTags")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+	var r0 []domain.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.TagCount, error)); ok {
+		return rf(ctx, tgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.TagCount); ok {
 		r0 = rf(ctx, tgID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCount)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ScrapperClient_RegisterUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterUser'
-type ScrapperClient_RegisterUser_Call struct {
+// ScrapperClient_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type ScrapperClient_GetTags_Call struct {
 	*mock.Call
 }
 
-// RegisterUser is a helper method to define mock.On call
+// GetTags is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
-func (_e *ScrapperClient_Expecter) RegisterUser(ctx interface{}, tgID interface{}) *ScrapperClient_RegisterUser_Call {
-	return &ScrapperClient_RegisterUser_Call{Call: _e.mock.On("RegisterUser", ctx, tgID)}
+func (_e *ScrapperClient_Expecter) GetTags(ctx interface{}, tgID interface{}) *ScrapperClient_GetTags_Call {
+	return &ScrapperClient_GetTags_Call{Call: _e.mock.On("GetTags", ctx, tgID)}
 }
 
-func (_c *ScrapperClient_RegisterUser_Call) Run(run func(ctx context.Context, tgID int64)) *ScrapperClient_RegisterUser_Call {
+func (_c *ScrapperClient_GetTags_Call) Run(run func(ctx context.Context, tgID int64)) *ScrapperClient_GetTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *ScrapperClient_RegisterUser_Call) Return(_a0 error) *ScrapperClient_RegisterUser_Call {
-	_c.Call.Return(_a0)
+func (_c *ScrapperClient_GetTags_Call) Return(_a0 []domain.TagCount, _a1 error) *ScrapperClient_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ScrapperClient_RegisterUser_Call) RunAndReturn(run func(context.Context, int64) error) *ScrapperClient_RegisterUser_Call {
+func (_c *ScrapperClient_GetTags_Call) RunAndReturn(run func(context.Context, int64) ([]domain.TagCount, error)) *ScrapperClient_GetTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveLink provides a mock function with given fields: ctx, tgID, link
-func (_m *ScrapperClient) RemoveLink(ctx context.Context, tgID int64, link *domain.Link) error {
-	ret := _m.Called(ctx, tgID, link)
+// IssueToken provides a mock function with given fields: ctx, tgID
+func (_m *ScrapperClient) IssueToken(ctx context.Context, tgID int64) (string, error) {
+	ret := _m.Called(ctx, tgID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveLink")
+		panic("no return value specified for IssueToken")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.Link) error); ok {
-		r0 = rf(ctx, tgID, link)
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (string, error)); ok {
+		return rf(ctx, tgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) string); ok {
+		r0 = rf(ctx, tgID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ScrapperClient_RemoveLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLink'
-type ScrapperClient_RemoveLink_Call struct {
+// ScrapperClient_IssueToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueToken'
+type ScrapperClient_IssueToken_Call struct {
 	*mock.Call
 }
 
-// RemoveLink is a helper method to define mock.On call
+// IssueToken is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
-//   - link *domain.Link
-func (_e *ScrapperClient_Expecter) RemoveLink(ctx interface{}, tgID interface{}, link interface{}) *ScrapperClient_RemoveLink_Call {
-	return &ScrapperClient_RemoveLink_Call{Call: _e.mock.On("RemoveLink", ctx, tgID, link)}
+func (_e *ScrapperClient_Expecter) IssueToken(ctx interface{}, tgID interface{}) *ScrapperClient_IssueToken_Call {
+	return &ScrapperClient_IssueToken_Call{Call: _e.mock.On("IssueToken", ctx, tgID)}
 }
 
-func (_c *ScrapperClient_RemoveLink_Call) Run(run func(ctx context.Context, tgID int64, link *domain.Link)) *ScrapperClient_RemoveLink_Call {
+func (_c *ScrapperClient_IssueToken_Call) Run(run func(ctx context.Context, tgID int64)) *ScrapperClient_IssueToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.Link))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *ScrapperClient_RemoveLink_Call) Return(_a0 error) *ScrapperClient_RemoveLink_Call {
-	_c.Call.Return(_a0)
+func (_c *ScrapperClient_IssueToken_Call) Return(_a0 string, _a1 error) *ScrapperClient_IssueToken_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ScrapperClient_RemoveLink_Call) RunAndReturn(run func(context.Context, int64, *domain.Link) error) *ScrapperClient_RemoveLink_Call {
+func (_c *ScrapperClient_IssueToken_Call) RunAndReturn(run func(context.Context, int64) (string, error)) *ScrapperClient_IssueToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateLink provides a mock function with given fields: ctx, tgID, link
-func (_m *ScrapperClient) UpdateLink(ctx context.Context, tgID int64, link *domain.Link) error {
-	ret := _m.Called(ctx, tgID, link)
+// PauseLinks provides a mock function with given fields: ctx, tgID, selector, until
+func (_m *ScrapperClient) PauseLinks(ctx context.Context, tgID int64, selector domain.LinkSelector, until time.Time) ([]domain.Link, error) {
+	ret := _m.Called(ctx, tgID, selector, until)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateLink")
+		panic("no return value specified for PauseLinks")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.Link) error); ok {
-		r0 = rf(ctx, tgID, link)
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.LinkSelector, time.Time) ([]domain.Link, error)); ok {
+		return rf(ctx, tgID, selector, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.LinkSelector, time.Time) []domain.Link); ok {
+		r0 = rf(ctx, tgID, selector, until)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.LinkSelector, time.Time) error); ok {
+		r1 = rf(ctx, tgID, selector, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ScrapperClient_UpdateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLink'
-type ScrapperClient_UpdateLink_Call struct {
+// ScrapperClient_PauseLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseLinks'
+type ScrapperClient_PauseLinks_Call struct {
 	*mock.Call
 }
 
-// UpdateLink is a helper method to define mock.On call
+// PauseLinks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
-//   - link *domain.Link
-func (_e *ScrapperClient_Expecter) UpdateLink(ctx interface{}, tgID interface{}, link interface{}) *ScrapperClient_UpdateLink_Call {
-	return &ScrapperClient_UpdateLink_Call{Call: _e.mock.On("UpdateLink", ctx, tgID, link)}
+//   - selector domain.LinkSelector
+//   - until time.Time
+func (_e *ScrapperClient_Expecter) PauseLinks(ctx interface{}, tgID interface{}, selector interface{}, until interface{}) *ScrapperClient_PauseLinks_Call {
+	return &ScrapperClient_PauseLinks_Call{Call: _e.mock.On("PauseLinks", ctx, tgID, selector, until)}
+}
+
+func (_c *ScrapperClient_PauseLinks_Call) Run(run func(ctx context.Context, tgID int64, selector domain.LinkSelector, until time.Time)) *ScrapperClient_PauseLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.LinkSelector), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_PauseLinks_Call) Return(_a0 []domain.Link, _a1 error) *ScrapperClient_PauseLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_PauseLinks_Call) RunAndReturn(run func(context.Context, int64, domain.LinkSelector, time.Time) ([]domain.Link, error)) *ScrapperClient_PauseLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterUser provides a mock function with given fields: ctx, tgID
+func (_m *ScrapperClient) RegisterUser(ctx context.Context, tgID int64) error {
+	ret := _m.Called(ctx, tgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, tgID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScrapperClient_RegisterUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterUser'
+type ScrapperClient_RegisterUser_Call struct {
+	*mock.Call
+}
+
+// RegisterUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+func (_e *ScrapperClient_Expecter) RegisterUser(ctx interface{}, tgID interface{}) *ScrapperClient_RegisterUser_Call {
+	return &ScrapperClient_RegisterUser_Call{Call: _e.mock.On("RegisterUser", ctx, tgID)}
+}
+
+func (_c *ScrapperClient_RegisterUser_Call) Run(run func(ctx context.Context, tgID int64)) *ScrapperClient_RegisterUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_RegisterUser_Call) Return(_a0 error) *ScrapperClient_RegisterUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ScrapperClient_RegisterUser_Call) RunAndReturn(run func(context.Context, int64) error) *ScrapperClient_RegisterUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveLink provides a mock function with given fields: ctx, tgID, link
+func (_m *ScrapperClient) RemoveLink(ctx context.Context, tgID int64, link *domain.Link) error {
+	ret := _m.Called(ctx, tgID, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.Link) error); ok {
+		r0 = rf(ctx, tgID, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScrapperClient_RemoveLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLink'
+type ScrapperClient_RemoveLink_Call struct {
+	*mock.Call
+}
+
+// RemoveLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - link *domain.Link
+func (_e *ScrapperClient_Expecter) RemoveLink(ctx interface{}, tgID interface{}, link interface{}) *ScrapperClient_RemoveLink_Call {
+	return &ScrapperClient_RemoveLink_Call{Call: _e.mock.On("RemoveLink", ctx, tgID, link)}
+}
+
+func (_c *ScrapperClient_RemoveLink_Call) Run(run func(ctx context.Context, tgID int64, link *domain.Link)) *ScrapperClient_RemoveLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.Link))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_RemoveLink_Call) Return(_a0 error) *ScrapperClient_RemoveLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ScrapperClient_RemoveLink_Call) RunAndReturn(run func(context.Context, int64, *domain.Link) error) *ScrapperClient_RemoveLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveLinksByTag provides a mock function with given fields: ctx, tgID, tag
+func (_m *ScrapperClient) RemoveLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	ret := _m.Called(ctx, tgID, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveLinksByTag")
+	}
+
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]domain.Link, error)); ok {
+		return rf(ctx, tgID, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []domain.Link); ok {
+		r0 = rf(ctx, tgID, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, tgID, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_RemoveLinksByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLinksByTag'
+type ScrapperClient_RemoveLinksByTag_Call struct {
+	*mock.Call
+}
+
+// RemoveLinksByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - tag string
+func (_e *ScrapperClient_Expecter) RemoveLinksByTag(ctx interface{}, tgID interface{}, tag interface{}) *ScrapperClient_RemoveLinksByTag_Call {
+	return &ScrapperClient_RemoveLinksByTag_Call{Call: _e.mock.On("RemoveLinksByTag", ctx, tgID, tag)}
+}
+
+func (_c *ScrapperClient_RemoveLinksByTag_Call) Run(run func(ctx context.Context, tgID int64, tag string)) *ScrapperClient_RemoveLinksByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_RemoveLinksByTag_Call) Return(_a0 []domain.Link, _a1 error) *ScrapperClient_RemoveLinksByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_RemoveLinksByTag_Call) RunAndReturn(run func(context.Context, int64, string) ([]domain.Link, error)) *ScrapperClient_RemoveLinksByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, tgID, oldTag, newTag
+func (_m *ScrapperClient) RenameTag(ctx context.Context, tgID int64, oldTag string, newTag string) (int64, error) {
+	ret := _m.Called(ctx, tgID, oldTag, newTag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (int64, error)); ok {
+		return rf(ctx, tgID, oldTag, newTag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) int64); ok {
+		r0 = rf(ctx, tgID, oldTag, newTag)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, tgID, oldTag, newTag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type ScrapperClient_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - oldTag string
+//   - newTag string
+func (_e *ScrapperClient_Expecter) RenameTag(ctx interface{}, tgID interface{}, oldTag interface{}, newTag interface{}) *ScrapperClient_RenameTag_Call {
+	return &ScrapperClient_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, tgID, oldTag, newTag)}
+}
+
+func (_c *ScrapperClient_RenameTag_Call) Run(run func(ctx context.Context, tgID int64, oldTag string, newTag string)) *ScrapperClient_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_RenameTag_Call) Return(_a0 int64, _a1 error) *ScrapperClient_RenameTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_RenameTag_Call) RunAndReturn(run func(context.Context, int64, string, string) (int64, error)) *ScrapperClient_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeLinks provides a mock function with given fields: ctx, tgID, selector
+func (_m *ScrapperClient) ResumeLinks(ctx context.Context, tgID int64, selector domain.LinkSelector) ([]domain.Link, error) {
+	ret := _m.Called(ctx, tgID, selector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeLinks")
+	}
+
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.LinkSelector) ([]domain.Link, error)); ok {
+		return rf(ctx, tgID, selector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.LinkSelector) []domain.Link); ok {
+		r0 = rf(ctx, tgID, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.LinkSelector) error); ok {
+		r1 = rf(ctx, tgID, selector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_ResumeLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeLinks'
+type ScrapperClient_ResumeLinks_Call struct {
+	*mock.Call
+}
+
+// ResumeLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - selector domain.LinkSelector
+func (_e *ScrapperClient_Expecter) ResumeLinks(ctx interface{}, tgID interface{}, selector interface{}) *ScrapperClient_ResumeLinks_Call {
+	return &ScrapperClient_ResumeLinks_Call{Call: _e.mock.On("ResumeLinks", ctx, tgID, selector)}
+}
+
+func (_c *ScrapperClient_ResumeLinks_Call) Run(run func(ctx context.Context, tgID int64, selector domain.LinkSelector)) *ScrapperClient_ResumeLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.LinkSelector))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_ResumeLinks_Call) Return(_a0 []domain.Link, _a1 error) *ScrapperClient_ResumeLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_ResumeLinks_Call) RunAndReturn(run func(context.Context, int64, domain.LinkSelector) ([]domain.Link, error)) *ScrapperClient_ResumeLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchLinks provides a mock function with given fields: ctx, tgID, query, limit
+func (_m *ScrapperClient) SearchLinks(ctx context.Context, tgID int64, query string, limit int64) ([]domain.SearchResult, error) {
+	ret := _m.Called(ctx, tgID, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLinks")
+	}
+
+	var r0 []domain.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) ([]domain.SearchResult, error)); ok {
+		return rf(ctx, tgID, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) []domain.SearchResult); ok {
+		r0 = rf(ctx, tgID, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64) error); ok {
+		r1 = rf(ctx, tgID, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_SearchLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLinks'
+type ScrapperClient_SearchLinks_Call struct {
+	*mock.Call
+}
+
+// SearchLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - query string
+//   - limit int64
+func (_e *ScrapperClient_Expecter) SearchLinks(ctx interface{}, tgID interface{}, query interface{}, limit interface{}) *ScrapperClient_SearchLinks_Call {
+	return &ScrapperClient_SearchLinks_Call{Call: _e.mock.On("SearchLinks", ctx, tgID, query, limit)}
+}
+
+func (_c *ScrapperClient_SearchLinks_Call) Run(run func(ctx context.Context, tgID int64, query string, limit int64)) *ScrapperClient_SearchLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_SearchLinks_Call) Return(_a0 []domain.SearchResult, _a1 error) *ScrapperClient_SearchLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_SearchLinks_Call) RunAndReturn(run func(context.Context, int64, string, int64) ([]domain.SearchResult, error)) *ScrapperClient_SearchLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTagMode provides a mock function with given fields: ctx, tgID, tag, mode
+func (_m *ScrapperClient) SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error {
+	ret := _m.Called(ctx, tgID, tag, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTagMode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, domain.DeliveryMode) error); ok {
+		r0 = rf(ctx, tgID, tag, mode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScrapperClient_SetTagMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTagMode'
+type ScrapperClient_SetTagMode_Call struct {
+	*mock.Call
+}
+
+// SetTagMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - tag string
+//   - mode domain.DeliveryMode
+func (_e *ScrapperClient_Expecter) SetTagMode(ctx interface{}, tgID interface{}, tag interface{}, mode interface{}) *ScrapperClient_SetTagMode_Call {
+	return &ScrapperClient_SetTagMode_Call{Call: _e.mock.On("SetTagMode", ctx, tgID, tag, mode)}
+}
+
+func (_c *ScrapperClient_SetTagMode_Call) Run(run func(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode)) *ScrapperClient_SetTagMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(domain.DeliveryMode))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_SetTagMode_Call) Return(_a0 error) *ScrapperClient_SetTagMode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ScrapperClient_SetTagMode_Call) RunAndReturn(run func(context.Context, int64, string, domain.DeliveryMode) error) *ScrapperClient_SetTagMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLink provides a mock function with given fields: ctx, tgID, link
+func (_m *ScrapperClient) UpdateLink(ctx context.Context, tgID int64, link *domain.Link) error {
+	ret := _m.Called(ctx, tgID, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.Link) error); ok {
+		r0 = rf(ctx, tgID, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScrapperClient_UpdateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLink'
+type ScrapperClient_UpdateLink_Call struct {
+	*mock.Call
+}
+
+// UpdateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - link *domain.Link
+func (_e *ScrapperClient_Expecter) UpdateLink(ctx interface{}, tgID interface{}, link interface{}) *ScrapperClient_UpdateLink_Call {
+	return &ScrapperClient_UpdateLink_Call{Call: _e.mock.On("UpdateLink", ctx, tgID, link)}
 }
 
 func (_c *ScrapperClient_UpdateLink_Call) Run(run func(ctx context.Context, tgID int64, link *domain.Link)) *ScrapperClient_UpdateLink_Call {
@@ -478,6 +1200,64 @@ func (_c *ScrapperClient_UpdateLink_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateSettings provides a mock function with given fields: ctx, tgID, update
+func (_m *ScrapperClient) UpdateSettings(ctx context.Context, tgID int64, update *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error) {
+	ret := _m.Called(ctx, tgID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSettings")
+	}
+
+	var r0 domain.DeliverySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error)); ok {
+		return rf(ctx, tgID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.DeliverySettingsUpdate) domain.DeliverySettings); ok {
+		r0 = rf(ctx, tgID, update)
+	} else {
+		r0 = ret.Get(0).(domain.DeliverySettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *domain.DeliverySettingsUpdate) error); ok {
+		r1 = rf(ctx, tgID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrapperClient_UpdateSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSettings'
+type ScrapperClient_UpdateSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - update *domain.DeliverySettingsUpdate
+func (_e *ScrapperClient_Expecter) UpdateSettings(ctx interface{}, tgID interface{}, update interface{}) *ScrapperClient_UpdateSettings_Call {
+	return &ScrapperClient_UpdateSettings_Call{Call: _e.mock.On("UpdateSettings", ctx, tgID, update)}
+}
+
+func (_c *ScrapperClient_UpdateSettings_Call) Run(run func(ctx context.Context, tgID int64, update *domain.DeliverySettingsUpdate)) *ScrapperClient_UpdateSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.DeliverySettingsUpdate))
+	})
+	return _c
+}
+
+func (_c *ScrapperClient_UpdateSettings_Call) Return(_a0 domain.DeliverySettings, _a1 error) *ScrapperClient_UpdateSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScrapperClient_UpdateSettings_Call) RunAndReturn(run func(context.Context, int64, *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error)) *ScrapperClient_UpdateSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateState provides a mock function with given fields: ctx, tgID, state, link
 func (_m *ScrapperClient) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error {
 	ret := _m.Called(ctx, tgID, state, link)
@@ -22,17 +22,17 @@ func (_m *StateManager) EXPECT() *StateManager_Expecter {
 	return &StateManager_Expecter{mock: &_m.Mock}
 }
 
-// CreateState provides a mock function with given fields: ctx, tgID, state
-func (_m *StateManager) CreateState(ctx context.Context, tgID int64, state int) error {
-	ret := _m.Called(ctx, tgID, state)
+// CreateState provides a mock function with given fields: ctx, tgID, userID, state
+func (_m *StateManager) CreateState(ctx context.Context, tgID int64, userID int64, state int) error {
+	ret := _m.Called(ctx, tgID, userID, state)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
-		r0 = rf(ctx, tgID, state)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) error); ok {
+		r0 = rf(ctx, tgID, userID, state)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,14 +48,15 @@ type StateManager_CreateState_Call struct {
 // CreateState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
+//   - userID int64
 //   - state int
-func (_e *StateManager_Expecter) CreateState(ctx interface{}, tgID interface{}, state interface{}) *StateManager_CreateState_Call {
-	return &StateManager_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, state)}
+func (_e *StateManager_Expecter) CreateState(ctx interface{}, tgID interface{}, userID interface{}, state interface{}) *StateManager_CreateState_Call {
+	return &StateManager_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, userID, state)}
 }
 
-func (_c *StateManager_CreateState_Call) Run(run func(ctx context.Context, tgID int64, state int)) *StateManager_CreateState_Call {
+func (_c *StateManager_CreateState_Call) Run(run func(ctx context.Context, tgID int64, userID int64, state int)) *StateManager_CreateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
 	})
 	return _c
 }
@@ -65,7 +66,7 @@ func (_c *StateManager_CreateState_Call) Return(_a0 error) *StateManager_CreateS
 	return _c
 }
 
-func (_c *StateManager_CreateState_Call) RunAndReturn(run func(context.Context, int64, int) error) *StateManager_CreateState_Call {
+func (_c *StateManager_CreateState_Call) RunAndReturn(run func(context.Context, int64, int64, int) error) *StateManager_CreateState_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -118,7 +119,7 @@ func (_c *StateManager_DeleteState_Call) RunAndReturn(run func(context.Context,
 }
 
 // GetState provides a mock function with given fields: ctx, tgID
-func (_m *StateManager) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
+func (_m *StateManager) GetState(ctx context.Context, tgID int64) (int, int64, domain.Link, error) {
 	ret := _m.Called(ctx, tgID)
 
 	if len(ret) == 0 {
@@ -126,9 +127,10 @@ func (_m *StateManager) GetState(ctx context.Context, tgID int64) (int, domain.L
 	}
 
 	var r0 int
-	var r1 domain.Link
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, domain.Link, error)); ok {
+	var r1 int64
+	var r2 domain.Link
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, int64, domain.Link, error)); ok {
 		return rf(ctx, tgID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
@@ -137,19 +139,25 @@ func (_m *StateManager) GetState(ctx context.Context, tgID int64) (int, domain.L
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) domain.Link); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) int64); ok {
 		r1 = rf(ctx, tgID)
 	} else {
-		r1 = ret.Get(1).(domain.Link)
+		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, int64) domain.Link); ok {
 		r2 = rf(ctx, tgID)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(domain.Link)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(3).(func(context.Context, int64) error); ok {
+		r3 = rf(ctx, tgID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
 // StateManager_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
@@ -171,12 +179,12 @@ func (_c *StateManager_GetState_Call) Run(run func(ctx context.Context, tgID int
 	return _c
 }
 
-func (_c *StateManager_GetState_Call) Return(state int, link domain.Link, err error) *StateManager_GetState_Call {
-	_c.Call.Return(state, link, err)
+func (_c *StateManager_GetState_Call) Return(state int, userID int64, link domain.Link, err error) *StateManager_GetState_Call {
+	_c.Call.Return(state, userID, link, err)
 	return _c
 }
 
-func (_c *StateManager_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, domain.Link, error)) *StateManager_GetState_Call {
+func (_c *StateManager_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, int64, domain.Link, error)) *StateManager_GetState_Call {
 	_c.Call.Return(run)
 	return _c
 }
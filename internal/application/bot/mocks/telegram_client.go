@@ -22,6 +22,276 @@ func (_m *TelegramClient) EXPECT() *TelegramClient_Expecter {
 	return &TelegramClient_Expecter{mock: &_m.Mock}
 }
 
+// AnswerCallback provides a mock function with given fields: ctx, callbackID, text
+func (_m *TelegramClient) AnswerCallback(ctx context.Context, callbackID string, text string) {
+	_m.Called(ctx, callbackID, text)
+}
+
+// TelegramClient_AnswerCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnswerCallback'
+type TelegramClient_AnswerCallback_Call struct {
+	*mock.Call
+}
+
+// AnswerCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callbackID string
+//   - text string
+func (_e *TelegramClient_Expecter) AnswerCallback(ctx interface{}, callbackID interface{}, text interface{}) *TelegramClient_AnswerCallback_Call {
+	return &TelegramClient_AnswerCallback_Call{Call: _e.mock.On("AnswerCallback", ctx, callbackID, text)}
+}
+
+func (_c *TelegramClient_AnswerCallback_Call) Run(run func(ctx context.Context, callbackID string, text string)) *TelegramClient_AnswerCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_AnswerCallback_Call) Return() *TelegramClient_AnswerCallback_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelegramClient_AnswerCallback_Call) RunAndReturn(run func(context.Context, string, string)) *TelegramClient_AnswerCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BotUsername provides a mock function with given fields:
+func (_m *TelegramClient) BotUsername() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BotUsername")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelegramClient_BotUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BotUsername'
+type TelegramClient_BotUsername_Call struct {
+	*mock.Call
+}
+
+// BotUsername is a helper method to define mock.On call
+func (_e *TelegramClient_Expecter) BotUsername() *TelegramClient_BotUsername_Call {
+	return &TelegramClient_BotUsername_Call{Call: _e.mock.On("BotUsername")}
+}
+
+func (_c *TelegramClient_BotUsername_Call) Run(run func()) *TelegramClient_BotUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelegramClient_BotUsername_Call) Return(_a0 string) *TelegramClient_BotUsername_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelegramClient_BotUsername_Call) RunAndReturn(run func() string) *TelegramClient_BotUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadFile provides a mock function with given fields: ctx, fileID
+func (_m *TelegramClient) DownloadFile(ctx context.Context, fileID string) ([]byte, error) {
+	ret := _m.Called(ctx, fileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadFile")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, fileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, fileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TelegramClient_DownloadFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFile'
+type TelegramClient_DownloadFile_Call struct {
+	*mock.Call
+}
+
+// DownloadFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileID string
+func (_e *TelegramClient_Expecter) DownloadFile(ctx interface{}, fileID interface{}) *TelegramClient_DownloadFile_Call {
+	return &TelegramClient_DownloadFile_Call{Call: _e.mock.On("DownloadFile", ctx, fileID)}
+}
+
+func (_c *TelegramClient_DownloadFile_Call) Run(run func(ctx context.Context, fileID string)) *TelegramClient_DownloadFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_DownloadFile_Call) Return(_a0 []byte, _a1 error) *TelegramClient_DownloadFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TelegramClient_DownloadFile_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *TelegramClient_DownloadFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMessage provides a mock function with given fields: ctx, tgID, messageID, text, keyboard
+func (_m *TelegramClient) EditMessage(ctx context.Context, tgID int64, messageID int, text string, keyboard domain.InlineKeyboard) {
+	_m.Called(ctx, tgID, messageID, text, keyboard)
+}
+
+// TelegramClient_EditMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMessage'
+type TelegramClient_EditMessage_Call struct {
+	*mock.Call
+}
+
+// EditMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - messageID int
+//   - text string
+//   - keyboard domain.InlineKeyboard
+func (_e *TelegramClient_Expecter) EditMessage(ctx interface{}, tgID interface{}, messageID interface{}, text interface{}, keyboard interface{}) *TelegramClient_EditMessage_Call {
+	return &TelegramClient_EditMessage_Call{Call: _e.mock.On("EditMessage", ctx, tgID, messageID, text, keyboard)}
+}
+
+func (_c *TelegramClient_EditMessage_Call) Run(run func(ctx context.Context, tgID int64, messageID int, text string, keyboard domain.InlineKeyboard)) *TelegramClient_EditMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(string), args[4].(domain.InlineKeyboard))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_EditMessage_Call) Return() *TelegramClient_EditMessage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelegramClient_EditMessage_Call) RunAndReturn(run func(context.Context, int64, int, string, domain.InlineKeyboard)) *TelegramClient_EditMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMessageKeyboard provides a mock function with given fields: ctx, tgID, messageID, keyboard
+func (_m *TelegramClient) EditMessageKeyboard(ctx context.Context, tgID int64, messageID int, keyboard domain.InlineKeyboard) {
+	_m.Called(ctx, tgID, messageID, keyboard)
+}
+
+// TelegramClient_EditMessageKeyboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMessageKeyboard'
+type TelegramClient_EditMessageKeyboard_Call struct {
+	*mock.Call
+}
+
+// EditMessageKeyboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - messageID int
+//   - keyboard domain.InlineKeyboard
+func (_e *TelegramClient_Expecter) EditMessageKeyboard(ctx interface{}, tgID interface{}, messageID interface{}, keyboard interface{}) *TelegramClient_EditMessageKeyboard_Call {
+	return &TelegramClient_EditMessageKeyboard_Call{Call: _e.mock.On("EditMessageKeyboard", ctx, tgID, messageID, keyboard)}
+}
+
+func (_c *TelegramClient_EditMessageKeyboard_Call) Run(run func(ctx context.Context, tgID int64, messageID int, keyboard domain.InlineKeyboard)) *TelegramClient_EditMessageKeyboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(domain.InlineKeyboard))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_EditMessageKeyboard_Call) Return() *TelegramClient_EditMessageKeyboard_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelegramClient_EditMessageKeyboard_Call) RunAndReturn(run func(context.Context, int64, int, domain.InlineKeyboard)) *TelegramClient_EditMessageKeyboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsChatAdmin provides a mock function with given fields: ctx, chatID, userID
+func (_m *TelegramClient) IsChatAdmin(ctx context.Context, chatID int64, userID int64) (bool, error) {
+	ret := _m.Called(ctx, chatID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsChatAdmin")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, chatID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, chatID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, chatID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TelegramClient_IsChatAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsChatAdmin'
+type TelegramClient_IsChatAdmin_Call struct {
+	*mock.Call
+}
+
+// IsChatAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID int64
+func (_e *TelegramClient_Expecter) IsChatAdmin(ctx interface{}, chatID interface{}, userID interface{}) *TelegramClient_IsChatAdmin_Call {
+	return &TelegramClient_IsChatAdmin_Call{Call: _e.mock.On("IsChatAdmin", ctx, chatID, userID)}
+}
+
+func (_c *TelegramClient_IsChatAdmin_Call) Run(run func(ctx context.Context, chatID int64, userID int64)) *TelegramClient_IsChatAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_IsChatAdmin_Call) Return(_a0 bool, _a1 error) *TelegramClient_IsChatAdmin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TelegramClient_IsChatAdmin_Call) RunAndReturn(run func(context.Context, int64, int64) (bool, error)) *TelegramClient_IsChatAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReceiveMessage provides a mock function with given fields: messageCh
 func (_m *TelegramClient) ReceiveMessage(messageCh chan domain.Message) {
 	_m.Called(messageCh)
@@ -55,6 +325,78 @@ func (_c *TelegramClient_ReceiveMessage_Call) RunAndReturn(run func(chan domain.
 	return _c
 }
 
+// SendDocument provides a mock function with given fields: ctx, tgID, fileName, data
+func (_m *TelegramClient) SendDocument(ctx context.Context, tgID int64, fileName string, data []byte) {
+	_m.Called(ctx, tgID, fileName, data)
+}
+
+// TelegramClient_SendDocument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDocument'
+type TelegramClient_SendDocument_Call struct {
+	*mock.Call
+}
+
+// SendDocument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - fileName string
+//   - data []byte
+func (_e *TelegramClient_Expecter) SendDocument(ctx interface{}, tgID interface{}, fileName interface{}, data interface{}) *TelegramClient_SendDocument_Call {
+	return &TelegramClient_SendDocument_Call{Call: _e.mock.On("SendDocument", ctx, tgID, fileName, data)}
+}
+
+func (_c *TelegramClient_SendDocument_Call) Run(run func(ctx context.Context, tgID int64, fileName string, data []byte)) *TelegramClient_SendDocument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_SendDocument_Call) Return() *TelegramClient_SendDocument_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelegramClient_SendDocument_Call) RunAndReturn(run func(context.Context, int64, string, []byte)) *TelegramClient_SendDocument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendFormattedMessage provides a mock function with given fields: ctx, tgID, text, format
+func (_m *TelegramClient) SendFormattedMessage(ctx context.Context, tgID int64, text string, format domain.MessageFormat) {
+	_m.Called(ctx, tgID, text, format)
+}
+
+// TelegramClient_SendFormattedMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendFormattedMessage'
+type TelegramClient_SendFormattedMessage_Call struct {
+	*mock.Call
+}
+
+// SendFormattedMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - text string
+//   - format domain.MessageFormat
+func (_e *TelegramClient_Expecter) SendFormattedMessage(ctx interface{}, tgID interface{}, text interface{}, format interface{}) *TelegramClient_SendFormattedMessage_Call {
+	return &TelegramClient_SendFormattedMessage_Call{Call: _e.mock.On("SendFormattedMessage", ctx, tgID, text, format)}
+}
+
+func (_c *TelegramClient_SendFormattedMessage_Call) Run(run func(ctx context.Context, tgID int64, text string, format domain.MessageFormat)) *TelegramClient_SendFormattedMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(domain.MessageFormat))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_SendFormattedMessage_Call) Return() *TelegramClient_SendFormattedMessage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelegramClient_SendFormattedMessage_Call) RunAndReturn(run func(context.Context, int64, string, domain.MessageFormat)) *TelegramClient_SendFormattedMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendMessage provides a mock function with given fields: ctx, tgID, text
 func (_m *TelegramClient) SendMessage(ctx context.Context, tgID int64, text string) {
 	_m.Called(ctx, tgID, text)
@@ -90,6 +432,42 @@ func (_c *TelegramClient_SendMessage_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// SendMessageWithKeyboard provides a mock function with given fields: ctx, tgID, text, keyboard
+func (_m *TelegramClient) SendMessageWithKeyboard(ctx context.Context, tgID int64, text string, keyboard domain.InlineKeyboard) {
+	_m.Called(ctx, tgID, text, keyboard)
+}
+
+// TelegramClient_SendMessageWithKeyboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageWithKeyboard'
+type TelegramClient_SendMessageWithKeyboard_Call struct {
+	*mock.Call
+}
+
+// SendMessageWithKeyboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - text string
+//   - keyboard domain.InlineKeyboard
+func (_e *TelegramClient_Expecter) SendMessageWithKeyboard(ctx interface{}, tgID interface{}, text interface{}, keyboard interface{}) *TelegramClient_SendMessageWithKeyboard_Call {
+	return &TelegramClient_SendMessageWithKeyboard_Call{Call: _e.mock.On("SendMessageWithKeyboard", ctx, tgID, text, keyboard)}
+}
+
+func (_c *TelegramClient_SendMessageWithKeyboard_Call) Run(run func(ctx context.Context, tgID int64, text string, keyboard domain.InlineKeyboard)) *TelegramClient_SendMessageWithKeyboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(domain.InlineKeyboard))
+	})
+	return _c
+}
+
+func (_c *TelegramClient_SendMessageWithKeyboard_Call) Return() *TelegramClient_SendMessageWithKeyboard_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelegramClient_SendMessageWithKeyboard_Call) RunAndReturn(run func(context.Context, int64, string, domain.InlineKeyboard)) *TelegramClient_SendMessageWithKeyboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopReceiveMessage provides a mock function with given fields:
 func (_m *TelegramClient) StopReceiveMessage() {
 	_m.Called()
@@ -0,0 +1,147 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	pauseUsageText = "Использование: /pause [ссылка|linkID|тег] [длительность], например /pause 3d или " +
+		"/pause https://github.com/owner/repo 12h. Длительность задаётся в минутах (m), часах (h), днях (d) " +
+		"или неделях (w); без неё пауза действует до /resume"
+	resumeUsageText  = "Использование: /resume [ссылка|linkID|тег]"
+	pauseNotFound    = "Ссылки не найдены. Посмотрите список с помощью /list"
+	maxPauseDuration = 365 * 24 * time.Hour
+	pauseTimeLayout  = "02.01.2006 15:04 UTC"
+)
+
+func (bot *Bot) commandPause(ctx context.Context, tgID int64, args []string) string {
+	if len(args) > 2 {
+		return pauseUsageText
+	}
+
+	var (
+		selector domain.LinkSelector
+		duration time.Duration
+		err      error
+	)
+
+	switch {
+	case len(args) == 2:
+		selector = parseLinkSelector(args[0])
+
+		duration, err = parsePauseDuration(args[1])
+		if err != nil {
+			return pauseUsageText
+		}
+	case len(args) == 1:
+		// Единственный аргумент — длительность общей паузы или ссылка/тег для бессрочной
+		if duration, err = parsePauseDuration(args[0]); err != nil {
+			selector, duration = parseLinkSelector(args[0]), 0
+		}
+	}
+
+	var until time.Time
+	if duration > 0 {
+		until = time.Now().UTC().Add(duration).Truncate(time.Minute)
+	}
+
+	links, err := bot.scrapper.PauseLinks(ctx, tgID, selector, until)
+	if err != nil {
+		slog.Error("Command /pause failed", "error", err.Error(), "chatId", tgID)
+
+		if errors.As(err, &domain.ErrLinkNotExist{}) {
+			return pauseNotFound
+		}
+
+		return errorText
+	}
+
+	slog.Info("Command /pause done", "chatId", tgID, "links", len(links), "until", until)
+
+	if until.IsZero() {
+		return fmt.Sprintf("Уведомления приостановлены для ссылок: %d. Возобновить — /resume", len(links))
+	}
+
+	return fmt.Sprintf("Уведомления приостановлены для ссылок: %d до %s", len(links), until.Format(pauseTimeLayout))
+}
+
+func (bot *Bot) commandResume(ctx context.Context, tgID int64, args []string) string {
+	if len(args) > 1 {
+		return resumeUsageText
+	}
+
+	var selector domain.LinkSelector
+	if len(args) == 1 {
+		selector = parseLinkSelector(args[0])
+	}
+
+	links, err := bot.scrapper.ResumeLinks(ctx, tgID, selector)
+	if err != nil {
+		slog.Error("Command /resume failed", "error", err.Error(), "chatId", tgID)
+
+		if errors.As(err, &domain.ErrLinkNotExist{}) {
+			return pauseNotFound
+		}
+
+		return errorText
+	}
+
+	slog.Info("Command /resume done", "chatId", tgID, "links", len(links))
+
+	return fmt.Sprintf("Уведомления возобновлены для ссылок: %d", len(links))
+}
+
+// parseLinkSelector определяет, чем задана ссылка в аргументе команды: идентификатором из /list,
+// адресом или тегом.
+func parseLinkSelector(ref string) domain.LinkSelector {
+	if linkID, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		return domain.LinkSelector{ID: linkID}
+	}
+
+	if valid, validURL := validateLink(ref); valid {
+		return domain.LinkSelector{URL: validURL}
+	}
+
+	if strings.Contains(ref, "://") {
+		return domain.LinkSelector{URL: ref}
+	}
+
+	return domain.LinkSelector{Tag: strings.TrimPrefix(ref, "#")}
+}
+
+// parsePauseDuration разбирает длительность паузы: 30m, 12h, 3d, 2w или составную запись Go (1h30m).
+func parsePauseDuration(value string) (time.Duration, error) {
+	var duration time.Duration
+
+	switch unit := value[len(value)-1]; unit {
+	case 'd', 'w':
+		count, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil {
+			return 0, err
+		}
+
+		duration = time.Duration(count) * 24 * time.Hour
+		if unit == 'w' {
+			duration *= 7
+		}
+	default:
+		var err error
+		if duration, err = time.ParseDuration(value); err != nil {
+			return 0, err
+		}
+	}
+
+	if duration <= 0 || duration > maxPauseDuration {
+		return 0, fmt.Errorf("pause duration %s out of range", value)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,50 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+const (
+	searchUsageText    = "Использование: /search <запрос>, например /search golang memory leak"
+	searchNotFoundText = "По запросу ничего не найдено"
+	searchResultsLimit = 10
+)
+
+func (bot *Bot) commandSearch(ctx context.Context, tgID int64, args []string) string {
+	query := strings.Join(args, " ")
+
+	// Поиск идёт только по буквам и цифрам, запрос из одних знаков препинания искать нечего
+	if strings.IndexFunc(query, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) < 0 {
+		return searchUsageText
+	}
+
+	results, err := bot.scrapper.SearchLinks(ctx, tgID, query, searchResultsLimit)
+	if err != nil {
+		slog.Error("Command /search failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /search done", "chatId", tgID, "results", len(results))
+
+	if len(results) == 0 {
+		return searchNotFoundText
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("Результаты поиска «" + query + "»:\n")
+
+	for i := range results {
+		fmt.Fprintf(&sb, "\n%d. %s\n", i+1, formatLink(&results[i].Link))
+
+		if update := results[i].Update; update != nil {
+			sb.WriteString("↳ " + updateRecordTime(update).Format(historyTimeLayout) + " " + updateRecordSummary(update) + "\n")
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,95 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	renameTagUsageText = "Использование: /renametag <старый тег> <новый тег>"
+	tagNotFoundText    = "Ссылки с таким тегом не найдены. Посмотрите список тегов с помощью /tags"
+)
+
+// parseTagRef возвращает тег, если аргумент команды явно задан как тег: #тег или tag:тег.
+func parseTagRef(ref string) (string, bool) {
+	switch {
+	case strings.HasPrefix(ref, "#"):
+		return strings.TrimPrefix(ref, "#"), len(ref) > 1
+	case strings.HasPrefix(ref, "tag:"):
+		return strings.TrimPrefix(ref, "tag:"), len(ref) > len("tag:")
+	default:
+		return "", false
+	}
+}
+
+func (bot *Bot) commandUntrackTag(ctx context.Context, tgID int64, tag string) string {
+	links, err := bot.scrapper.RemoveLinksByTag(ctx, tgID, tag)
+	if err != nil {
+		slog.Error("Command /untrack by tag failed", "error", err.Error(), "chatId", tgID, "tag", tag)
+
+		if errors.As(err, &domain.ErrTagNotExist{}) {
+			return tagNotFoundText
+		}
+
+		return errorText
+	}
+
+	slog.Info("Command /untrack by tag done", "chatId", tgID, "tag", tag, "links", len(links))
+
+	return fmt.Sprintf("Прекращено отслеживание ссылок с тегом %s: %d", tag, len(links))
+}
+
+func (bot *Bot) commandTags(ctx context.Context, tgID int64) string {
+	tags, err := bot.scrapper.GetTags(ctx, tgID)
+	if err != nil {
+		slog.Error("Command /tags failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /tags done", "chatId", tgID)
+
+	if len(tags) == 0 {
+		return "У отслеживаемых ссылок нет тегов. Задать их можно с помощью /settags"
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("Теги:\n")
+
+	for _, tag := range tags {
+		sb.WriteString(fmt.Sprintf("#%s — %d\n", tag.Tag, tag.Links))
+	}
+
+	return sb.String()
+}
+
+func (bot *Bot) commandRenameTag(ctx context.Context, tgID int64, args []string) string {
+	if len(args) != 2 {
+		return renameTagUsageText
+	}
+
+	oldTag, newTag := strings.TrimPrefix(args[0], "#"), strings.TrimPrefix(args[1], "#")
+
+	renamed, err := bot.scrapper.RenameTag(ctx, tgID, oldTag, newTag)
+	if err != nil {
+		slog.Error("Command /renametag failed", "error", err.Error(), "chatId", tgID, "tag", oldTag)
+
+		switch {
+		case errors.As(err, &domain.ErrTagNotExist{}):
+			return tagNotFoundText
+		case errors.As(err, &domain.ErrAPI{}):
+			return renameTagUsageText
+		default:
+			return errorText
+		}
+	}
+
+	slog.Info("Command /renametag done", "chatId", tgID, "oldTag", oldTag, "newTag", newTag)
+
+	return fmt.Sprintf("Тег %s переименован в %s у ссылок: %d", oldTag, newTag, renamed)
+}
@@ -0,0 +1,25 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+)
+
+const privateOnlyText = "Токен API можно получить только в личном чате с ботом"
+
+// commandToken выпускает персональный токен публичного API. Токен хранится в scrapper только в виде хеша,
+// поэтому показывается один раз, а повторный вызов /token заменяет прежний токен новым.
+func (bot *Bot) commandToken(ctx context.Context, tgID int64) string {
+	token, err := bot.scrapper.IssueToken(ctx, tgID)
+	if err != nil {
+		slog.Error("Command /token failed", "error", err.Error(), "chatId", tgID)
+		return errorText
+	}
+
+	slog.Info("Command /token done", "chatId", tgID)
+
+	return "🔑Ваш токен API:\n\n" + token + "\n\n" +
+		"Передавайте его в заголовке Authorization: Bearer <токен> в запросах к /api/v1. " +
+		"Токен показывается один раз: сохраните его. Повторный вызов /token выпустит новый токен, " +
+		"а прежний перестанет действовать"
+}
@@ -1,6 +1,9 @@
 package application
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/spf13/viper"
@@ -10,6 +13,7 @@ type ScrapperConfig struct {
 	Address           string
 	BotBaseURL        string
 	Interval          time.Duration
+	StateTTL          time.Duration
 	ReadTimeout       time.Duration
 	WriteTimeout      time.Duration
 	BotClientTimeout  time.Duration
@@ -17,6 +21,20 @@ type ScrapperConfig struct {
 	CheckLinksWorkers int
 	SizeLinksPage     int64
 	DBAccessType      string
+	ProbeLinksOnAdd   bool
+	FailuresToWarn    int64
+	AdminToken        string
+	PublicAPIAddress  string
+}
+
+// Validate проверяет параметры scrapper, без которых он не может работать. STATE_TTL задаёт и срок жизни
+// состояния диалога, и интервал его очистки, поэтому должен быть положительным.
+func (c ScrapperConfig) Validate() error {
+	if c.StateTTL <= 0 {
+		return fmt.Errorf("STATE_TTL must be positive, got %s", c.StateTTL)
+	}
+
+	return nil
 }
 
 type BotConfig struct {
@@ -27,6 +45,9 @@ type BotConfig struct {
 	WriteTimeout          time.Duration
 	ScrapperClientTimeout time.Duration
 	LogsPath              string
+	NotificationParseMode string
+	DisableLinkPreview    bool
+	GroupAdminOnly        bool
 }
 
 type DBConfig struct {
@@ -35,10 +56,46 @@ type DBConfig struct {
 	PostgresDB       string
 }
 
+// TracingConfig задаёт экспорт трейсов OpenTelemetry; пустой OTLPEndpoint выключает экспорт.
+type TracingConfig struct {
+	OTLPEndpoint string
+	SampleRatio  float64
+}
+
+// ServiceAuthConfig задаёт аутентификацию запросов между bot и scrapper. Token должен совпадать у обоих
+// сервисов. Если задан CertFile, сервисы общаются по взаимному TLS с сертификатами, подписанными CAFile.
+// Без Token и CertFile сервисы не запускаются, если проверка не выключена явно через Disabled.
+type ServiceAuthConfig struct {
+	Token    string
+	CertFile string
+	KeyFile  string
+	CAFile   string
+	Disabled bool
+}
+
+// Validate проверяет, что задан токен или взаимный TLS. Без них API bot и scrapper открыты для любого,
+// кто может до них достучаться, поэтому такой режим допускается только с Disabled и с предупреждением в логе.
+func (c ServiceAuthConfig) Validate() error {
+	if c.Token != "" || c.CertFile != "" {
+		return nil
+	}
+
+	if !c.Disabled {
+		return errors.New("service auth is not configured: set SERVICE_TOKEN or SERVICE_TLS_CERT, " +
+			"or SERVICE_AUTH_DISABLED=true to run without it")
+	}
+
+	slog.Warn("Service auth disabled, bot and scrapper APIs accept unauthenticated requests")
+
+	return nil
+}
+
 type Config struct {
-	ScrapConfig ScrapperConfig
-	BotConfig   BotConfig
-	DBConfig    DBConfig
+	ScrapConfig   ScrapperConfig
+	BotConfig     BotConfig
+	DBConfig      DBConfig
+	TracingConfig TracingConfig
+	ServiceAuth   ServiceAuthConfig
 }
 
 func ReadYAMLConfig() (*Config, error) {
@@ -48,12 +105,17 @@ func ReadYAMLConfig() (*Config, error) {
 			Address:           viper.GetString("SCRAPPER_ADDRESS"),
 			BotBaseURL:        viper.GetString("BOT_BASEURL"),
 			Interval:          viper.GetDuration("CHECK_LINKS_INTERVAL"),
+			StateTTL:          viper.GetDuration("STATE_TTL"),
 			ReadTimeout:       viper.GetDuration("SCRAPPER_READ_TIMEOUT"),
 			WriteTimeout:      viper.GetDuration("SCRAPPER_WRITE_TIMEOUT"),
 			BotClientTimeout:  viper.GetDuration("BOT_CLIENT_TIMEOUT"),
 			CheckLinksWorkers: viper.GetInt("CHECK_LINKS_WORKERS"),
 			SizeLinksPage:     viper.GetInt64("SIZE_LINKS_PAGE"),
 			DBAccessType:      viper.GetString("DB_ACCESS_TYPE"),
+			ProbeLinksOnAdd:   viper.GetBool("PROBE_LINKS_ON_ADD"),
+			FailuresToWarn:    viper.GetInt64("LINK_FAILURES_TO_WARN"),
+			AdminToken:        viper.GetString("ADMIN_TOKEN"),
+			PublicAPIAddress:  viper.GetString("PUBLIC_API_ADDRESS"),
 		},
 		BotConfig: BotConfig{
 			TgToken:               viper.GetString("TG_TOKEN"),
@@ -62,13 +124,46 @@ func ReadYAMLConfig() (*Config, error) {
 			ReadTimeout:           viper.GetDuration("BOT_READ_TIMEOUT"),
 			WriteTimeout:          viper.GetDuration("BOT_WRITE_TIMEOUT"),
 			ScrapperClientTimeout: viper.GetDuration("SCRAPPER_CLIENT_TIMEOUT"),
+			NotificationParseMode: viper.GetString("NOTIFICATION_PARSE_MODE"),
+			DisableLinkPreview:    viper.GetBool("NOTIFICATION_DISABLE_LINK_PREVIEW"),
+			GroupAdminOnly:        viper.GetBool("GROUP_ADMIN_ONLY"),
 		},
 		DBConfig: DBConfig{
 			PostgresUser:     viper.GetString("POSTGRES_USER"),
 			PostgresPassword: viper.GetString("POSTGRES_PASSWORD"),
 			PostgresDB:       viper.GetString("POSTGRES_DB"),
 		},
+		TracingConfig: TracingConfig{
+			OTLPEndpoint: viper.GetString("TRACING_OTLP_ENDPOINT"),
+			SampleRatio:  viper.GetFloat64("TRACING_SAMPLE_RATIO"),
+		},
+		ServiceAuth: ServiceAuthConfig{
+			Token:    viper.GetString("SERVICE_TOKEN"),
+			CertFile: viper.GetString("SERVICE_TLS_CERT"),
+			KeyFile:  viper.GetString("SERVICE_TLS_KEY"),
+			CAFile:   viper.GetString("SERVICE_TLS_CA"),
+			Disabled: viper.GetBool("SERVICE_AUTH_DISABLED"),
+		},
+	}
+
+	if err := config.ServiceAuth.Validate(); err != nil {
+		return nil, err
 	}
 
 	return &config, nil
 }
+
+// ReadScrapperConfig читает конфигурацию так же, как ReadYAMLConfig, и дополнительно проверяет параметры
+// scrapper. Bot читает тот же файл через ReadYAMLConfig, и параметры scrapper ему не нужны.
+func ReadScrapperConfig() (*Config, error) {
+	config, err := ReadYAMLConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := config.ScrapConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
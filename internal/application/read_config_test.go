@@ -0,0 +1,58 @@
+package application_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/application"
+)
+
+func Test_ServiceAuthConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  application.ServiceAuthConfig
+		wantErr bool
+	}{
+		{name: "token", config: application.ServiceAuthConfig{Token: "secret"}},
+		{name: "mutual tls", config: application.ServiceAuthConfig{CertFile: "service.crt"}},
+		{name: "not configured", config: application.ServiceAuthConfig{}, wantErr: true},
+		{name: "explicitly disabled", config: application.ServiceAuthConfig{Disabled: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_ScrapperConfig_Validate(t *testing.T) {
+	assert.NoError(t, application.ScrapperConfig{StateTTL: 15 * time.Minute}.Validate())
+	assert.Error(t, application.ScrapperConfig{}.Validate(), "STATE_TTL не задан")
+	assert.Error(t, application.ScrapperConfig{StateTTL: -time.Minute}.Validate())
+}
+
+func Test_ReadScrapperConfig_RequiresStateTTL(t *testing.T) {
+	t.Setenv("SERVICE_TOKEN", "secret")
+	t.Setenv("STATE_TTL", "")
+
+	_, err := application.ReadYAMLConfig()
+	assert.NoError(t, err, "Bot не использует STATE_TTL и запускается без него")
+
+	_, err = application.ReadScrapperConfig()
+	assert.Error(t, err)
+
+	t.Setenv("STATE_TTL", "15m")
+
+	config, err := application.ReadScrapperConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, config.ScrapConfig.StateTTL)
+}
@@ -0,0 +1,203 @@
+package scrapper
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/go-co-op/gocron/v2"
+)
+
+// scrapeJobName — имя задачи планировщика, которая проверяет все ссылки.
+const scrapeJobName = "check-links"
+
+// ListUsers возвращает всех пользователей с числом отслеживаемых ими ссылок.
+func (s *Scrapper) ListUsers(ctx context.Context) ([]domain.UserLinkCount, error) {
+	users, err := s.userRepo.GetUsersLinkCounts(ctx)
+	if err != nil {
+		slog.Error("List users failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.Info("List users done", "users", len(users))
+
+	return users, nil
+}
+
+// CheckLinkNow сразу проверяет отслеживаемую ссылку вне расписания. Найденные обновления рассылаются
+// подписчикам как при плановой проверке. Возвращает ссылку и ошибку проверки; ErrUpdatesNotFound
+// означает, что новых событий нет. Если ссылку не удалось найти, возвращается пустая ссылка.
+func (s *Scrapper) CheckLinkNow(ctx context.Context, rawURL string) (domain.Link, error) {
+	canonicalURL, err := domain.CanonicalURL(rawURL)
+	if err != nil {
+		return domain.Link{}, err
+	}
+
+	link, err := s.linkRepo.GetLinkByURL(ctx, canonicalURL)
+	if err != nil {
+		slog.Error("Check link now failed", "error", err.Error(), "url", canonicalURL)
+		return domain.Link{}, err
+	}
+
+	// Проверка идёт в запросе HTTP, поэтому обновления не пишутся в очередь напрямую: она может быть
+	// заполнена или уже закрыта при остановке scrapper
+	updates := make(chan domain.LinkUpdate)
+
+	go func() {
+		defer close(updates)
+
+		err = s.linkCheck.CheckLink(ctx, &link, updates)
+	}()
+
+	for update := range updates {
+		s.enqueueUpdate(ctx, &update)
+	}
+
+	slog.Info("Check link now done", "url", canonicalURL, "error", err)
+
+	return link, err
+}
+
+// TriggerScrape запускает внеплановый проход проверки всех ссылок, даже если планировщик на паузе.
+// Проход выполняется в фоне; если предыдущий ещё не закончился, возвращается ErrScrapeInProgress.
+func (s *Scrapper) TriggerScrape(_ context.Context) error {
+	s.schedulerMu.RLock()
+	defer s.schedulerMu.RUnlock()
+
+	job := s.scrapeJob()
+	if job == nil {
+		return domain.ErrSchedulerNotRunning{}
+	}
+
+	if s.scrapeRunning() {
+		return domain.ErrScrapeInProgress{}
+	}
+
+	s.forceScrape.Store(true)
+
+	if err := job.RunNow(); err != nil {
+		s.forceScrape.Store(false)
+		slog.Error("Trigger scrape failed", "error", err.Error())
+
+		return err
+	}
+
+	slog.Info("Scrape triggered")
+
+	return nil
+}
+
+// PauseScheduler приостанавливает плановые проходы проверки ссылок. Уже идущий проход не прерывается,
+// очистка состояний, сводки и метрики продолжают работать.
+func (s *Scrapper) PauseScheduler(ctx context.Context) (domain.SchedulerStatus, error) {
+	s.paused.Store(true)
+	slog.Info("Scheduler paused")
+
+	return s.GetSchedulerStatus(ctx)
+}
+
+// ResumeScheduler возобновляет плановые проходы проверки ссылок.
+func (s *Scrapper) ResumeScheduler(ctx context.Context) (domain.SchedulerStatus, error) {
+	s.paused.Store(false)
+	slog.Info("Scheduler resumed")
+
+	return s.GetSchedulerStatus(ctx)
+}
+
+// GetSchedulerStatus возвращает состояние планировщика проверок и итоги последнего прохода.
+func (s *Scrapper) GetSchedulerStatus(_ context.Context) (domain.SchedulerStatus, error) {
+	s.schedulerMu.RLock()
+	defer s.schedulerMu.RUnlock()
+
+	job := s.scrapeJob()
+	if job == nil {
+		return domain.SchedulerStatus{}, domain.ErrSchedulerNotRunning{}
+	}
+
+	status := s.lastScrape()
+	status.Paused = s.paused.Load()
+
+	nextRun, err := job.NextRun()
+	if err == nil {
+		status.NextRunAt = nextRun
+	}
+
+	return status, nil
+}
+
+// scheduledScrape — задача планировщика: пропускает проход, пока планировщик на паузе,
+// если только проход не запрошен TriggerScrape.
+func (s *Scrapper) scheduledScrape(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) {
+	forced := s.forceScrape.Swap(false)
+	if s.paused.Load() && !forced {
+		slog.Info("Scrape skipped, scheduler paused")
+		return
+	}
+
+	s.scrape(ctx, linkUpdates)
+}
+
+// scrape проверяет все ссылки и запоминает итоги прохода. Проходы не пересекаются: если предыдущий
+// ещё идёт, новый пропускается.
+func (s *Scrapper) scrape(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) {
+	start, ok := s.beginScrape()
+	if !ok {
+		slog.Warn("Scrape skipped, previous scrape still running")
+		return
+	}
+
+	stats := s.linkCheck.CheckLinks(ctx, linkUpdates)
+	s.finishScrape(start, stats)
+}
+
+func (s *Scrapper) beginScrape() (time.Time, bool) {
+	s.scrapeMu.Lock()
+	defer s.scrapeMu.Unlock()
+
+	if s.scrapeStatus.Running {
+		return time.Time{}, false
+	}
+
+	start := time.Now()
+	s.scrapeStatus.Running = true
+	s.scrapeStatus.LastRunAt = start
+
+	return start, true
+}
+
+func (s *Scrapper) finishScrape(start time.Time, stats domain.ScrapeStats) {
+	s.scrapeMu.Lock()
+	defer s.scrapeMu.Unlock()
+
+	s.scrapeStatus.Running = false
+	s.scrapeStatus.LastRunDuration = time.Since(start)
+	s.scrapeStatus.LastRun = stats
+}
+
+func (s *Scrapper) lastScrape() domain.SchedulerStatus {
+	s.scrapeMu.Lock()
+	defer s.scrapeMu.Unlock()
+
+	return s.scrapeStatus
+}
+
+func (s *Scrapper) scrapeRunning() bool {
+	return s.lastScrape().Running
+}
+
+// scrapeJob возвращает задачу проверки ссылок запущенного планировщика. Вызывается под schedulerMu.
+func (s *Scrapper) scrapeJob() gocron.Job {
+	if s.scheduler == nil {
+		return nil
+	}
+
+	for _, job := range s.scheduler.Jobs() {
+		if job.Name() == scrapeJobName {
+			return job
+		}
+	}
+
+	return nil
+}
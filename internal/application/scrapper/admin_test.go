@@ -0,0 +1,161 @@
+package scrapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func Test_Scrapper_ListUsers(t *testing.T) {
+	ctx := context.Background()
+	users := []domain.UserLinkCount{{TgID: 1, Links: 3}, {TgID: 2, Links: 0}}
+
+	userRepo := &mocks.UserRepo{}
+	userRepo.On("GetUsersLinkCounts", ctx).Return(users, nil).Once()
+
+	s := scrapper.NewScrapper(userRepo, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	listed, err := s.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, users, listed)
+	userRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_CheckLinkNow(t *testing.T) {
+	ctx := context.Background()
+	link := domain.Link{ID: 7, URL: "https://github.com/owner/repo"}
+
+	linkRepo := &mocks.LinkRepo{}
+	linkRepo.On("GetLinkByURL", ctx, "https://github.com/owner/repo").Return(link, nil).Once()
+
+	linkChecker := &mocks.LinkChecker{}
+	linkChecker.On("CheckLink", ctx, &link, mock.Anything).Return(domain.ErrUpdatesNotFound{}).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, linkChecker)
+
+	checked, err := s.CheckLinkNow(ctx, "https://GitHub.com/owner/repo/")
+	assert.ErrorAs(t, err, &domain.ErrUpdatesNotFound{})
+	assert.Equal(t, link, checked)
+	linkRepo.AssertExpectations(t)
+	linkChecker.AssertExpectations(t)
+}
+
+func Test_Scrapper_CheckLinkNow_AfterRunStopped(t *testing.T) {
+	ctx := context.Background()
+	link := domain.Link{ID: 7, URL: "https://github.com/owner/repo"}
+	update := domain.LinkUpdate{Link: link, TgIDs: []int64{1}, Description: "new issue"}
+
+	linkRepo := &mocks.LinkRepo{}
+	linkRepo.On("GetLinkByURL", mock.Anything, "https://github.com/owner/repo").Return(link, nil)
+	linkRepo.On("GetTrackingStats", mock.Anything).Return(domain.TrackingStats{}, nil).Maybe()
+
+	linkChecker := &mocks.LinkChecker{}
+	linkChecker.On("CheckLink", ctx, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { args.Get(2).(chan<- domain.LinkUpdate) <- update }).
+		Return(nil).Once()
+
+	updateRepo := &mocks.UpdateRepo{}
+	updateRepo.On("AddUpdate", mock.Anything, &update).Return(nil).Once()
+
+	deliveryRepo := &mocks.DeliveryRepo{}
+	deliveryRepo.On("GetDeliveryTargets", mock.Anything, int64(7)).Return(map[int64]domain.DeliveryTarget{}, nil).Once()
+
+	notifier := &mocks.Notifier{}
+	notifier.On("PostUpdates", mock.Anything, &update).Return(nil).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, deliveryRepo, updateRepo,
+		time.Hour, time.Hour, notifier, linkChecker)
+
+	// Очередь доставки закрывается при остановке Run, а HTTP-сервер ещё может принимать запросы
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+
+	go func() { done <- s.Run(runCtx) }()
+
+	require.Eventually(t, func() bool { return s.CheckScheduler(ctx) == nil }, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	checked, err := s.CheckLinkNow(ctx, "https://github.com/owner/repo")
+	require.NoError(t, err)
+	assert.Equal(t, link, checked)
+	updateRepo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func Test_Scrapper_CheckLinkNow_NotTracked(t *testing.T) {
+	ctx := context.Background()
+
+	linkRepo := &mocks.LinkRepo{}
+	linkRepo.On("GetLinkByURL", ctx, "https://github.com/owner/repo").Return(domain.Link{}, domain.ErrLinkNotExist{}).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	checked, err := s.CheckLinkNow(ctx, "https://github.com/owner/repo")
+	assert.ErrorAs(t, err, &domain.ErrLinkNotExist{})
+	assert.Zero(t, checked)
+}
+
+func Test_Scrapper_SchedulerControl_NotRunning(t *testing.T) {
+	ctx := context.Background()
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	assert.ErrorAs(t, s.TriggerScrape(ctx), &domain.ErrSchedulerNotRunning{})
+
+	_, err := s.GetSchedulerStatus(ctx)
+	assert.ErrorAs(t, err, &domain.ErrSchedulerNotRunning{})
+}
+
+func Test_Scrapper_TriggerScrape_WhilePaused(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	linkRepo := &mocks.LinkRepo{}
+	linkRepo.On("GetTrackingStats", mock.Anything).Return(domain.TrackingStats{}, nil).Maybe()
+
+	linkChecker := &mocks.LinkChecker{}
+	linkChecker.On("CheckLinks", mock.Anything, mock.Anything).Return(domain.ScrapeStats{Checked: 3, Failed: 1}).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Hour, time.Hour, &mocks.Notifier{}, linkChecker)
+
+	done := make(chan error)
+
+	go func() { done <- s.Run(ctx) }()
+
+	require.Eventually(t, func() bool { return s.CheckScheduler(ctx) == nil }, time.Second, 10*time.Millisecond)
+
+	status, err := s.PauseScheduler(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Paused)
+	assert.True(t, status.LastRunAt.IsZero())
+	assert.False(t, status.NextRunAt.IsZero())
+
+	require.NoError(t, s.TriggerScrape(ctx))
+
+	assert.Eventually(t, func() bool {
+		status, err = s.GetSchedulerStatus(ctx)
+		return err == nil && !status.Running && status.LastRun.Checked == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, domain.ScrapeStats{Checked: 3, Failed: 1}, status.LastRun)
+	assert.True(t, status.Paused)
+	assert.False(t, status.LastRunAt.IsZero())
+
+	status, err = s.ResumeScheduler(ctx)
+	require.NoError(t, err)
+	assert.False(t, status.Paused)
+
+	cancel()
+	assert.NoError(t, <-done)
+	linkChecker.AssertExpectations(t)
+}
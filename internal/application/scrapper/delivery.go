@@ -0,0 +1,199 @@
+package scrapper
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"LinkTracker/internal/domain"
+)
+
+// digestCheckInterval — как часто проверяется, не пора ли отправить накопленные сводки.
+const digestCheckInterval = time.Minute
+
+// DeliverUpdate отправляет обновление пользователям с мгновенной доставкой, а для остальных
+// откладывает его до ближайшей сводки или до конца тихих часов. Если режим доставки узнать
+// или сохранить не удалось, обновление отправляется сразу, чтобы оно не потерялось.
+func (s *Scrapper) DeliverUpdate(ctx context.Context, update *domain.LinkUpdate) {
+	immediate := update.TgIDs
+
+	targets, err := s.deliveryRepo.GetDeliveryTargets(ctx, update.Link.ID)
+	if err != nil {
+		slog.Error("Get delivery targets failed", "error", err.Error(), "url", update.Link.URL)
+	} else {
+		now := time.Now().UTC()
+		immediate = make([]int64, 0, len(update.TgIDs))
+
+		for _, tgID := range update.TgIDs {
+			target, ok := targets[tgID]
+			if !ok {
+				immediate = append(immediate, tgID)
+				continue
+			}
+
+			mode := target.Mode
+			if mode == domain.DeliveryImmediate {
+				if !target.InQuietHours(now) {
+					immediate = append(immediate, tgID)
+					continue
+				}
+
+				mode = domain.DeliveryQuiet
+			}
+
+			if err := s.deliveryRepo.AddPendingUpdate(ctx, tgID, mode, update); err != nil {
+				slog.Error("Add pending update failed", "error", err.Error(), "tgID", tgID, "url", update.Link.URL)
+
+				immediate = append(immediate, tgID)
+			}
+		}
+	}
+
+	if len(immediate) == 0 {
+		return
+	}
+
+	immediateUpdate := *update
+	immediateUpdate.TgIDs = immediate
+
+	err = s.notifier.PostUpdates(ctx, &immediateUpdate)
+	if err != nil {
+		slog.Error(err.Error(), "url", update.Link, "tgIDS", immediate, "description", update.Description)
+	}
+}
+
+// SendDigests отправляет сводки, окно которых уже закрылось: часовые — по началу часа,
+// ежедневные — в выбранное пользователем время в его часовом поясе, отложенные в тихие часы —
+// сразу после их окончания. Пока у пользователя идут тихие часы, сводки не отправляются.
+func (s *Scrapper) SendDigests(ctx context.Context) {
+	tgIDs, err := s.deliveryRepo.GetPendingUsers(ctx)
+	if err != nil {
+		slog.Error("Get pending users failed", "error", err.Error())
+		return
+	}
+
+	now := time.Now().UTC()
+
+	for _, tgID := range tgIDs {
+		s.sendUserDigests(ctx, tgID, now)
+	}
+}
+
+func (s *Scrapper) sendUserDigests(ctx context.Context, tgID int64, now time.Time) {
+	settings, err := s.deliveryRepo.GetSettings(ctx, tgID)
+	if err != nil {
+		slog.Error("Get delivery settings failed", "error", err.Error(), "tgID", tgID)
+		return
+	}
+
+	if settings.InQuietHours(now) {
+		return
+	}
+
+	windows := []struct {
+		mode domain.DeliveryMode
+		end  time.Time
+	}{
+		{mode: domain.DeliveryHourly, end: settings.LastHourlyDigest(now)},
+		{mode: domain.DeliveryDaily, end: settings.LastDailyDigest(now)},
+		{mode: domain.DeliveryQuiet, end: now},
+	}
+
+	for _, window := range windows {
+		pending, err := s.deliveryRepo.GetPendingUpdates(ctx, tgID, window.mode, window.end)
+		if err != nil {
+			slog.Error("Get pending updates failed", "error", err.Error(), "tgID", tgID, "mode", window.mode)
+			continue
+		}
+
+		if len(pending) == 0 {
+			continue
+		}
+
+		digest := domain.Digest{TgID: tgID, Mode: window.mode, Updates: make([]domain.LinkUpdate, 0, len(pending))}
+		ids := make([]int64, 0, len(pending))
+
+		for _, p := range pending {
+			p.Update.TgIDs = []int64{tgID}
+			digest.Updates = append(digest.Updates, p.Update)
+			ids = append(ids, p.ID)
+		}
+
+		if err := s.notifier.PostDigest(ctx, &digest); err != nil {
+			slog.Error("Post digest failed", "error", err.Error(), "tgID", tgID, "mode", window.mode)
+			continue
+		}
+
+		if err := s.deliveryRepo.DeletePendingUpdates(ctx, ids); err != nil {
+			slog.Error("Delete pending updates failed", "error", err.Error(), "tgID", tgID)
+			continue
+		}
+
+		slog.Info("Send digest done", "tgID", tgID, "mode", window.mode, "updates", len(ids))
+	}
+}
+
+func (s *Scrapper) GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error) {
+	settings, err := s.deliveryRepo.GetSettings(ctx, tgID)
+	if err != nil {
+		slog.Error("Get settings failed", "error", err.Error(), "tgID", tgID)
+		return domain.DeliverySettings{}, err
+	}
+
+	slog.Info("Get settings done", "tgID", tgID)
+
+	return settings, nil
+}
+
+// UpdateSettings меняет заданные поля настроек доставки и возвращает получившиеся настройки.
+func (s *Scrapper) UpdateSettings(ctx context.Context, tgID int64,
+	update *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error) {
+	settings, err := s.deliveryRepo.GetSettings(ctx, tgID)
+	if err != nil {
+		slog.Error("Update settings failed", "error", err.Error(), "tgID", tgID)
+		return domain.DeliverySettings{}, err
+	}
+
+	if err := settings.Apply(update); err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	if err := s.deliveryRepo.SaveSettings(ctx, tgID, &settings); err != nil {
+		slog.Error("Update settings failed", "error", err.Error(), "tgID", tgID)
+		return domain.DeliverySettings{}, err
+	}
+
+	slog.Info("Update settings done", "tgID", tgID, "mode", settings.Mode, "timezone", settings.Timezone,
+		"quietHours", settings.Quiet.String())
+
+	return settings, nil
+}
+
+// SetTagMode задаёт режим доставки для ссылок с тегом tag. Пустой режим сбрасывает настройку тега.
+func (s *Scrapper) SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error {
+	if tag == "" {
+		return domain.ErrInvalidSettings{Reason: "empty tag"}
+	}
+
+	var err error
+
+	if mode == "" {
+		err = s.deliveryRepo.DeleteTagMode(ctx, tgID, tag)
+	} else {
+		if _, ok := domain.ParseDeliveryMode(string(mode)); !ok {
+			return domain.ErrInvalidSettings{Reason: fmt.Sprintf("unknown delivery mode %q", mode)}
+		}
+
+		err = s.deliveryRepo.SetTagMode(ctx, tgID, tag, mode)
+	}
+
+	if err != nil {
+		slog.Error("Set tag mode failed", "error", err.Error(), "tgID", tgID, "tag", tag)
+		return err
+	}
+
+	slog.Info("Set tag mode done", "tgID", tgID, "tag", tag, "mode", mode)
+
+	return nil
+}
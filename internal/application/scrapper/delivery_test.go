@@ -0,0 +1,228 @@
+package scrapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func newDeliveryScrapper(deliveryRepo *mocks.DeliveryRepo, notifier *mocks.Notifier) *scrapper.Scrapper {
+	return scrapper.NewScrapper(&mocks.UserRepo{}, &mocks.LinkRepo{}, &mocks.StateRepo{}, deliveryRepo, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, notifier, &mocks.LinkChecker{})
+}
+
+func Test_Scrapper_DeliverUpdate_SplitsByMode(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	update := domain.LinkUpdate{
+		Link:        domain.Link{ID: 7, URL: "https://github.com/owner/repo"},
+		TgIDs:       []int64{1, 2, 3},
+		Description: "description",
+	}
+
+	deliveryRepo.On("GetDeliveryTargets", ctx, int64(7)).Return(map[int64]domain.DeliveryTarget{
+		1: {Mode: domain.DeliveryImmediate},
+		2: {Mode: domain.DeliveryDaily},
+	}, nil)
+	deliveryRepo.On("AddPendingUpdate", ctx, int64(2), domain.DeliveryDaily, &update).Return(nil)
+	notifier.On("PostUpdates", ctx, mock.MatchedBy(func(u *domain.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{1, 3}, u.TgIDs) && u.Description == "description"
+	})).Return(nil)
+
+	s.DeliverUpdate(ctx, &update)
+
+	deliveryRepo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func Test_Scrapper_DeliverUpdate_AllPending(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	update := domain.LinkUpdate{Link: domain.Link{ID: 7}, TgIDs: []int64{1}}
+
+	deliveryRepo.On("GetDeliveryTargets", ctx, int64(7)).
+		Return(map[int64]domain.DeliveryTarget{1: {Mode: domain.DeliveryHourly}}, nil)
+	deliveryRepo.On("AddPendingUpdate", ctx, int64(1), domain.DeliveryHourly, &update).Return(nil)
+
+	s.DeliverUpdate(ctx, &update)
+
+	deliveryRepo.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "PostUpdates", mock.Anything, mock.Anything)
+}
+
+func Test_Scrapper_DeliverUpdate_ModesErrorSendsImmediately(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	update := domain.LinkUpdate{Link: domain.Link{ID: 7}, TgIDs: []int64{1, 2}}
+
+	deliveryRepo.On("GetDeliveryTargets", ctx, int64(7)).Return(nil, errors.New("some error"))
+	notifier.On("PostUpdates", ctx, mock.MatchedBy(func(u *domain.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{1, 2}, u.TgIDs)
+	})).Return(nil)
+
+	s.DeliverUpdate(ctx, &update)
+
+	notifier.AssertExpectations(t)
+}
+
+// quietNow возвращает тихие часы по UTC, которые идут прямо сейчас: с часа назад до часа вперёд.
+func quietNow() domain.QuietHours {
+	now := time.Now().UTC()
+	minute := now.Hour()*60 + now.Minute()
+
+	return domain.QuietHours{Start: (minute + 23*60) % (24 * 60), End: (minute + 60) % (24 * 60)}
+}
+
+func Test_Scrapper_DeliverUpdate_QuietHours(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	update := domain.LinkUpdate{Link: domain.Link{ID: 7}, TgIDs: []int64{1, 2}}
+	quiet := quietNow()
+
+	deliveryRepo.On("GetDeliveryTargets", ctx, int64(7)).Return(map[int64]domain.DeliveryTarget{
+		1: {Mode: domain.DeliveryImmediate, Quiet: quiet, Timezone: "UTC"},
+		2: {Mode: domain.DeliveryImmediate, Urgent: true, Quiet: quiet, Timezone: "UTC"},
+	}, nil)
+	deliveryRepo.On("AddPendingUpdate", ctx, int64(1), domain.DeliveryQuiet, &update).Return(nil)
+	notifier.On("PostUpdates", ctx, mock.MatchedBy(func(u *domain.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{2}, u.TgIDs)
+	})).Return(nil)
+
+	s.DeliverUpdate(ctx, &update)
+
+	deliveryRepo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func Test_Scrapper_SendDigests_HeldDuringQuietHours(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	tgID := int64(1)
+	settings := domain.DefaultDeliverySettings()
+	settings.Quiet = quietNow()
+
+	deliveryRepo.On("GetPendingUsers", ctx).Return([]int64{tgID}, nil)
+	deliveryRepo.On("GetSettings", ctx, tgID).Return(settings, nil)
+
+	s.SendDigests(ctx)
+
+	deliveryRepo.AssertNotCalled(t, "GetPendingUpdates", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "PostDigest", mock.Anything, mock.Anything)
+}
+
+func Test_Scrapper_SendDigests(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	tgID := int64(1)
+	settings := domain.DefaultDeliverySettings()
+	settings.Timezone = "Europe/Moscow"
+
+	pending := []domain.PendingUpdate{
+		{ID: 10, Update: domain.LinkUpdate{Link: domain.Link{ID: 7, URL: "https://github.com/owner/repo"}}},
+		{ID: 11, Update: domain.LinkUpdate{Link: domain.Link{ID: 8, URL: "https://github.com/owner/other"}}},
+	}
+
+	isPast := mock.MatchedBy(func(createdBefore time.Time) bool {
+		return !createdBefore.After(time.Now()) && time.Since(createdBefore) <= 24*time.Hour
+	})
+
+	deliveryRepo.On("GetPendingUsers", ctx).Return([]int64{tgID}, nil)
+	deliveryRepo.On("GetSettings", ctx, tgID).Return(settings, nil)
+	deliveryRepo.On("GetPendingUpdates", ctx, tgID, domain.DeliveryHourly, isPast).Return(pending, nil)
+	deliveryRepo.On("GetPendingUpdates", ctx, tgID, domain.DeliveryDaily, isPast).Return(nil, nil)
+	deliveryRepo.On("GetPendingUpdates", ctx, tgID, domain.DeliveryQuiet, isPast).Return(nil, nil)
+	deliveryRepo.On("DeletePendingUpdates", ctx, []int64{10, 11}).Return(nil)
+	notifier.On("PostDigest", ctx, mock.MatchedBy(func(d *domain.Digest) bool {
+		return d.TgID == tgID && d.Mode == domain.DeliveryHourly && len(d.Updates) == 2 &&
+			assert.ObjectsAreEqual([]int64{tgID}, d.Updates[0].TgIDs)
+	})).Return(nil)
+
+	s.SendDigests(ctx)
+
+	deliveryRepo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func Test_Scrapper_SendDigests_PostFailedKeepsPending(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	notifier := &mocks.Notifier{}
+	s := newDeliveryScrapper(deliveryRepo, notifier)
+
+	tgID := int64(1)
+	pending := []domain.PendingUpdate{{ID: 10, Update: domain.LinkUpdate{Link: domain.Link{ID: 7}}}}
+
+	deliveryRepo.On("GetPendingUsers", ctx).Return([]int64{tgID}, nil)
+	deliveryRepo.On("GetSettings", ctx, tgID).Return(domain.DefaultDeliverySettings(), nil)
+	deliveryRepo.On("GetPendingUpdates", ctx, tgID, domain.DeliveryHourly, mock.Anything).Return(nil, nil)
+	deliveryRepo.On("GetPendingUpdates", ctx, tgID, domain.DeliveryDaily, mock.Anything).Return(pending, nil)
+	deliveryRepo.On("GetPendingUpdates", ctx, tgID, domain.DeliveryQuiet, mock.Anything).Return(nil, nil)
+	notifier.On("PostDigest", ctx, mock.Anything).Return(errors.New("bot unavailable"))
+
+	s.SendDigests(ctx)
+
+	deliveryRepo.AssertNotCalled(t, "DeletePendingUpdates", mock.Anything, mock.Anything)
+}
+
+func Test_Scrapper_UpdateSettings(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	s := newDeliveryScrapper(deliveryRepo, &mocks.Notifier{})
+
+	tgID := int64(1)
+	mode, digestTime := domain.DeliveryDaily, 20*60
+
+	expected := domain.DefaultDeliverySettings()
+	expected.Mode, expected.DigestTime = mode, digestTime
+
+	deliveryRepo.On("GetSettings", ctx, tgID).Return(domain.DefaultDeliverySettings(), nil)
+	deliveryRepo.On("SaveSettings", ctx, tgID, &expected).Return(nil)
+
+	settings, err := s.UpdateSettings(ctx, tgID, &domain.DeliverySettingsUpdate{Mode: &mode, DigestTime: &digestTime})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, settings)
+	deliveryRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_UpdateSettings_InvalidTimezone(t *testing.T) {
+	ctx := context.Background()
+	deliveryRepo := &mocks.DeliveryRepo{}
+	s := newDeliveryScrapper(deliveryRepo, &mocks.Notifier{})
+
+	tgID := int64(1)
+	timezone := "Mars/Olympus"
+
+	deliveryRepo.On("GetSettings", ctx, tgID).Return(domain.DefaultDeliverySettings(), nil)
+
+	_, err := s.UpdateSettings(ctx, tgID, &domain.DeliverySettingsUpdate{Timezone: &timezone})
+
+	assert.ErrorAs(t, err, &domain.ErrInvalidSettings{})
+	deliveryRepo.AssertNotCalled(t, "SaveSettings", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,83 @@
+package scrapper
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+
+	// historyRetention — сколько хранятся записи истории обновлений.
+	historyRetention = 90 * 24 * time.Hour
+	// historyCleanupInterval — как часто из истории удаляются устаревшие записи.
+	historyCleanupInterval = time.Hour
+)
+
+// RecordUpdate сохраняет обнаруженное обновление в историю ссылки. Служебные предупреждения скраппера
+// событиями источника не являются и не сохраняются. Ошибка сохранения не мешает доставке уведомления.
+func (s *Scrapper) RecordUpdate(ctx context.Context, update *domain.LinkUpdate) {
+	if update.Warning {
+		return
+	}
+
+	if err := s.updateRepo.AddUpdate(ctx, update); err != nil {
+		slog.Error("Record update failed", "error", err.Error(), "url", update.Link.URL)
+	}
+}
+
+// DeleteExpiredUpdates удаляет из истории обновления, обнаруженные раньше срока хранения.
+func (s *Scrapper) DeleteExpiredUpdates(ctx context.Context) {
+	deleted, err := s.updateRepo.DeleteUpdatesBefore(ctx, time.Now().UTC().Add(-historyRetention))
+	if err != nil {
+		slog.Error("Delete expired updates failed", "error", err.Error())
+		return
+	}
+
+	slog.Info("Delete expired updates done", "deleted", deleted)
+}
+
+// GetLinkUpdates возвращает страницу истории обновлений отслеживаемой пользователем ссылки
+// от новых к старым и общее число записей. Нулевой limit заменяется значением по умолчанию.
+func (s *Scrapper) GetLinkUpdates(ctx context.Context, tgID, linkID, limit, offset int64) ([]domain.UpdateRecord, int64, error) {
+	links, err := s.linkRepo.GetUserLinks(ctx, tgID)
+	if err != nil {
+		slog.Error("Get link updates failed", "error", err.Error(), "tgID", tgID, "linkID", linkID)
+		return nil, 0, err
+	}
+
+	if !containsLink(links, linkID) {
+		return nil, 0, domain.ErrLinkNotExist{}
+	}
+
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	limit = min(limit, maxHistoryLimit)
+	offset = max(offset, 0)
+
+	records, total, err := s.updateRepo.GetLinkUpdates(ctx, linkID, limit, offset)
+	if err != nil {
+		slog.Error("Get link updates failed", "error", err.Error(), "tgID", tgID, "linkID", linkID)
+		return nil, 0, err
+	}
+
+	slog.Info("Get link updates done", "tgID", tgID, "linkID", linkID, "updates", len(records), "total", total)
+
+	return records, total, nil
+}
+
+func containsLink(links []domain.Link, linkID int64) bool {
+	for i := range links {
+		if links[i].ID == linkID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package scrapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func Test_Scrapper_GetLinkUpdates_ClampsPage(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	updateRepo := &mocks.UpdateRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, updateRepo,
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	records := []domain.UpdateRecord{{ID: 3, LinkID: 7}}
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{{ID: 7}}, nil)
+	updateRepo.On("GetLinkUpdates", ctx, int64(7), int64(100), int64(0)).Return(records, int64(1), nil).Once()
+	updateRepo.On("GetLinkUpdates", ctx, int64(7), int64(20), int64(5)).Return(records, int64(1), nil).Once()
+
+	got, total, err := s.GetLinkUpdates(ctx, tgID, 7, 500, -2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, records, got)
+	assert.Equal(t, int64(1), total)
+
+	_, _, err = s.GetLinkUpdates(ctx, tgID, 7, 0, 5)
+
+	assert.NoError(t, err)
+	updateRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_GetLinkUpdates_NotOwnedLink(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	updateRepo := &mocks.UpdateRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, updateRepo,
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{{ID: 1}}, nil)
+
+	_, _, err := s.GetLinkUpdates(ctx, tgID, 7, 10, 0)
+
+	assert.ErrorAs(t, err, &domain.ErrLinkNotExist{})
+	updateRepo.AssertNotCalled(t, "GetLinkUpdates")
+}
+
+func Test_Scrapper_RecordUpdate_IgnoresRepoError(t *testing.T) {
+	ctx := context.Background()
+	updateRepo := &mocks.UpdateRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, updateRepo,
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	update := &domain.LinkUpdate{Link: domain.Link{ID: 7, URL: "https://github.com/a/b"}, Description: "new"}
+
+	updateRepo.On("AddUpdate", ctx, update).Return(errors.New("db down")).Once()
+
+	assert.NotPanics(t, func() { s.RecordUpdate(ctx, update) })
+	updateRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_RecordUpdate_SkipsWarnings(t *testing.T) {
+	ctx := context.Background()
+	updateRepo := &mocks.UpdateRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, updateRepo,
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	s.RecordUpdate(ctx, &domain.LinkUpdate{
+		Link:        domain.Link{ID: 7, URL: "https://github.com/a/b"},
+		Description: "Ссылку не удаётся проверить",
+		Warning:     true,
+	})
+
+	updateRepo.AssertNotCalled(t, "AddUpdate")
+}
+
+func Test_Scrapper_DeleteExpiredUpdates(t *testing.T) {
+	ctx := context.Background()
+	updateRepo := &mocks.UpdateRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, updateRepo,
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	updateRepo.On("DeleteUpdatesBefore", ctx, mock.MatchedBy(func(detectedBefore time.Time) bool {
+		return detectedBefore.Before(time.Now().UTC().Add(-30 * 24 * time.Hour))
+	})).Return(int64(3), nil).Once()
+
+	s.DeleteExpiredUpdates(ctx)
+
+	updateRepo.AssertExpectations(t)
+}
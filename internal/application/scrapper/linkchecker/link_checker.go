@@ -2,6 +2,7 @@ package linkchecker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -11,24 +12,55 @@ import (
 
 	"LinkTracker/internal/application/scrapper"
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/metrics"
+	"LinkTracker/internal/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // LinkSourceHandler определяет интерфейс для проверки ссылки для конкретного источника.
+// Probe проверяет, что источник существует, и возвращает ErrSourceNotFound, если его нет.
 type LinkSourceHandler interface {
 	Supports(link *url.URL) bool
-	Check(ctx context.Context, link *domain.Link) (lastUpdate time.Time, description string, err error)
+	Check(ctx context.Context, link *domain.Link) (domain.CheckResult, error)
+	Probe(ctx context.Context, link *domain.Link) error
+}
+
+// statusWarnings — предупреждения, которые получают подписчики, когда источник перестаёт обновляться.
+var statusWarnings = map[domain.SourceStatus]string{
+	domain.SourceArchived: "Репозиторий перенесён в архив",
+	domain.SourceClosed:   "Вопрос закрыт",
+	domain.SourceDeleted:  "Источник удалён, ссылка больше не проверяется",
 }
 
+const movedWarning = "Источник переименован или перенесён"
+
+const failingWarning = "Ссылку не удаётся проверить"
+
+// probeTimeout ограничивает проверку существования источника при добавлении ссылки,
+// чтобы исчерпанный лимит запросов к API не задерживал ответ. Он заметно меньше таймаута клиента bot,
+// иначе bot не дождётся ответа на /track, хотя ссылка будет сохранена.
+const probeTimeout = 2 * time.Second
+
+// batchProbeTimeout ограничивает все проверки существования источников при импорте пачки ссылок,
+// чтобы вместе с записью ссылок в базу ответ укладывался в таймаут клиента bot.
+const batchProbeTimeout = 3 * time.Second
+
 // LinkChecker выполняет проверку ссылок в пакетном и параллельном режимах.
 type LinkChecker struct {
 	linkRepo         scrapper.LinkRepo
 	handlers         []LinkSourceHandler
 	limitLinksInPage int64
 	workers          int
+	probeOnAdd       bool
+	failuresToWarn   int64
 }
 
-// NewLinkChecker создаёт новый экземпляр LinkChecker.
-func NewLinkChecker(linkRepo scrapper.LinkRepo, handlers []LinkSourceHandler, limitLinksInPage int64, workers int) *LinkChecker {
+// NewLinkChecker создаёт новый экземпляр LinkChecker. Если probeOnAdd включён, при добавлении ссылки
+// дополнительно проверяется, что источник существует. После failuresToWarn ошибок проверки подряд
+// подписчики получают предупреждение, нулевое значение отключает предупреждения.
+func NewLinkChecker(linkRepo scrapper.LinkRepo, handlers []LinkSourceHandler, limitLinksInPage int64, workers int,
+	probeOnAdd bool, failuresToWarn int64) *LinkChecker {
 	if workers < 1 {
 		workers = 1
 	}
@@ -38,18 +70,80 @@ func NewLinkChecker(linkRepo scrapper.LinkRepo, handlers []LinkSourceHandler, li
 		handlers:         handlers,
 		limitLinksInPage: limitLinksInPage,
 		workers:          workers,
+		probeOnAdd:       probeOnAdd,
+		failuresToWarn:   failuresToWarn,
 	}
 }
 
+// ValidateLink проверяет, что ссылку поддерживает один из обработчиков, и, если включено,
+// что источник существует. Неподдерживаемая ссылка возвращает ErrUnsupportedHost, ненайденный
+// источник — ErrSourceNotFound. Другие ошибки проверки существования не мешают добавить ссылку:
+// источник будет проверен при следующем обходе.
+func (l *LinkChecker) ValidateLink(ctx context.Context, link *domain.Link) error {
+	handler := l.findHandler(link.URL)
+	if handler == nil {
+		return domain.ErrUnsupportedHost{}
+	}
+
+	if !l.probeOnAdd {
+		return nil
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	err := handler.Probe(probeCtx, link)
+	if errors.As(err, &domain.ErrSourceNotFound{}) {
+		return err
+	}
+
+	if err != nil {
+		slog.Warn("Link probe failed", "link", link.URL, "error", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateLinks проверяет пачку ссылок так же, как ValidateLink. Источники опрашиваются параллельно,
+// не больше workers одновременно, а все проверки вместе ограничены batchProbeTimeout: не успевшая
+// проверка не мешает добавить ссылку. Ошибка для links[i] возвращается в i-м элементе результата.
+func (l *LinkChecker) ValidateLinks(ctx context.Context, links []domain.Link) []error {
+	ctx, cancel := context.WithTimeout(ctx, batchProbeTimeout)
+	defer cancel()
+
+	errs := make([]error, len(links))
+	slots := make(chan struct{}, l.workers)
+
+	var wg sync.WaitGroup
+
+	for i := range links {
+		slots <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			errs[i] = l.ValidateLink(ctx, &links[i])
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
 // CheckLinks выполняет обход ссылок пакетами с параллельной обработкой каждого батча.
-// Обновления передаются через канал linkUpdates.
-func (l *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) {
+// Обновления передаются через канал linkUpdates. Возвращает число проверенных ссылок и ошибок проверки.
+func (l *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) domain.ScrapeStats {
 	slog.Info("Scrape start")
 
 	var (
 		lastUpdateTime   time.Time
 		totalChecks      int64
 		successfulChecks int64
+		failedChecks     int64
 	)
 
 	// Цикл по батчам ссылок через курсорную пагинацию.
@@ -57,7 +151,7 @@ func (l *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.
 		links, err := l.linkRepo.GetLinksAfter(ctx, lastUpdateTime, l.limitLinksInPage)
 		if err != nil {
 			slog.Error("Failed to retrieve links", "error", err.Error())
-			return
+			return domain.ScrapeStats{Checked: totalChecks, Failed: failedChecks}
 		}
 
 		if len(links) == 0 {
@@ -80,9 +174,13 @@ func (l *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.
 				for _, link := range chunk {
 					atomic.AddInt64(&totalChecks, 1)
 
-					err := l.processLink(ctx, &link, linkUpdates, &successfulChecks)
+					err := l.checkLink(ctx, &link, linkUpdates, &successfulChecks)
 					if err != nil {
 						slog.Error("Error processing link", "link", link.URL, "error", err.Error())
+
+						if !errors.As(err, &domain.ErrUpdatesNotFound{}) {
+							atomic.AddInt64(&failedChecks, 1)
+						}
 					}
 				}
 			}(chunk)
@@ -94,25 +192,73 @@ func (l *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.
 	slog.Info("Scrape finished",
 		"totalChecks", totalChecks,
 		"successfulChecks", successfulChecks,
+		"failedChecks", failedChecks,
 	)
+
+	return domain.ScrapeStats{Checked: totalChecks, Failed: failedChecks}
+}
+
+// CheckLink сразу проверяет одну ссылку вне расписания, например по запросу оператора. Результат
+// сохраняется и рассылается так же, как при плановой проверке. ErrUpdatesNotFound означает,
+// что проверка прошла, но новых событий нет.
+func (l *LinkChecker) CheckLink(ctx context.Context, link *domain.Link, linkUpdates chan<- domain.LinkUpdate) error {
+	var successfulChecks int64
+
+	return l.checkLink(ctx, link, linkUpdates, &successfulChecks)
+}
+
+func (l *LinkChecker) checkLink(ctx context.Context, link *domain.Link,
+	linkUpdates chan<- domain.LinkUpdate, successfulChecks *int64) error {
+	ctx, span := tracing.Start(ctx, "scrapper.check_link", attribute.String("link.url", link.URL))
+
+	err := l.processLink(ctx, link, linkUpdates, successfulChecks)
+	tracing.Finish(span, err)
+
+	return err
 }
 
 // processLink обрабатывает одну ссылку: ищет подходящий обработчик,
 // запускает проверку и, если необходимо, обновляет время последнего обновления и отправляет обновление через канал.
 func (l *LinkChecker) processLink(ctx context.Context, link *domain.Link,
 	linkUpdates chan<- domain.LinkUpdate, successfulChecks *int64) error {
+	start := time.Now()
+
 	handler := l.findHandler(link.URL)
 	if handler == nil {
 		slog.Error("Unsupported host", "link", link.URL)
+		metrics.ObserveCheck("unknown", metrics.CheckUnsupported, time.Since(start))
+		l.recordCheck(ctx, link, domain.ErrUnsupportedHost{}, linkUpdates)
+
 		return domain.ErrUnsupportedHost{}
 	}
 
-	lastUpdate, description, err := handler.Check(ctx, link)
+	result, err := handler.Check(ctx, link)
+	metrics.ObserveCheck(checkSource(link.URL), checkOutcome(link, &result, err), time.Since(start))
+
+	if result.MovedTo != "" && result.MovedTo != link.URL {
+		if moveErr := l.moveLink(ctx, link, result.MovedTo, linkUpdates); moveErr != nil {
+			return moveErr
+		}
+	}
+
+	l.recordCheck(ctx, link, err, linkUpdates)
+
+	if !result.Metadata.IsEmpty() {
+		if metadataErr := l.refreshMetadata(ctx, link, &result.Metadata, linkUpdates); metadataErr != nil {
+			return metadataErr
+		}
+	}
+
+	if errors.As(err, &domain.ErrSourceDeleted{}) {
+		slog.Info("Link source deleted, link is no longer checked", "link", link.URL)
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
 
-	err = l.linkRepo.UpdateTimeLink(ctx, lastUpdate, link.ID)
+	err = l.linkRepo.UpdateTimeLink(ctx, result.LastUpdate, link.ID)
 	if err != nil {
 		slog.Error("Update time link failed", "error", err.Error(), "link", link.URL)
 		return fmt.Errorf("failed update time: %w", err)
@@ -120,23 +266,179 @@ func (l *LinkChecker) processLink(ctx context.Context, link *domain.Link,
 
 	atomic.AddInt64(successfulChecks, 1)
 
-	if lastUpdate.After(link.LastUpdated) {
-		tgIDs, err := l.linkRepo.GetUsersByLink(ctx, link.ID)
+	if !result.LastUpdate.After(link.LastUpdated) {
+		return domain.ErrUpdatesNotFound{}
+	}
+
+	tgIDs, err := l.linkRepo.GetUsersByLink(ctx, link.ID)
+	if err != nil {
+		slog.Error("Failed to get users", "error", err.Error(), "link", link.URL)
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+
+	linkUpdates <- domain.LinkUpdate{
+		Link:        *link,
+		TgIDs:       tgIDs,
+		Description: result.Details.PlainText(),
+		Details:     result.Details,
+	}
+
+	return nil
+}
+
+// recordCheck сохраняет результат проверки ссылки. Отсутствие событий и удалённый источник ошибкой
+// проверки не считаются. Когда число ошибок подряд достигает failuresToWarn, подписчики один раз
+// получают предупреждение; следующее придёт только после успешной проверки и новой серии ошибок.
+// Ошибка сохранения только логируется, чтобы не мешать обработке результата проверки.
+func (l *LinkChecker) recordCheck(ctx context.Context, link *domain.Link, checkErr error, linkUpdates chan<- domain.LinkUpdate) {
+	var errText string
+	if checkErr != nil && !errors.As(checkErr, &domain.ErrUpdatesNotFound{}) && !errors.As(checkErr, &domain.ErrSourceDeleted{}) {
+		errText = checkErr.Error()
+	}
+
+	failures, err := l.linkRepo.RecordLinkCheck(ctx, link.ID, time.Now().UTC(), errText)
+	if err != nil {
+		slog.Error("Record link check failed", "error", err.Error(), "link", link.URL)
+		return
+	}
+
+	if l.failuresToWarn < 1 || failures != l.failuresToWarn {
+		return
+	}
+
+	tgIDs, err := l.linkRepo.GetUsersByLink(ctx, link.ID)
+	if err != nil {
+		slog.Error("Failed to get users", "error", err.Error(), "link", link.URL)
+		return
+	}
+
+	slog.Warn("Link keeps failing", "link", link.URL, "failures", failures, "error", errText)
+
+	linkUpdates <- domain.LinkUpdate{
+		Link:        *link,
+		TgIDs:       tgIDs,
+		Description: fmt.Sprintf("%s: ошибок подряд %d, последняя: %s", failingWarning, failures, errText),
+		Details: domain.UpdateDetails{
+			Kind:      failingWarning,
+			Title:     link.URL,
+			URL:       link.URL,
+			CreatedAt: time.Now().UTC(),
+			Preview:   "Последняя ошибка: " + errText,
+		},
+		Warning: true,
+	}
+}
+
+// moveLink переносит ссылку на новый адрес источника и один раз сообщает об этом подписчикам.
+// Если новый адрес уже отслеживается, ссылка объединяется с ним, и дальше link указывает на общую запись.
+func (l *LinkChecker) moveLink(ctx context.Context, link *domain.Link, movedTo string,
+	linkUpdates chan<- domain.LinkUpdate) error {
+	tgIDs, err := l.linkRepo.GetUsersByLink(ctx, link.ID)
+	if err != nil {
+		slog.Error("Failed to get users", "error", err.Error(), "link", link.URL)
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+
+	linkID, err := l.linkRepo.MoveLink(ctx, link.ID, movedTo)
+	if err != nil {
+		slog.Error("Move link failed", "error", err.Error(), "link", link.URL, "movedTo", movedTo)
+		return fmt.Errorf("failed move link: %w", err)
+	}
+
+	slog.Info("Link source moved", "link", link.URL, "movedTo", movedTo, "linkId", linkID)
+
+	oldURL := link.URL
+	link.URL, link.ID = movedTo, linkID
+
+	linkUpdates <- domain.LinkUpdate{
+		Link:        *link,
+		TgIDs:       tgIDs,
+		Description: movedWarning + ": " + oldURL + " → " + movedTo,
+		Details: domain.UpdateDetails{
+			Kind:      movedWarning,
+			Title:     movedTo,
+			URL:       movedTo,
+			CreatedAt: time.Now().UTC(),
+			Preview:   "Прежний адрес: " + oldURL,
+		},
+		Warning: true,
+	}
+
+	return nil
+}
+
+// refreshMetadata сохраняет метаданные источника и, если источник только что перестал обновляться,
+// один раз предупреждает об этом подписчиков.
+func (l *LinkChecker) refreshMetadata(ctx context.Context, link *domain.Link, metadata *domain.LinkMetadata,
+	linkUpdates chan<- domain.LinkUpdate) error {
+	if *metadata != link.Metadata {
+		err := l.linkRepo.UpdateLinkMetadata(ctx, link.ID, metadata)
 		if err != nil {
-			slog.Error("Failed to get users", "error", err.Error(), "link", link.URL)
-			return fmt.Errorf("failed to get users: %w", err)
+			slog.Error("Update link metadata failed", "error", err.Error(), "link", link.URL)
+			return fmt.Errorf("failed update metadata: %w", err)
 		}
+	}
 
-		linkUpdates <- domain.LinkUpdate{
-			Link:        *link,
-			TgIDs:       tgIDs,
-			Description: description,
-		}
+	warning, ok := statusWarnings[metadata.Status]
+	if !ok || metadata.Status == link.Metadata.Status {
+		return nil
+	}
+
+	tgIDs, err := l.linkRepo.GetUsersByLink(ctx, link.ID)
+	if err != nil {
+		slog.Error("Failed to get users", "error", err.Error(), "link", link.URL)
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+
+	slog.Info("Link source became inactive", "link", link.URL, "status", metadata.Status)
+
+	description := warning
+	if metadata.Title != "" {
+		description += ": " + metadata.Title
+	}
+
+	linkUpdates <- domain.LinkUpdate{
+		Link:        *link,
+		TgIDs:       tgIDs,
+		Description: description,
+		Details: domain.UpdateDetails{
+			Kind:      warning,
+			Title:     metadata.Title,
+			URL:       link.URL,
+			CreatedAt: time.Now().UTC(),
+		},
+		Warning: true,
 	}
 
 	return nil
 }
 
+// checkSource возвращает источник ссылки для метрик: хост без www.
+func checkSource(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "unknown"
+	}
+
+	return domain.CanonicalHost(parsed.Host)
+}
+
+// checkOutcome определяет исход проверки ссылки для метрик.
+func checkOutcome(link *domain.Link, result *domain.CheckResult, err error) string {
+	switch {
+	case errors.As(err, &domain.ErrSourceDeleted{}):
+		return metrics.CheckDeleted
+	case errors.As(err, &domain.ErrUpdatesNotFound{}):
+		return metrics.CheckNoUpdates
+	case err != nil:
+		return metrics.CheckError
+	case result.LastUpdate.After(link.LastUpdated):
+		return metrics.CheckUpdated
+	default:
+		return metrics.CheckNoUpdates
+	}
+}
+
 // findHandler парсит URL и ищет первый подходящий обработчик.
 func (l *LinkChecker) findHandler(rawURL string) LinkSourceHandler {
 	parsed, err := url.Parse(rawURL)
@@ -3,11 +3,13 @@ package linkchecker_test
 import (
 	"context"
 	"errors"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"LinkTracker/internal/application/scrapper/linkchecker"
 	"LinkTracker/internal/application/scrapper/linkchecker/mocks"
@@ -25,7 +27,7 @@ func Test_LinkChecker_CheckLinks(t *testing.T) {
 	linkUpdates := make(chan domain.LinkUpdate, 100)
 	updateTime := time.Now()
 	usersTgIDs := []int64{1, 2, 3}
-	descriptionUpdate := "update"
+	detailsUpdate := domain.UpdateDetails{Kind: "Issue", Title: "update"}
 
 	link1 := domain.Link{URL: "https://example/example", ID: 1,
 		LastUpdated: time.Date(2025, 1, 1, 1, 1, 1, 1, time.UTC)}
@@ -36,21 +38,280 @@ func Test_LinkChecker_CheckLinks(t *testing.T) {
 	linkRepo.On("GetLinksAfter", ctx, time.Time{}, limitLinksInPage).Return(links, nil).Once()
 	linkRepo.On("GetLinksAfter", ctx, link2.LastUpdated, limitLinksInPage).Return(nil, nil).Once()
 	handler.On("Supports", mock.Anything).Return(true)
-	handler.On("Check", ctx, &link1).Return(time.Time{}, "", errors.New("not Updates")).Once()
-	handler.On("Check", ctx, &link2).Return(updateTime, descriptionUpdate, nil).Once()
-	linkRepo.On("UpdateTimeLink", ctx, updateTime, link2.ID).Return(nil)
-	linkRepo.On("GetUsersByLink", ctx, link2.ID).Return(usersTgIDs, nil)
+	handler.On("Check", mock.Anything, &link1).Return(domain.CheckResult{}, errors.New("not Updates")).Once()
+	handler.On("Check", mock.Anything, &link2).Return(domain.CheckResult{LastUpdate: updateTime, Details: detailsUpdate}, nil).Once()
+	linkRepo.On("UpdateTimeLink", mock.Anything, updateTime, link2.ID).Return(nil)
+	linkRepo.On("RecordLinkCheck", mock.Anything, link1.ID, mock.Anything, "not Updates").Return(int64(1), nil).Once()
+	linkRepo.On("RecordLinkCheck", mock.Anything, link2.ID, mock.Anything, "").Return(int64(0), nil).Once()
+	linkRepo.On("GetUsersByLink", mock.Anything, link2.ID).Return(usersTgIDs, nil)
 
-	linksChecker := linkchecker.NewLinkChecker(linkRepo, []linkchecker.LinkSourceHandler{handler}, limitLinksInPage, workers)
+	linksChecker := linkchecker.NewLinkChecker(linkRepo, []linkchecker.LinkSourceHandler{handler}, limitLinksInPage, workers, false, 0)
 
-	linksChecker.CheckLinks(ctx, linkUpdates)
+	stats := linksChecker.CheckLinks(ctx, linkUpdates)
+
+	assert.Equal(t, domain.ScrapeStats{Checked: 2, Failed: 1}, stats)
 
 	update2 := <-linkUpdates
 
 	assert.Equal(t, usersTgIDs, update2.TgIDs)
 	assert.Equal(t, link2, update2.Link)
-	assert.Equal(t, descriptionUpdate, update2.Description)
+	assert.Equal(t, detailsUpdate, update2.Details)
+	assert.Equal(t, detailsUpdate.PlainText(), update2.Description)
+
+	linkRepo.AssertExpectations(t)
+	handler.AssertExpectations(t)
+}
+
+// Test_LinkChecker_CheckLinks_Metadata проверяет сохранение метаданных и однократное предупреждение
+// подписчиков, когда репозиторий переносят в архив.
+func Test_LinkChecker_CheckLinks_Metadata(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &scrappermocks.LinkRepo{}
+	handler := &mocks.LinkSourceHandler{}
+	linkUpdates := make(chan domain.LinkUpdate, 100)
+	usersTgIDs := []int64{1, 2}
+
+	archived := domain.LinkMetadata{Title: "owner/repo", Stars: 10, Status: domain.SourceArchived}
+
+	link1 := domain.Link{URL: "https://github.com/owner/repo", ID: 1,
+		LastUpdated: time.Date(2025, 1, 1, 1, 1, 1, 1, time.UTC),
+		Metadata:    domain.LinkMetadata{Title: "owner/repo", Stars: 9, Status: domain.SourceActive}}
+	link2 := domain.Link{URL: "https://github.com/owner/old", ID: 2,
+		LastUpdated: time.Date(2025, 2, 2, 2, 2, 2, 2, time.UTC),
+		Metadata:    domain.LinkMetadata{Title: "owner/old", Status: domain.SourceArchived}}
+
+	linkRepo.On("GetLinksAfter", ctx, time.Time{}, int64(10)).Return([]domain.Link{link1, link2}, nil).Once()
+	linkRepo.On("GetLinksAfter", ctx, link2.LastUpdated, int64(10)).Return(nil, nil).Once()
+	handler.On("Supports", mock.Anything).Return(true)
+	handler.On("Check", mock.Anything, &link1).Return(domain.CheckResult{Metadata: archived}, domain.ErrUpdatesNotFound{}).Once()
+	handler.On("Check", mock.Anything, &link2).Return(domain.CheckResult{Metadata: link2.Metadata}, domain.ErrUpdatesNotFound{}).Once()
+	linkRepo.On("UpdateLinkMetadata", mock.Anything, link1.ID, &archived).Return(nil).Once()
+	linkRepo.On("GetUsersByLink", mock.Anything, link1.ID).Return(usersTgIDs, nil).Once()
+	linkRepo.On("RecordLinkCheck", mock.Anything, mock.Anything, mock.Anything, "").Return(int64(0), nil).Times(2)
+
+	linksChecker := linkchecker.NewLinkChecker(linkRepo, []linkchecker.LinkSourceHandler{handler}, 10, 1, false, 0)
+
+	stats := linksChecker.CheckLinks(ctx, linkUpdates)
+
+	assert.Equal(t, domain.ScrapeStats{Checked: 2}, stats)
+
+	if assert.Len(t, linkUpdates, 1) {
+		warning := <-linkUpdates
+
+		assert.Equal(t, usersTgIDs, warning.TgIDs)
+		assert.Equal(t, link1.URL, warning.Link.URL)
+		assert.Equal(t, "Репозиторий перенесён в архив: owner/repo", warning.Description)
+		assert.Equal(t, "Репозиторий перенесён в архив", warning.Details.Kind)
+		assert.True(t, warning.Warning)
+		assert.Equal(t, link1.URL, warning.Details.URL)
+	}
 
 	linkRepo.AssertExpectations(t)
 	handler.AssertExpectations(t)
 }
+
+// Test_LinkChecker_CheckLinks_MovedAndDeleted проверяет перенос ссылки на новый адрес и остановку проверки
+// удалённого источника с однократным уведомлением подписчиков.
+func Test_LinkChecker_CheckLinks_MovedAndDeleted(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &scrappermocks.LinkRepo{}
+	handler := &mocks.LinkSourceHandler{}
+	linkUpdates := make(chan domain.LinkUpdate, 100)
+	updateTime := time.Date(2025, 3, 3, 3, 3, 3, 0, time.UTC)
+
+	active := domain.LinkMetadata{Title: "new/repo", Status: domain.SourceActive}
+	deleted := domain.LinkMetadata{Status: domain.SourceDeleted}
+
+	moved := domain.Link{URL: "https://github.com/old/repo", ID: 1,
+		LastUpdated: time.Date(2025, 1, 1, 1, 1, 1, 1, time.UTC), Metadata: active}
+	gone := domain.Link{URL: "https://stackoverflow.com/questions/1", ID: 2,
+		LastUpdated: time.Date(2025, 2, 2, 2, 2, 2, 2, time.UTC), Metadata: domain.LinkMetadata{Status: domain.SourceActive}}
+
+	linkRepo.On("GetLinksAfter", ctx, time.Time{}, int64(10)).Return([]domain.Link{moved, gone}, nil).Once()
+	linkRepo.On("GetLinksAfter", ctx, gone.LastUpdated, int64(10)).Return(nil, nil).Once()
+	handler.On("Supports", mock.Anything).Return(true)
+	handler.On("Check", mock.Anything, mock.MatchedBy(func(link *domain.Link) bool { return link.ID == moved.ID })).
+		Return(domain.CheckResult{LastUpdate: updateTime, Metadata: active, MovedTo: "https://github.com/new/repo"}, nil).Once()
+	handler.On("Check", mock.Anything, &gone).Return(domain.CheckResult{Metadata: deleted}, domain.ErrSourceDeleted{}).Once()
+
+	linkRepo.On("GetUsersByLink", mock.Anything, moved.ID).Return([]int64{1}, nil).Once()
+	linkRepo.On("MoveLink", mock.Anything, moved.ID, "https://github.com/new/repo").Return(int64(3), nil).Once()
+	linkRepo.On("UpdateTimeLink", mock.Anything, updateTime, int64(3)).Return(nil).Once()
+	linkRepo.On("GetUsersByLink", mock.Anything, int64(3)).Return([]int64{1, 4}, nil).Once()
+	linkRepo.On("UpdateLinkMetadata", mock.Anything, gone.ID, &deleted).Return(nil).Once()
+	linkRepo.On("GetUsersByLink", mock.Anything, gone.ID).Return([]int64{2}, nil).Once()
+	linkRepo.On("RecordLinkCheck", mock.Anything, int64(3), mock.Anything, "").Return(int64(0), nil).Once()
+	linkRepo.On("RecordLinkCheck", mock.Anything, gone.ID, mock.Anything, "").Return(int64(0), nil).Once()
+
+	linksChecker := linkchecker.NewLinkChecker(linkRepo, []linkchecker.LinkSourceHandler{handler}, 10, 1, false, 0)
+
+	linksChecker.CheckLinks(ctx, linkUpdates)
+
+	if assert.Len(t, linkUpdates, 3) {
+		movedUpdate := <-linkUpdates
+
+		assert.Equal(t, []int64{1}, movedUpdate.TgIDs)
+		assert.Equal(t, int64(3), movedUpdate.Link.ID)
+		assert.Equal(t, "Источник переименован или перенесён: https://github.com/old/repo → https://github.com/new/repo",
+			movedUpdate.Description)
+		assert.True(t, movedUpdate.Warning)
+
+		update := <-linkUpdates
+
+		assert.Equal(t, []int64{1, 4}, update.TgIDs)
+		assert.False(t, update.Warning)
+		assert.Equal(t, "https://github.com/new/repo", update.Link.URL)
+
+		goneUpdate := <-linkUpdates
+
+		assert.Equal(t, []int64{2}, goneUpdate.TgIDs)
+		assert.Equal(t, "Источник удалён, ссылка больше не проверяется", goneUpdate.Description)
+		assert.True(t, goneUpdate.Warning)
+	}
+
+	linkRepo.AssertExpectations(t)
+	handler.AssertExpectations(t)
+}
+
+// Test_LinkChecker_CheckLinks_FailingLink проверяет, что предупреждение о ссылке, которую не удаётся
+// проверить, приходит один раз, когда число ошибок подряд достигает порога.
+func Test_LinkChecker_CheckLinks_FailingLink(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &scrappermocks.LinkRepo{}
+	handler := &mocks.LinkSourceHandler{}
+	linkUpdates := make(chan domain.LinkUpdate, 100)
+
+	reached := domain.Link{URL: "https://github.com/owner/reached", ID: 1,
+		LastUpdated: time.Date(2025, 1, 1, 1, 1, 1, 1, time.UTC)}
+	exceeded := domain.Link{URL: "https://github.com/owner/exceeded", ID: 2,
+		LastUpdated: time.Date(2025, 2, 2, 2, 2, 2, 2, time.UTC)}
+
+	linkRepo.On("GetLinksAfter", ctx, time.Time{}, int64(10)).Return([]domain.Link{reached, exceeded}, nil).Once()
+	linkRepo.On("GetLinksAfter", ctx, exceeded.LastUpdated, int64(10)).Return(nil, nil).Once()
+	handler.On("Supports", mock.Anything).Return(true)
+	handler.On("Check", mock.Anything, mock.Anything).Return(domain.CheckResult{}, domain.ErrStatusNotOK{StatusCode: 500}).Times(2)
+	linkRepo.On("RecordLinkCheck", mock.Anything, reached.ID, mock.Anything, "status not ok, status code [500]").Return(int64(3), nil).Once()
+	linkRepo.On("RecordLinkCheck", mock.Anything, exceeded.ID, mock.Anything, "status not ok, status code [500]").Return(int64(4), nil).Once()
+	linkRepo.On("GetUsersByLink", mock.Anything, reached.ID).Return([]int64{1, 2}, nil).Once()
+
+	linksChecker := linkchecker.NewLinkChecker(linkRepo, []linkchecker.LinkSourceHandler{handler}, 10, 1, false, 3)
+
+	stats := linksChecker.CheckLinks(ctx, linkUpdates)
+
+	assert.Equal(t, domain.ScrapeStats{Checked: 2, Failed: 2}, stats)
+
+	if assert.Len(t, linkUpdates, 1) {
+		warning := <-linkUpdates
+
+		assert.Equal(t, []int64{1, 2}, warning.TgIDs)
+		assert.Equal(t, reached.URL, warning.Link.URL)
+		assert.Equal(t, "Ссылку не удаётся проверить: ошибок подряд 3, последняя: status not ok, status code [500]",
+			warning.Description)
+		assert.Equal(t, "Ссылку не удаётся проверить", warning.Details.Kind)
+		assert.True(t, warning.Warning)
+	}
+
+	linkRepo.AssertExpectations(t)
+	handler.AssertExpectations(t)
+}
+
+// Test_LinkChecker_CheckLink проверяет внеплановую проверку одной ссылки: результат сохраняется,
+// а если событий новее уже известного нет, возвращается ErrUpdatesNotFound.
+func Test_LinkChecker_CheckLink(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &scrappermocks.LinkRepo{}
+	handler := &mocks.LinkSourceHandler{}
+	linkUpdates := make(chan domain.LinkUpdate, 100)
+	lastUpdated := time.Date(2025, 1, 1, 1, 1, 1, 0, time.UTC)
+
+	link := domain.Link{URL: "https://github.com/owner/repo", ID: 1, LastUpdated: lastUpdated}
+
+	handler.On("Supports", mock.Anything).Return(true)
+	handler.On("Check", mock.Anything, &link).Return(domain.CheckResult{LastUpdate: lastUpdated}, nil).Once()
+	linkRepo.On("RecordLinkCheck", mock.Anything, link.ID, mock.Anything, "").Return(int64(0), nil).Once()
+	linkRepo.On("UpdateTimeLink", mock.Anything, lastUpdated, link.ID).Return(nil).Once()
+
+	linksChecker := linkchecker.NewLinkChecker(linkRepo, []linkchecker.LinkSourceHandler{handler}, 10, 1, false, 0)
+
+	err := linksChecker.CheckLink(ctx, &link, linkUpdates)
+
+	assert.ErrorAs(t, err, &domain.ErrUpdatesNotFound{})
+	assert.Empty(t, linkUpdates)
+	linkRepo.AssertExpectations(t)
+	handler.AssertExpectations(t)
+}
+
+// Test_LinkChecker_ValidateLink проверяет поддержку ссылки обработчиками и проверку существования источника.
+func Test_LinkChecker_ValidateLink(t *testing.T) {
+	tests := []struct {
+		name        string
+		supports    bool
+		probeOnAdd  bool
+		probeErr    error
+		expectedErr error
+	}{
+		{name: "Unsupported link", supports: false, expectedErr: domain.ErrUnsupportedHost{}},
+		{name: "Supported link without probe", supports: true},
+		{name: "Source exists", supports: true, probeOnAdd: true},
+		{name: "Source not found", supports: true, probeOnAdd: true, probeErr: domain.ErrSourceNotFound{},
+			expectedErr: domain.ErrSourceNotFound{}},
+		{name: "Probe failed", supports: true, probeOnAdd: true, probeErr: errors.New("rate limit")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &mocks.LinkSourceHandler{}
+			link := &domain.Link{URL: "https://github.com/owner/repo"}
+
+			handler.On("Supports", mock.Anything).Return(tt.supports)
+
+			if tt.probeOnAdd {
+				handler.On("Probe", mock.Anything, link).Return(tt.probeErr).Once()
+			}
+
+			linksChecker := linkchecker.NewLinkChecker(&scrappermocks.LinkRepo{}, []linkchecker.LinkSourceHandler{handler}, 10, 1,
+				tt.probeOnAdd, 0)
+
+			err := linksChecker.ValidateLink(context.Background(), link)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			handler.AssertExpectations(t)
+		})
+	}
+}
+
+// Test_LinkChecker_ValidateLinks проверяет пачку ссылок: результат i соответствует i-й ссылке,
+// а зависшая проверка источника не задерживает ответ дольше общего ограничения.
+func Test_LinkChecker_ValidateLinks(t *testing.T) {
+	handler := &mocks.LinkSourceHandler{}
+	found := domain.Link{URL: "https://github.com/owner/found"}
+	missing := domain.Link{URL: "https://github.com/owner/missing"}
+	slow := domain.Link{URL: "https://github.com/owner/slow"}
+	unsupported := domain.Link{URL: "https://example.com/owner/repo"}
+
+	handler.On("Supports", mock.Anything).Return(func(link *url.URL) bool { return link.Host == "github.com" })
+	handler.On("Probe", mock.Anything, &found).Return(nil).Once()
+	handler.On("Probe", mock.Anything, &missing).Return(domain.ErrSourceNotFound{}).Once()
+	handler.On("Probe", mock.Anything, &slow).
+		Run(func(args mock.Arguments) { <-args.Get(0).(context.Context).Done() }).
+		Return(context.DeadlineExceeded).Once()
+
+	linksChecker := linkchecker.NewLinkChecker(&scrappermocks.LinkRepo{}, []linkchecker.LinkSourceHandler{handler}, 10, 2,
+		true, 0)
+
+	start := time.Now()
+	errs := linksChecker.ValidateLinks(context.Background(), []domain.Link{found, missing, slow, unsupported})
+
+	assert.Less(t, time.Since(start), 4*time.Second)
+	require.Len(t, errs, 4)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], domain.ErrSourceNotFound{})
+	assert.NoError(t, errs[2], "Не успевшая проверка не мешает добавить ссылку")
+	assert.ErrorIs(t, errs[3], domain.ErrUnsupportedHost{})
+	handler.AssertExpectations(t)
+}
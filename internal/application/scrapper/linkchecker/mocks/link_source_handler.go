@@ -8,8 +8,6 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
-
 	url "net/url"
 )
 
@@ -27,38 +25,31 @@ func (_m *LinkSourceHandler) EXPECT() *LinkSourceHandler_Expecter {
 }
 
 // Check provides a mock function with given fields: ctx, link
-func (_m *LinkSourceHandler) Check(ctx context.Context, link *domain.Link) (time.Time, string, error) {
+func (_m *LinkSourceHandler) Check(ctx context.Context, link *domain.Link) (domain.CheckResult, error) {
 	ret := _m.Called(ctx, link)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Check")
 	}
 
-	var r0 time.Time
-	var r1 string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) (time.Time, string, error)); ok {
+	var r0 domain.CheckResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) (domain.CheckResult, error)); ok {
 		return rf(ctx, link)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) time.Time); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) domain.CheckResult); ok {
 		r0 = rf(ctx, link)
 	} else {
-		r0 = ret.Get(0).(time.Time)
+		r0 = ret.Get(0).(domain.CheckResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *domain.Link) string); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Link) error); ok {
 		r1 = rf(ctx, link)
 	} else {
-		r1 = ret.Get(1).(string)
+		r1 = ret.Error(1)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, *domain.Link) error); ok {
-		r2 = rf(ctx, link)
-	} else {
-		r2 = ret.Error(2)
-	}
-
-	return r0, r1, r2
+	return r0, r1
 }
 
 // LinkSourceHandler_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
@@ -80,12 +71,59 @@ func (_c *LinkSourceHandler_Check_Call) Run(run func(ctx context.Context, link *
 	return _c
 }
 
-func (_c *LinkSourceHandler_Check_Call) Return(lastUpdate time.Time, description string, err error) *LinkSourceHandler_Check_Call {
-	_c.Call.Return(lastUpdate, description, err)
+func (_c *LinkSourceHandler_Check_Call) Return(_a0 domain.CheckResult, _a1 error) *LinkSourceHandler_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkSourceHandler_Check_Call) RunAndReturn(run func(context.Context, *domain.Link) (domain.CheckResult, error)) *LinkSourceHandler_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Probe provides a mock function with given fields: ctx, link
+func (_m *LinkSourceHandler) Probe(ctx context.Context, link *domain.Link) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Probe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkSourceHandler_Probe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Probe'
+type LinkSourceHandler_Probe_Call struct {
+	*mock.Call
+}
+
+// Probe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.Link
+func (_e *LinkSourceHandler_Expecter) Probe(ctx interface{}, link interface{}) *LinkSourceHandler_Probe_Call {
+	return &LinkSourceHandler_Probe_Call{Call: _e.mock.On("Probe", ctx, link)}
+}
+
+func (_c *LinkSourceHandler_Probe_Call) Run(run func(ctx context.Context, link *domain.Link)) *LinkSourceHandler_Probe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Link))
+	})
+	return _c
+}
+
+func (_c *LinkSourceHandler_Probe_Call) Return(_a0 error) *LinkSourceHandler_Probe_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *LinkSourceHandler_Check_Call) RunAndReturn(run func(context.Context, *domain.Link) (time.Time, string, error)) *LinkSourceHandler_Check_Call {
+func (_c *LinkSourceHandler_Probe_Call) RunAndReturn(run func(context.Context, *domain.Link) error) *LinkSourceHandler_Probe_Call {
 	_c.Call.Return(run)
 	return _c
 }
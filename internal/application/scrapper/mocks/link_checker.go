@@ -22,9 +22,70 @@ func (_m *LinkChecker) EXPECT() *LinkChecker_Expecter {
 	return &LinkChecker_Expecter{mock: &_m.Mock}
 }
 
+// CheckLink provides a mock function with given fields: ctx, link, linkUpdates
+func (_m *LinkChecker) CheckLink(ctx context.Context, link *domain.Link, linkUpdates chan<- domain.LinkUpdate) error {
+	ret := _m.Called(ctx, link, linkUpdates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link, chan<- domain.LinkUpdate) error); ok {
+		r0 = rf(ctx, link, linkUpdates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkChecker_CheckLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckLink'
+type LinkChecker_CheckLink_Call struct {
+	*mock.Call
+}
+
+// CheckLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.Link
+//   - linkUpdates chan<- domain.LinkUpdate
+func (_e *LinkChecker_Expecter) CheckLink(ctx interface{}, link interface{}, linkUpdates interface{}) *LinkChecker_CheckLink_Call {
+	return &LinkChecker_CheckLink_Call{Call: _e.mock.On("CheckLink", ctx, link, linkUpdates)}
+}
+
+func (_c *LinkChecker_CheckLink_Call) Run(run func(ctx context.Context, link *domain.Link, linkUpdates chan<- domain.LinkUpdate)) *LinkChecker_CheckLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Link), args[2].(chan<- domain.LinkUpdate))
+	})
+	return _c
+}
+
+func (_c *LinkChecker_CheckLink_Call) Return(_a0 error) *LinkChecker_CheckLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkChecker_CheckLink_Call) RunAndReturn(run func(context.Context, *domain.Link, chan<- domain.LinkUpdate) error) *LinkChecker_CheckLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckLinks provides a mock function with given fields: ctx, linkUpdates
-func (_m *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) {
-	_m.Called(ctx, linkUpdates)
+func (_m *LinkChecker) CheckLinks(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) domain.ScrapeStats {
+	ret := _m.Called(ctx, linkUpdates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckLinks")
+	}
+
+	var r0 domain.ScrapeStats
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- domain.LinkUpdate) domain.ScrapeStats); ok {
+		r0 = rf(ctx, linkUpdates)
+	} else {
+		r0 = ret.Get(0).(domain.ScrapeStats)
+	}
+
+	return r0
 }
 
 // LinkChecker_CheckLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckLinks'
@@ -46,12 +107,108 @@ func (_c *LinkChecker_CheckLinks_Call) Run(run func(ctx context.Context, linkUpd
 	return _c
 }
 
-func (_c *LinkChecker_CheckLinks_Call) Return() *LinkChecker_CheckLinks_Call {
-	_c.Call.Return()
+func (_c *LinkChecker_CheckLinks_Call) Return(_a0 domain.ScrapeStats) *LinkChecker_CheckLinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkChecker_CheckLinks_Call) RunAndReturn(run func(context.Context, chan<- domain.LinkUpdate) domain.ScrapeStats) *LinkChecker_CheckLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateLink provides a mock function with given fields: ctx, link
+func (_m *LinkChecker) ValidateLink(ctx context.Context, link *domain.Link) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkChecker_ValidateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateLink'
+type LinkChecker_ValidateLink_Call struct {
+	*mock.Call
+}
+
+// ValidateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.Link
+func (_e *LinkChecker_Expecter) ValidateLink(ctx interface{}, link interface{}) *LinkChecker_ValidateLink_Call {
+	return &LinkChecker_ValidateLink_Call{Call: _e.mock.On("ValidateLink", ctx, link)}
+}
+
+func (_c *LinkChecker_ValidateLink_Call) Run(run func(ctx context.Context, link *domain.Link)) *LinkChecker_ValidateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Link))
+	})
+	return _c
+}
+
+func (_c *LinkChecker_ValidateLink_Call) Return(_a0 error) *LinkChecker_ValidateLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkChecker_ValidateLink_Call) RunAndReturn(run func(context.Context, *domain.Link) error) *LinkChecker_ValidateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateLinks provides a mock function with given fields: ctx, links
+func (_m *LinkChecker) ValidateLinks(ctx context.Context, links []domain.Link) []error {
+	ret := _m.Called(ctx, links)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateLinks")
+	}
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Link) []error); ok {
+		r0 = rf(ctx, links)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+// LinkChecker_ValidateLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateLinks'
+type LinkChecker_ValidateLinks_Call struct {
+	*mock.Call
+}
+
+// ValidateLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - links []domain.Link
+func (_e *LinkChecker_Expecter) ValidateLinks(ctx interface{}, links interface{}) *LinkChecker_ValidateLinks_Call {
+	return &LinkChecker_ValidateLinks_Call{Call: _e.mock.On("ValidateLinks", ctx, links)}
+}
+
+func (_c *LinkChecker_ValidateLinks_Call) Run(run func(ctx context.Context, links []domain.Link)) *LinkChecker_ValidateLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Link))
+	})
+	return _c
+}
+
+func (_c *LinkChecker_ValidateLinks_Call) Return(_a0 []error) *LinkChecker_ValidateLinks_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *LinkChecker_CheckLinks_Call) RunAndReturn(run func(context.Context, chan<- domain.LinkUpdate)) *LinkChecker_CheckLinks_Call {
+func (_c *LinkChecker_ValidateLinks_Call) RunAndReturn(run func(context.Context, []domain.Link) []error) *LinkChecker_ValidateLinks_Call {
 	_c.Call.Return(run)
 	return _c
 }
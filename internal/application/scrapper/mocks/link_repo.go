@@ -198,6 +198,63 @@ func (_c *LinkRepo_GetAllLinks_Call) RunAndReturn(run func(context.Context) ([]d
 	return _c
 }
 
+// GetLinkByURL provides a mock function with given fields: ctx, url
+func (_m *LinkRepo) GetLinkByURL(ctx context.Context, url string) (domain.Link, error) {
+	ret := _m.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkByURL")
+	}
+
+	var r0 domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Link, error)); ok {
+		return rf(ctx, url)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Link); ok {
+		r0 = rf(ctx, url)
+	} else {
+		r0 = ret.Get(0).(domain.Link)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_GetLinkByURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkByURL'
+type LinkRepo_GetLinkByURL_Call struct {
+	*mock.Call
+}
+
+// GetLinkByURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+func (_e *LinkRepo_Expecter) GetLinkByURL(ctx interface{}, url interface{}) *LinkRepo_GetLinkByURL_Call {
+	return &LinkRepo_GetLinkByURL_Call{Call: _e.mock.On("GetLinkByURL", ctx, url)}
+}
+
+func (_c *LinkRepo_GetLinkByURL_Call) Run(run func(ctx context.Context, url string)) *LinkRepo_GetLinkByURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_GetLinkByURL_Call) Return(_a0 domain.Link, _a1 error) *LinkRepo_GetLinkByURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_GetLinkByURL_Call) RunAndReturn(run func(context.Context, string) (domain.Link, error)) *LinkRepo_GetLinkByURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinksAfter provides a mock function with given fields: ctx, lastUpdate, limit
 func (_m *LinkRepo) GetLinksAfter(ctx context.Context, lastUpdate time.Time, limit int64) ([]domain.Link, error) {
 	ret := _m.Called(ctx, lastUpdate, limit)
@@ -258,6 +315,121 @@ func (_c *LinkRepo_GetLinksAfter_Call) RunAndReturn(run func(context.Context, ti
 	return _c
 }
 
+// GetTrackingStats provides a mock function with given fields: ctx
+func (_m *LinkRepo) GetTrackingStats(ctx context.Context) (domain.TrackingStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrackingStats")
+	}
+
+	var r0 domain.TrackingStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (domain.TrackingStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) domain.TrackingStats); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.TrackingStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_GetTrackingStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrackingStats'
+type LinkRepo_GetTrackingStats_Call struct {
+	*mock.Call
+}
+
+// GetTrackingStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LinkRepo_Expecter) GetTrackingStats(ctx interface{}) *LinkRepo_GetTrackingStats_Call {
+	return &LinkRepo_GetTrackingStats_Call{Call: _e.mock.On("GetTrackingStats", ctx)}
+}
+
+func (_c *LinkRepo_GetTrackingStats_Call) Run(run func(ctx context.Context)) *LinkRepo_GetTrackingStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_GetTrackingStats_Call) Return(_a0 domain.TrackingStats, _a1 error) *LinkRepo_GetTrackingStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_GetTrackingStats_Call) RunAndReturn(run func(context.Context) (domain.TrackingStats, error)) *LinkRepo_GetTrackingStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnhealthyLinks provides a mock function with given fields: ctx, minFailures
+func (_m *LinkRepo) GetUnhealthyLinks(ctx context.Context, minFailures int64) ([]domain.Link, error) {
+	ret := _m.Called(ctx, minFailures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnhealthyLinks")
+	}
+
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.Link, error)); ok {
+		return rf(ctx, minFailures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.Link); ok {
+		r0 = rf(ctx, minFailures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, minFailures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_GetUnhealthyLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnhealthyLinks'
+type LinkRepo_GetUnhealthyLinks_Call struct {
+	*mock.Call
+}
+
+// GetUnhealthyLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - minFailures int64
+func (_e *LinkRepo_Expecter) GetUnhealthyLinks(ctx interface{}, minFailures interface{}) *LinkRepo_GetUnhealthyLinks_Call {
+	return &LinkRepo_GetUnhealthyLinks_Call{Call: _e.mock.On("GetUnhealthyLinks", ctx, minFailures)}
+}
+
+func (_c *LinkRepo_GetUnhealthyLinks_Call) Run(run func(ctx context.Context, minFailures int64)) *LinkRepo_GetUnhealthyLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_GetUnhealthyLinks_Call) Return(_a0 []domain.Link, _a1 error) *LinkRepo_GetUnhealthyLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_GetUnhealthyLinks_Call) RunAndReturn(run func(context.Context, int64) ([]domain.Link, error)) *LinkRepo_GetUnhealthyLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserLinks provides a mock function with given fields: ctx, tgID
 func (_m *LinkRepo) GetUserLinks(ctx context.Context, tgID int64) ([]domain.Link, error) {
 	ret := _m.Called(ctx, tgID)
@@ -317,6 +489,125 @@ func (_c *LinkRepo_GetUserLinks_Call) RunAndReturn(run func(context.Context, int
 	return _c
 }
 
+// GetUserLinksByTag provides a mock function with given fields: ctx, tgID, tag
+func (_m *LinkRepo) GetUserLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	ret := _m.Called(ctx, tgID, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserLinksByTag")
+	}
+
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]domain.Link, error)); ok {
+		return rf(ctx, tgID, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []domain.Link); ok {
+		r0 = rf(ctx, tgID, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, tgID, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_GetUserLinksByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserLinksByTag'
+type LinkRepo_GetUserLinksByTag_Call struct {
+	*mock.Call
+}
+
+// GetUserLinksByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - tag string
+func (_e *LinkRepo_Expecter) GetUserLinksByTag(ctx interface{}, tgID interface{}, tag interface{}) *LinkRepo_GetUserLinksByTag_Call {
+	return &LinkRepo_GetUserLinksByTag_Call{Call: _e.mock.On("GetUserLinksByTag", ctx, tgID, tag)}
+}
+
+func (_c *LinkRepo_GetUserLinksByTag_Call) Run(run func(ctx context.Context, tgID int64, tag string)) *LinkRepo_GetUserLinksByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_GetUserLinksByTag_Call) Return(_a0 []domain.Link, _a1 error) *LinkRepo_GetUserLinksByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_GetUserLinksByTag_Call) RunAndReturn(run func(context.Context, int64, string) ([]domain.Link, error)) *LinkRepo_GetUserLinksByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTags provides a mock function with given fields: ctx, tgID
+func (_m *LinkRepo) GetUserTags(ctx context.Context, tgID int64) ([]domain.TagCount, error) {
+	ret := _m.Called(ctx, tgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTags")
+	}
+
+	var r0 []domain.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.TagCount, error)); ok {
+		return rf(ctx, tgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.TagCount); ok {
+		r0 = rf(ctx, tgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_GetUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTags'
+type LinkRepo_GetUserTags_Call struct {
+	*mock.Call
+}
+
+// GetUserTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+func (_e *LinkRepo_Expecter) GetUserTags(ctx interface{}, tgID interface{}) *LinkRepo_GetUserTags_Call {
+	return &LinkRepo_GetUserTags_Call{Call: _e.mock.On("GetUserTags", ctx, tgID)}
+}
+
+func (_c *LinkRepo_GetUserTags_Call) Run(run func(ctx context.Context, tgID int64)) *LinkRepo_GetUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_GetUserTags_Call) Return(_a0 []domain.TagCount, _a1 error) *LinkRepo_GetUserTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_GetUserTags_Call) RunAndReturn(run func(context.Context, int64) ([]domain.TagCount, error)) *LinkRepo_GetUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUsersByLink provides a mock function with given fields: ctx, linkID
 func (_m *LinkRepo) GetUsersByLink(ctx context.Context, linkID int64) ([]int64, error) {
 	ret := _m.Called(ctx, linkID)
@@ -376,6 +667,340 @@ func (_c *LinkRepo_GetUsersByLink_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// MoveLink provides a mock function with given fields: ctx, linkID, newURL
+func (_m *LinkRepo) MoveLink(ctx context.Context, linkID int64, newURL string) (int64, error) {
+	ret := _m.Called(ctx, linkID, newURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveLink")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (int64, error)); ok {
+		return rf(ctx, linkID, newURL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) int64); ok {
+		r0 = rf(ctx, linkID, newURL)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, linkID, newURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_MoveLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveLink'
+type LinkRepo_MoveLink_Call struct {
+	*mock.Call
+}
+
+// MoveLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID int64
+//   - newURL string
+func (_e *LinkRepo_Expecter) MoveLink(ctx interface{}, linkID interface{}, newURL interface{}) *LinkRepo_MoveLink_Call {
+	return &LinkRepo_MoveLink_Call{Call: _e.mock.On("MoveLink", ctx, linkID, newURL)}
+}
+
+func (_c *LinkRepo_MoveLink_Call) Run(run func(ctx context.Context, linkID int64, newURL string)) *LinkRepo_MoveLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_MoveLink_Call) Return(_a0 int64, _a1 error) *LinkRepo_MoveLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_MoveLink_Call) RunAndReturn(run func(context.Context, int64, string) (int64, error)) *LinkRepo_MoveLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseLinks provides a mock function with given fields: ctx, tgID, linkIDs, until
+func (_m *LinkRepo) PauseLinks(ctx context.Context, tgID int64, linkIDs []int64, until time.Time) error {
+	ret := _m.Called(ctx, tgID, linkIDs, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseLinks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64, time.Time) error); ok {
+		r0 = rf(ctx, tgID, linkIDs, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepo_PauseLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseLinks'
+type LinkRepo_PauseLinks_Call struct {
+	*mock.Call
+}
+
+// PauseLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - linkIDs []int64
+//   - until time.Time
+func (_e *LinkRepo_Expecter) PauseLinks(ctx interface{}, tgID interface{}, linkIDs interface{}, until interface{}) *LinkRepo_PauseLinks_Call {
+	return &LinkRepo_PauseLinks_Call{Call: _e.mock.On("PauseLinks", ctx, tgID, linkIDs, until)}
+}
+
+func (_c *LinkRepo_PauseLinks_Call) Run(run func(ctx context.Context, tgID int64, linkIDs []int64, until time.Time)) *LinkRepo_PauseLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_PauseLinks_Call) Return(_a0 error) *LinkRepo_PauseLinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepo_PauseLinks_Call) RunAndReturn(run func(context.Context, int64, []int64, time.Time) error) *LinkRepo_PauseLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordLinkCheck provides a mock function with given fields: ctx, linkID, checkedAt, checkErr
+func (_m *LinkRepo) RecordLinkCheck(ctx context.Context, linkID int64, checkedAt time.Time, checkErr string) (int64, error) {
+	ret := _m.Called(ctx, linkID, checkedAt, checkErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordLinkCheck")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) (int64, error)); ok {
+		return rf(ctx, linkID, checkedAt, checkErr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) int64); ok {
+		r0 = rf(ctx, linkID, checkedAt, checkErr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, string) error); ok {
+		r1 = rf(ctx, linkID, checkedAt, checkErr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_RecordLinkCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordLinkCheck'
+type LinkRepo_RecordLinkCheck_Call struct {
+	*mock.Call
+}
+
+// RecordLinkCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID int64
+//   - checkedAt time.Time
+//   - checkErr string
+func (_e *LinkRepo_Expecter) RecordLinkCheck(ctx interface{}, linkID interface{}, checkedAt interface{}, checkErr interface{}) *LinkRepo_RecordLinkCheck_Call {
+	return &LinkRepo_RecordLinkCheck_Call{Call: _e.mock.On("RecordLinkCheck", ctx, linkID, checkedAt, checkErr)}
+}
+
+func (_c *LinkRepo_RecordLinkCheck_Call) Run(run func(ctx context.Context, linkID int64, checkedAt time.Time, checkErr string)) *LinkRepo_RecordLinkCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_RecordLinkCheck_Call) Return(_a0 int64, _a1 error) *LinkRepo_RecordLinkCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_RecordLinkCheck_Call) RunAndReturn(run func(context.Context, int64, time.Time, string) (int64, error)) *LinkRepo_RecordLinkCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, tgID, oldTag, newTag
+func (_m *LinkRepo) RenameTag(ctx context.Context, tgID int64, oldTag string, newTag string) (int64, error) {
+	ret := _m.Called(ctx, tgID, oldTag, newTag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (int64, error)); ok {
+		return rf(ctx, tgID, oldTag, newTag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) int64); ok {
+		r0 = rf(ctx, tgID, oldTag, newTag)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, tgID, oldTag, newTag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type LinkRepo_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - oldTag string
+//   - newTag string
+func (_e *LinkRepo_Expecter) RenameTag(ctx interface{}, tgID interface{}, oldTag interface{}, newTag interface{}) *LinkRepo_RenameTag_Call {
+	return &LinkRepo_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, tgID, oldTag, newTag)}
+}
+
+func (_c *LinkRepo_RenameTag_Call) Run(run func(ctx context.Context, tgID int64, oldTag string, newTag string)) *LinkRepo_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_RenameTag_Call) Return(_a0 int64, _a1 error) *LinkRepo_RenameTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_RenameTag_Call) RunAndReturn(run func(context.Context, int64, string, string) (int64, error)) *LinkRepo_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeLinks provides a mock function with given fields: ctx, tgID, linkIDs
+func (_m *LinkRepo) ResumeLinks(ctx context.Context, tgID int64, linkIDs []int64) error {
+	ret := _m.Called(ctx, tgID, linkIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeLinks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) error); ok {
+		r0 = rf(ctx, tgID, linkIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepo_ResumeLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeLinks'
+type LinkRepo_ResumeLinks_Call struct {
+	*mock.Call
+}
+
+// ResumeLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - linkIDs []int64
+func (_e *LinkRepo_Expecter) ResumeLinks(ctx interface{}, tgID interface{}, linkIDs interface{}) *LinkRepo_ResumeLinks_Call {
+	return &LinkRepo_ResumeLinks_Call{Call: _e.mock.On("ResumeLinks", ctx, tgID, linkIDs)}
+}
+
+func (_c *LinkRepo_ResumeLinks_Call) Run(run func(ctx context.Context, tgID int64, linkIDs []int64)) *LinkRepo_ResumeLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_ResumeLinks_Call) Return(_a0 error) *LinkRepo_ResumeLinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepo_ResumeLinks_Call) RunAndReturn(run func(context.Context, int64, []int64) error) *LinkRepo_ResumeLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUserLinks provides a mock function with given fields: ctx, tgID, terms, limit
+func (_m *LinkRepo) SearchUserLinks(ctx context.Context, tgID int64, terms []string, limit int64) ([]domain.SearchResult, error) {
+	ret := _m.Called(ctx, tgID, terms, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUserLinks")
+	}
+
+	var r0 []domain.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, int64) ([]domain.SearchResult, error)); ok {
+		return rf(ctx, tgID, terms, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, int64) []domain.SearchResult); ok {
+		r0 = rf(ctx, tgID, terms, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string, int64) error); ok {
+		r1 = rf(ctx, tgID, terms, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepo_SearchUserLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUserLinks'
+type LinkRepo_SearchUserLinks_Call struct {
+	*mock.Call
+}
+
+// SearchUserLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - terms []string
+//   - limit int64
+func (_e *LinkRepo_Expecter) SearchUserLinks(ctx interface{}, tgID interface{}, terms interface{}, limit interface{}) *LinkRepo_SearchUserLinks_Call {
+	return &LinkRepo_SearchUserLinks_Call{Call: _e.mock.On("SearchUserLinks", ctx, tgID, terms, limit)}
+}
+
+func (_c *LinkRepo_SearchUserLinks_Call) Run(run func(ctx context.Context, tgID int64, terms []string, limit int64)) *LinkRepo_SearchUserLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_SearchUserLinks_Call) Return(_a0 []domain.SearchResult, _a1 error) *LinkRepo_SearchUserLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepo_SearchUserLinks_Call) RunAndReturn(run func(context.Context, int64, []string, int64) ([]domain.SearchResult, error)) *LinkRepo_SearchUserLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateLink provides a mock function with given fields: ctx, tgID, link
 func (_m *LinkRepo) UpdateLink(ctx context.Context, tgID int64, link *domain.Link) error {
 	ret := _m.Called(ctx, tgID, link)
@@ -424,6 +1049,54 @@ func (_c *LinkRepo_UpdateLink_Call) RunAndReturn(run func(context.Context, int64
 	return _c
 }
 
+// UpdateLinkMetadata provides a mock function with given fields: ctx, linkID, metadata
+func (_m *LinkRepo) UpdateLinkMetadata(ctx context.Context, linkID int64, metadata *domain.LinkMetadata) error {
+	ret := _m.Called(ctx, linkID, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLinkMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.LinkMetadata) error); ok {
+		r0 = rf(ctx, linkID, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepo_UpdateLinkMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLinkMetadata'
+type LinkRepo_UpdateLinkMetadata_Call struct {
+	*mock.Call
+}
+
+// UpdateLinkMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID int64
+//   - metadata *domain.LinkMetadata
+func (_e *LinkRepo_Expecter) UpdateLinkMetadata(ctx interface{}, linkID interface{}, metadata interface{}) *LinkRepo_UpdateLinkMetadata_Call {
+	return &LinkRepo_UpdateLinkMetadata_Call{Call: _e.mock.On("UpdateLinkMetadata", ctx, linkID, metadata)}
+}
+
+func (_c *LinkRepo_UpdateLinkMetadata_Call) Run(run func(ctx context.Context, linkID int64, metadata *domain.LinkMetadata)) *LinkRepo_UpdateLinkMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.LinkMetadata))
+	})
+	return _c
+}
+
+func (_c *LinkRepo_UpdateLinkMetadata_Call) Return(_a0 error) *LinkRepo_UpdateLinkMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepo_UpdateLinkMetadata_Call) RunAndReturn(run func(context.Context, int64, *domain.LinkMetadata) error) *LinkRepo_UpdateLinkMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTimeLink provides a mock function with given fields: ctx, lastUpdate, linkID
 func (_m *LinkRepo) UpdateTimeLink(ctx context.Context, lastUpdate time.Time, linkID int64) error {
 	ret := _m.Called(ctx, lastUpdate, linkID)
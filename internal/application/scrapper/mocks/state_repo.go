@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // StateRepo is an autogenerated mock type for the StateRepo type
@@ -22,17 +24,17 @@ func (_m *StateRepo) EXPECT() *StateRepo_Expecter {
 	return &StateRepo_Expecter{mock: &_m.Mock}
 }
 
-// CreateState provides a mock function with given fields: ctx, tgID, state
-func (_m *StateRepo) CreateState(ctx context.Context, tgID int64, state int) error {
-	ret := _m.Called(ctx, tgID, state)
+// CreateState provides a mock function with given fields: ctx, tgID, userID, state, updatedAt
+func (_m *StateRepo) CreateState(ctx context.Context, tgID int64, userID int64, state int, updatedAt time.Time) error {
+	ret := _m.Called(ctx, tgID, userID, state, updatedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
-		r0 = rf(ctx, tgID, state)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int, time.Time) error); ok {
+		r0 = rf(ctx, tgID, userID, state, updatedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,14 +50,16 @@ type StateRepo_CreateState_Call struct {
 // CreateState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
+//   - userID int64
 //   - state int
-func (_e *StateRepo_Expecter) CreateState(ctx interface{}, tgID interface{}, state interface{}) *StateRepo_CreateState_Call {
-	return &StateRepo_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, state)}
+//   - updatedAt time.Time
+func (_e *StateRepo_Expecter) CreateState(ctx interface{}, tgID interface{}, userID interface{}, state interface{}, updatedAt interface{}) *StateRepo_CreateState_Call {
+	return &StateRepo_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, userID, state, updatedAt)}
 }
 
-func (_c *StateRepo_CreateState_Call) Run(run func(ctx context.Context, tgID int64, state int)) *StateRepo_CreateState_Call {
+func (_c *StateRepo_CreateState_Call) Run(run func(ctx context.Context, tgID int64, userID int64, state int, updatedAt time.Time)) *StateRepo_CreateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int), args[4].(time.Time))
 	})
 	return _c
 }
@@ -65,7 +69,64 @@ func (_c *StateRepo_CreateState_Call) Return(_a0 error) *StateRepo_CreateState_C
 	return _c
 }
 
-func (_c *StateRepo_CreateState_Call) RunAndReturn(run func(context.Context, int64, int) error) *StateRepo_CreateState_Call {
+func (_c *StateRepo_CreateState_Call) RunAndReturn(run func(context.Context, int64, int64, int, time.Time) error) *StateRepo_CreateState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredStates provides a mock function with given fields: ctx, updatedBefore
+func (_m *StateRepo) DeleteExpiredStates(ctx context.Context, updatedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, updatedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredStates")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, updatedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, updatedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, updatedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateRepo_DeleteExpiredStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredStates'
+type StateRepo_DeleteExpiredStates_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updatedBefore time.Time
+func (_e *StateRepo_Expecter) DeleteExpiredStates(ctx interface{}, updatedBefore interface{}) *StateRepo_DeleteExpiredStates_Call {
+	return &StateRepo_DeleteExpiredStates_Call{Call: _e.mock.On("DeleteExpiredStates", ctx, updatedBefore)}
+}
+
+func (_c *StateRepo_DeleteExpiredStates_Call) Run(run func(ctx context.Context, updatedBefore time.Time)) *StateRepo_DeleteExpiredStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StateRepo_DeleteExpiredStates_Call) Return(_a0 int64, _a1 error) *StateRepo_DeleteExpiredStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateRepo_DeleteExpiredStates_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *StateRepo_DeleteExpiredStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -117,39 +178,46 @@ func (_c *StateRepo_DeleteState_Call) RunAndReturn(run func(context.Context, int
 	return _c
 }
 
-// GetState provides a mock function with given fields: ctx, tgID
-func (_m *StateRepo) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
-	ret := _m.Called(ctx, tgID)
+// GetState provides a mock function with given fields: ctx, tgID, updatedAfter
+func (_m *StateRepo) GetState(ctx context.Context, tgID int64, updatedAfter time.Time) (int, int64, domain.Link, error) {
+	ret := _m.Called(ctx, tgID, updatedAfter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetState")
 	}
 
 	var r0 int
-	var r1 domain.Link
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, domain.Link, error)); ok {
-		return rf(ctx, tgID)
+	var r1 int64
+	var r2 domain.Link
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (int, int64, domain.Link, error)); ok {
+		return rf(ctx, tgID, updatedAfter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
-		r0 = rf(ctx, tgID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) int); ok {
+		r0 = rf(ctx, tgID, updatedAfter)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) domain.Link); ok {
-		r1 = rf(ctx, tgID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) int64); ok {
+		r1 = rf(ctx, tgID, updatedAfter)
 	} else {
-		r1 = ret.Get(1).(domain.Link)
+		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
-		r2 = rf(ctx, tgID)
+	if rf, ok := ret.Get(2).(func(context.Context, int64, time.Time) domain.Link); ok {
+		r2 = rf(ctx, tgID, updatedAfter)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(domain.Link)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(3).(func(context.Context, int64, time.Time) error); ok {
+		r3 = rf(ctx, tgID, updatedAfter)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
 // StateRepo_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
@@ -160,38 +228,39 @@ type StateRepo_GetState_Call struct {
 // GetState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
-func (_e *StateRepo_Expecter) GetState(ctx interface{}, tgID interface{}) *StateRepo_GetState_Call {
-	return &StateRepo_GetState_Call{Call: _e.mock.On("GetState", ctx, tgID)}
+//   - updatedAfter time.Time
+func (_e *StateRepo_Expecter) GetState(ctx interface{}, tgID interface{}, updatedAfter interface{}) *StateRepo_GetState_Call {
+	return &StateRepo_GetState_Call{Call: _e.mock.On("GetState", ctx, tgID, updatedAfter)}
 }
 
-func (_c *StateRepo_GetState_Call) Run(run func(ctx context.Context, tgID int64)) *StateRepo_GetState_Call {
+func (_c *StateRepo_GetState_Call) Run(run func(ctx context.Context, tgID int64, updatedAfter time.Time)) *StateRepo_GetState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *StateRepo_GetState_Call) Return(state int, link domain.Link, err error) *StateRepo_GetState_Call {
-	_c.Call.Return(state, link, err)
+func (_c *StateRepo_GetState_Call) Return(state int, userID int64, link domain.Link, err error) *StateRepo_GetState_Call {
+	_c.Call.Return(state, userID, link, err)
 	return _c
 }
 
-func (_c *StateRepo_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, domain.Link, error)) *StateRepo_GetState_Call {
+func (_c *StateRepo_GetState_Call) RunAndReturn(run func(context.Context, int64, time.Time) (int, int64, domain.Link, error)) *StateRepo_GetState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateState provides a mock function with given fields: ctx, tgID, state, link
-func (_m *StateRepo) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error {
-	ret := _m.Called(ctx, tgID, state, link)
+// UpdateState provides a mock function with given fields: ctx, tgID, state, link, updatedAt
+func (_m *StateRepo) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link, updatedAt time.Time) error {
+	ret := _m.Called(ctx, tgID, state, link, updatedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, *domain.Link) error); ok {
-		r0 = rf(ctx, tgID, state, link)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, *domain.Link, time.Time) error); ok {
+		r0 = rf(ctx, tgID, state, link, updatedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -209,13 +278,14 @@ type StateRepo_UpdateState_Call struct {
 //   - tgID int64
 //   - state int
 //   - link *domain.Link
-func (_e *StateRepo_Expecter) UpdateState(ctx interface{}, tgID interface{}, state interface{}, link interface{}) *StateRepo_UpdateState_Call {
-	return &StateRepo_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, tgID, state, link)}
+//   - updatedAt time.Time
+func (_e *StateRepo_Expecter) UpdateState(ctx interface{}, tgID interface{}, state interface{}, link interface{}, updatedAt interface{}) *StateRepo_UpdateState_Call {
+	return &StateRepo_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, tgID, state, link, updatedAt)}
 }
 
-func (_c *StateRepo_UpdateState_Call) Run(run func(ctx context.Context, tgID int64, state int, link *domain.Link)) *StateRepo_UpdateState_Call {
+func (_c *StateRepo_UpdateState_Call) Run(run func(ctx context.Context, tgID int64, state int, link *domain.Link, updatedAt time.Time)) *StateRepo_UpdateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(*domain.Link))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(*domain.Link), args[4].(time.Time))
 	})
 	return _c
 }
@@ -225,7 +295,7 @@ func (_c *StateRepo_UpdateState_Call) Return(_a0 error) *StateRepo_UpdateState_C
 	return _c
 }
 
-func (_c *StateRepo_UpdateState_Call) RunAndReturn(run func(context.Context, int64, int, *domain.Link) error) *StateRepo_UpdateState_Call {
+func (_c *StateRepo_UpdateState_Call) RunAndReturn(run func(context.Context, int64, int, *domain.Link, time.Time) error) *StateRepo_UpdateState_Call {
 	_c.Call.Return(run)
 	return _c
 }
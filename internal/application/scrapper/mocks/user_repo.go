@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	domain "LinkTracker/internal/domain"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -173,6 +174,169 @@ func (_c *UserRepo_GetAllUsers_Call) RunAndReturn(run func(context.Context) ([]i
 	return _c
 }
 
+// GetUserByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *UserRepo) GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByTokenHash")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepo_GetUserByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByTokenHash'
+type UserRepo_GetUserByTokenHash_Call struct {
+	*mock.Call
+}
+
+// GetUserByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *UserRepo_Expecter) GetUserByTokenHash(ctx interface{}, tokenHash interface{}) *UserRepo_GetUserByTokenHash_Call {
+	return &UserRepo_GetUserByTokenHash_Call{Call: _e.mock.On("GetUserByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *UserRepo_GetUserByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *UserRepo_GetUserByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepo_GetUserByTokenHash_Call) Return(_a0 int64, _a1 error) *UserRepo_GetUserByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepo_GetUserByTokenHash_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *UserRepo_GetUserByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersLinkCounts provides a mock function with given fields: ctx
+func (_m *UserRepo) GetUsersLinkCounts(ctx context.Context) ([]domain.UserLinkCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersLinkCounts")
+	}
+
+	var r0 []domain.UserLinkCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.UserLinkCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.UserLinkCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserLinkCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepo_GetUsersLinkCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersLinkCounts'
+type UserRepo_GetUsersLinkCounts_Call struct {
+	*mock.Call
+}
+
+// GetUsersLinkCounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserRepo_Expecter) GetUsersLinkCounts(ctx interface{}) *UserRepo_GetUsersLinkCounts_Call {
+	return &UserRepo_GetUsersLinkCounts_Call{Call: _e.mock.On("GetUsersLinkCounts", ctx)}
+}
+
+func (_c *UserRepo_GetUsersLinkCounts_Call) Run(run func(ctx context.Context)) *UserRepo_GetUsersLinkCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserRepo_GetUsersLinkCounts_Call) Return(_a0 []domain.UserLinkCount, _a1 error) *UserRepo_GetUsersLinkCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepo_GetUsersLinkCounts_Call) RunAndReturn(run func(context.Context) ([]domain.UserLinkCount, error)) *UserRepo_GetUsersLinkCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTokenHash provides a mock function with given fields: ctx, tgID, tokenHash
+func (_m *UserRepo) SaveTokenHash(ctx context.Context, tgID int64, tokenHash string) error {
+	ret := _m.Called(ctx, tgID, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTokenHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, tgID, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepo_SaveTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTokenHash'
+type UserRepo_SaveTokenHash_Call struct {
+	*mock.Call
+}
+
+// SaveTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - tokenHash string
+func (_e *UserRepo_Expecter) SaveTokenHash(ctx interface{}, tgID interface{}, tokenHash interface{}) *UserRepo_SaveTokenHash_Call {
+	return &UserRepo_SaveTokenHash_Call{Call: _e.mock.On("SaveTokenHash", ctx, tgID, tokenHash)}
+}
+
+func (_c *UserRepo_SaveTokenHash_Call) Run(run func(ctx context.Context, tgID int64, tokenHash string)) *UserRepo_SaveTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepo_SaveTokenHash_Call) Return(_a0 error) *UserRepo_SaveTokenHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepo_SaveTokenHash_Call) RunAndReturn(run func(context.Context, int64, string) error) *UserRepo_SaveTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepo creates a new instance of UserRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepo(t interface {
@@ -4,10 +4,12 @@ import (
 	"context"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/metrics"
 )
 
 type BotClient interface {
-	PostUpdates(ctx context.Context, link *domain.Link, tgID []int64, description string) error
+	PostUpdates(ctx context.Context, update *domain.LinkUpdate) error
+	PostDigest(ctx context.Context, digest *domain.Digest) error
 }
 
 type HTTPNotifier struct {
@@ -19,11 +21,20 @@ func NewHTTPNotifier(botClient BotClient) *HTTPNotifier {
 		botClient: botClient}
 }
 
-func (n *HTTPNotifier) PostUpdates(ctx context.Context, link *domain.Link, tgID []int64, description string) error {
-	err := n.botClient.PostUpdates(ctx, link, tgID, description)
+func (n *HTTPNotifier) PostUpdates(ctx context.Context, update *domain.LinkUpdate) error {
+	err := n.botClient.PostUpdates(ctx, update)
+	metrics.ObserveNotification(metrics.NotificationUpdate, err)
+
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (n *HTTPNotifier) PostDigest(ctx context.Context, digest *domain.Digest) error {
+	err := n.botClient.PostDigest(ctx, digest)
+	metrics.ObserveNotification(metrics.NotificationDigest, err)
+
+	return err
+}
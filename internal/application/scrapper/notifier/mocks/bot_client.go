@@ -22,17 +22,64 @@ func (_m *BotClient) EXPECT() *BotClient_Expecter {
 	return &BotClient_Expecter{mock: &_m.Mock}
 }
 
-// PostUpdates provides a mock function with given fields: ctx, link, tgID, description
-func (_m *BotClient) PostUpdates(ctx context.Context, link *domain.Link, tgID []int64, description string) error {
-	ret := _m.Called(ctx, link, tgID, description)
+// PostDigest provides a mock function with given fields: ctx, digest
+func (_m *BotClient) PostDigest(ctx context.Context, digest *domain.Digest) error {
+	ret := _m.Called(ctx, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostDigest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Digest) error); ok {
+		r0 = rf(ctx, digest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BotClient_PostDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDigest'
+type BotClient_PostDigest_Call struct {
+	*mock.Call
+}
+
+// PostDigest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest *domain.Digest
+func (_e *BotClient_Expecter) PostDigest(ctx interface{}, digest interface{}) *BotClient_PostDigest_Call {
+	return &BotClient_PostDigest_Call{Call: _e.mock.On("PostDigest", ctx, digest)}
+}
+
+func (_c *BotClient_PostDigest_Call) Run(run func(ctx context.Context, digest *domain.Digest)) *BotClient_PostDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Digest))
+	})
+	return _c
+}
+
+func (_c *BotClient_PostDigest_Call) Return(_a0 error) *BotClient_PostDigest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BotClient_PostDigest_Call) RunAndReturn(run func(context.Context, *domain.Digest) error) *BotClient_PostDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostUpdates provides a mock function with given fields: ctx, update
+func (_m *BotClient) PostUpdates(ctx context.Context, update *domain.LinkUpdate) error {
+	ret := _m.Called(ctx, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostUpdates")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link, []int64, string) error); ok {
-		r0 = rf(ctx, link, tgID, description)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.LinkUpdate) error); ok {
+		r0 = rf(ctx, update)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,16 +94,14 @@ type BotClient_PostUpdates_Call struct {
 
 // PostUpdates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - link *domain.Link
-//   - tgID []int64
-//   - description string
-func (_e *BotClient_Expecter) PostUpdates(ctx interface{}, link interface{}, tgID interface{}, description interface{}) *BotClient_PostUpdates_Call {
-	return &BotClient_PostUpdates_Call{Call: _e.mock.On("PostUpdates", ctx, link, tgID, description)}
+//   - update *domain.LinkUpdate
+func (_e *BotClient_Expecter) PostUpdates(ctx interface{}, update interface{}) *BotClient_PostUpdates_Call {
+	return &BotClient_PostUpdates_Call{Call: _e.mock.On("PostUpdates", ctx, update)}
 }
 
-func (_c *BotClient_PostUpdates_Call) Run(run func(ctx context.Context, link *domain.Link, tgID []int64, description string)) *BotClient_PostUpdates_Call {
+func (_c *BotClient_PostUpdates_Call) Run(run func(ctx context.Context, update *domain.LinkUpdate)) *BotClient_PostUpdates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Link), args[2].([]int64), args[3].(string))
+		run(args[0].(context.Context), args[1].(*domain.LinkUpdate))
 	})
 	return _c
 }
@@ -66,7 +111,7 @@ func (_c *BotClient_PostUpdates_Call) Return(_a0 error) *BotClient_PostUpdates_C
 	return _c
 }
 
-func (_c *BotClient_PostUpdates_Call) RunAndReturn(run func(context.Context, *domain.Link, []int64, string) error) *BotClient_PostUpdates_Call {
+func (_c *BotClient_PostUpdates_Call) RunAndReturn(run func(context.Context, *domain.LinkUpdate) error) *BotClient_PostUpdates_Call {
 	_c.Call.Return(run)
 	return _c
 }
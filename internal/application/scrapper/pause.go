@@ -0,0 +1,81 @@
+package scrapper
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"LinkTracker/internal/domain"
+)
+
+// PauseLinks приостанавливает уведомления по выбранным ссылкам пользователя до until, теги и фильтры
+// ссылок сохраняются. Нулевое until ставит паузу до ResumeLinks. Возвращает ссылки, попавшие под паузу.
+func (s *Scrapper) PauseLinks(ctx context.Context, tgID int64, selector domain.LinkSelector,
+	until time.Time) ([]domain.Link, error) {
+	links, linkIDs, err := s.selectLinks(ctx, tgID, selector)
+	if err != nil {
+		slog.Error("Pause links failed", "error", err.Error(), "tgID", tgID)
+		return nil, err
+	}
+
+	err = s.linkRepo.PauseLinks(ctx, tgID, linkIDs, until)
+	if err != nil {
+		slog.Error("Pause links failed", "error", err.Error(), "tgID", tgID)
+		return nil, err
+	}
+
+	for i := range links {
+		links[i].Paused, links[i].PausedUntil = true, until
+	}
+
+	slog.Info("Pause links done", "tgID", tgID, "links", len(links), "until", until)
+
+	return links, nil
+}
+
+// ResumeLinks возобновляет уведомления по выбранным ссылкам пользователя.
+func (s *Scrapper) ResumeLinks(ctx context.Context, tgID int64, selector domain.LinkSelector) ([]domain.Link, error) {
+	links, linkIDs, err := s.selectLinks(ctx, tgID, selector)
+	if err != nil {
+		slog.Error("Resume links failed", "error", err.Error(), "tgID", tgID)
+		return nil, err
+	}
+
+	err = s.linkRepo.ResumeLinks(ctx, tgID, linkIDs)
+	if err != nil {
+		slog.Error("Resume links failed", "error", err.Error(), "tgID", tgID)
+		return nil, err
+	}
+
+	for i := range links {
+		links[i].Paused, links[i].PausedUntil = false, time.Time{}
+	}
+
+	slog.Info("Resume links done", "tgID", tgID, "links", len(links))
+
+	return links, nil
+}
+
+// selectLinks возвращает ссылки пользователя, подходящие под селектор, и их идентификаторы.
+func (s *Scrapper) selectLinks(ctx context.Context, tgID int64, selector domain.LinkSelector) ([]domain.Link, []int64, error) {
+	userLinks, err := s.linkRepo.GetUserLinks(ctx, tgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	links := make([]domain.Link, 0, len(userLinks))
+	linkIDs := make([]int64, 0, len(userLinks))
+
+	for i := range userLinks {
+		if selector.Matches(&userLinks[i]) {
+			links = append(links, userLinks[i])
+			linkIDs = append(linkIDs, userLinks[i].ID)
+		}
+	}
+
+	if len(links) == 0 {
+		return nil, nil, domain.ErrLinkNotExist{}
+	}
+
+	return links, linkIDs, nil
+}
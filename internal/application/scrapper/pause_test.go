@@ -0,0 +1,95 @@
+package scrapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func newPauseScrapper(linkRepo *mocks.LinkRepo) *scrapper.Scrapper {
+	return scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+}
+
+func pauseTestLinks() []domain.Link {
+	return []domain.Link{
+		{ID: 1, URL: "https://github.com/owner/first", Tags: []string{"work"}},
+		{ID: 2, URL: "https://github.com/owner/second", Tags: []string{"home"}},
+		{ID: 3, URL: "https://stackoverflow.com/questions/1", Tags: []string{"work"}},
+	}
+}
+
+func Test_Scrapper_PauseLinks_ByTag(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	until := time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(pauseTestLinks(), nil).Once()
+	linkRepo.On("PauseLinks", ctx, tgID, []int64{1, 3}, until).Return(nil).Once()
+
+	links, err := s.PauseLinks(ctx, tgID, domain.LinkSelector{Tag: "work"}, until)
+
+	assert.NoError(t, err)
+	assert.Len(t, links, 2)
+
+	for _, link := range links {
+		assert.True(t, link.Paused)
+		assert.Equal(t, until, link.PausedUntil)
+	}
+
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_PauseLinks_NotFound(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(pauseTestLinks(), nil).Once()
+
+	_, err := s.PauseLinks(ctx, tgID, domain.LinkSelector{ID: 42}, time.Time{})
+
+	assert.ErrorAs(t, err, &domain.ErrLinkNotExist{})
+	linkRepo.AssertNotCalled(t, "PauseLinks")
+}
+
+func Test_Scrapper_ResumeLinks_All(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(pauseTestLinks(), nil).Once()
+	linkRepo.On("ResumeLinks", ctx, tgID, []int64{1, 2, 3}).Return(nil).Once()
+
+	links, err := s.ResumeLinks(ctx, tgID, domain.LinkSelector{})
+
+	assert.NoError(t, err)
+	assert.Len(t, links, 3)
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_ResumeLinks_RepoError(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(pauseTestLinks(), nil).Once()
+	linkRepo.On("ResumeLinks", ctx, tgID, []int64{2}).Return(errors.New("some error")).Once()
+
+	_, err := s.ResumeLinks(ctx, tgID, domain.LinkSelector{URL: "https://github.com/owner/second"})
+
+	assert.Error(t, err)
+	linkRepo.AssertExpectations(t)
+}
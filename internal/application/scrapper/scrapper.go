@@ -5,66 +5,130 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/metrics"
+	"LinkTracker/internal/tracing"
 
 	"github.com/go-co-op/gocron/v2"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// statsInterval — как часто обновляются метрики числа пользователей и отслеживаемых ссылок.
+const statsInterval = time.Minute
+
 type LinkRepo interface {
 	GetUserLinks(ctx context.Context, tgID int64) ([]domain.Link, error)
+	GetUserLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error)
+	GetUserTags(ctx context.Context, tgID int64) ([]domain.TagCount, error)
+	SearchUserLinks(ctx context.Context, tgID int64, terms []string, limit int64) ([]domain.SearchResult, error)
+	RenameTag(ctx context.Context, tgID int64, oldTag, newTag string) (int64, error)
 	AddLink(ctx context.Context, tgID int64, link *domain.Link) (domain.Link, error)
 	DeleteLink(ctx context.Context, tgID int64, link *domain.Link) (domain.Link, error)
 	UpdateLink(ctx context.Context, tgID int64, link *domain.Link) error
 	GetAllLinks(ctx context.Context) ([]domain.Link, error)
+	GetLinkByURL(ctx context.Context, url string) (domain.Link, error)
 	GetUsersByLink(ctx context.Context, linkID int64) ([]int64, error)
 	UpdateTimeLink(ctx context.Context, lastUpdate time.Time, linkID int64) error
+	UpdateLinkMetadata(ctx context.Context, linkID int64, metadata *domain.LinkMetadata) error
+	MoveLink(ctx context.Context, linkID int64, newURL string) (int64, error)
+	RecordLinkCheck(ctx context.Context, linkID int64, checkedAt time.Time, checkErr string) (int64, error)
+	GetUnhealthyLinks(ctx context.Context, minFailures int64) ([]domain.Link, error)
+	GetTrackingStats(ctx context.Context) (domain.TrackingStats, error)
 	GetLinksAfter(ctx context.Context, lastUpdate time.Time, limit int64) ([]domain.Link, error)
+	PauseLinks(ctx context.Context, tgID int64, linkIDs []int64, until time.Time) error
+	ResumeLinks(ctx context.Context, tgID int64, linkIDs []int64) error
 }
 
 type UserRepo interface {
 	CreateUser(ctx context.Context, id int64) error
 	DeleteUser(ctx context.Context, id int64) error
 	GetAllUsers(ctx context.Context) ([]int64, error)
+	GetUsersLinkCounts(ctx context.Context) ([]domain.UserLinkCount, error)
+	SaveTokenHash(ctx context.Context, tgID int64, tokenHash string) error
+	GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error)
 }
 
 type StateRepo interface {
-	CreateState(ctx context.Context, tgID int64, state int) error
+	CreateState(ctx context.Context, tgID, userID int64, state int, updatedAt time.Time) error
 	DeleteState(ctx context.Context, tgID int64) error
-	GetState(ctx context.Context, tgID int64) (state int, link domain.Link, err error)
-	UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error
+	GetState(ctx context.Context, tgID int64, updatedAfter time.Time) (state int, userID int64, link domain.Link, err error)
+	UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link, updatedAt time.Time) error
+	DeleteExpiredStates(ctx context.Context, updatedBefore time.Time) (int64, error)
+}
+
+// DeliveryRepo хранит настройки доставки уведомлений и обновления, отложенные до сводки.
+type DeliveryRepo interface {
+	GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error)
+	SaveSettings(ctx context.Context, tgID int64, settings *domain.DeliverySettings) error
+	SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error
+	DeleteTagMode(ctx context.Context, tgID int64, tag string) error
+	GetDeliveryTargets(ctx context.Context, linkID int64) (map[int64]domain.DeliveryTarget, error)
+	AddPendingUpdate(ctx context.Context, tgID int64, mode domain.DeliveryMode, update *domain.LinkUpdate) error
+	GetPendingUsers(ctx context.Context) ([]int64, error)
+	GetPendingUpdates(ctx context.Context, tgID int64, mode domain.DeliveryMode,
+		createdBefore time.Time) ([]domain.PendingUpdate, error)
+	DeletePendingUpdates(ctx context.Context, ids []int64) error
+}
+
+// UpdateRepo хранит историю обнаруженных обновлений ссылок.
+type UpdateRepo interface {
+	AddUpdate(ctx context.Context, update *domain.LinkUpdate) error
+	GetLinkUpdates(ctx context.Context, linkID, limit, offset int64) ([]domain.UpdateRecord, int64, error)
+	DeleteUpdatesBefore(ctx context.Context, detectedBefore time.Time) (int64, error)
 }
 
 type Notifier interface {
-	PostUpdates(ctx context.Context, link *domain.Link, tgID []int64, description string) error
+	PostUpdates(ctx context.Context, update *domain.LinkUpdate) error
+	PostDigest(ctx context.Context, digest *domain.Digest) error
 }
 
 type LinkChecker interface {
-	CheckLinks(ctx context.Context, linkUpdates chan<- domain.LinkUpdate)
+	CheckLinks(ctx context.Context, linkUpdates chan<- domain.LinkUpdate) domain.ScrapeStats
+	CheckLink(ctx context.Context, link *domain.Link, linkUpdates chan<- domain.LinkUpdate) error
+	ValidateLink(ctx context.Context, link *domain.Link) error
+	ValidateLinks(ctx context.Context, links []domain.Link) []error
 }
 
 type Scrapper struct {
 	userRepo     UserRepo
 	linkRepo     LinkRepo
 	stateManager StateRepo
+	deliveryRepo DeliveryRepo
+	updateRepo   UpdateRepo
 	notifier     Notifier
 	linkCheck    LinkChecker
 	interval     time.Duration
+	stateTTL     time.Duration
 	linkUpdates  chan domain.LinkUpdate
+
+	schedulerMu sync.RWMutex
+	scheduler   gocron.Scheduler
+
+	paused       atomic.Bool
+	forceScrape  atomic.Bool
+	scrapeMu     sync.Mutex
+	scrapeStatus domain.SchedulerStatus
 }
 
-func NewScrapper(userRepo UserRepo, linkRepo LinkRepo, stateManager StateRepo,
-	interval time.Duration, notifier Notifier, linkChecker LinkChecker) *Scrapper {
+func NewScrapper(userRepo UserRepo, linkRepo LinkRepo, stateManager StateRepo, deliveryRepo DeliveryRepo, updateRepo UpdateRepo,
+	interval, stateTTL time.Duration, notifier Notifier, linkChecker LinkChecker) *Scrapper {
 	linkUpdatesBufferSize := 1000
 
-	slog.Info("Creating new Scrapper", "interval", interval, "linkUpdatesBufferSize", linkUpdatesBufferSize)
+	slog.Info("Creating new Scrapper", "interval", interval, "stateTTL", stateTTL,
+		"linkUpdatesBufferSize", linkUpdatesBufferSize)
 
 	return &Scrapper{
 		userRepo:     userRepo,
 		linkRepo:     linkRepo,
 		stateManager: stateManager,
+		deliveryRepo: deliveryRepo,
+		updateRepo:   updateRepo,
 		interval:     interval,
+		stateTTL:     stateTTL,
 		notifier:     notifier,
 		linkCheck:    linkChecker,
 		linkUpdates:  make(chan domain.LinkUpdate, linkUpdatesBufferSize),
@@ -72,28 +136,54 @@ func NewScrapper(userRepo UserRepo, linkRepo LinkRepo, stateManager StateRepo,
 }
 
 func (s *Scrapper) Run(ctx context.Context) error {
-	scheduler, err := initLinksCheckerScheduler(ctx, s.interval, s.linkCheck.CheckLinks, s.linkUpdates)
+	scheduler, err := initLinksCheckerScheduler(ctx, s.interval, s.scheduledScrape, s.linkUpdates)
+	if err != nil {
+		return err
+	}
+
+	err = addIntervalJob(ctx, scheduler, "clean-states", s.stateTTL, s.DeleteExpiredStates)
+	if err != nil {
+		return err
+	}
+
+	err = addIntervalJob(ctx, scheduler, "send-digests", digestCheckInterval, s.SendDigests)
+	if err != nil {
+		return err
+	}
+
+	err = addIntervalJob(ctx, scheduler, "clean-history", historyCleanupInterval, s.DeleteExpiredUpdates)
 	if err != nil {
 		return err
 	}
 
+	err = addIntervalJob(ctx, scheduler, "refresh-stats", statsInterval, s.RefreshTrackingStats,
+		gocron.WithStartAt(gocron.WithStartImmediately()))
+	if err != nil {
+		return err
+	}
+
+	metrics.RegisterLinkUpdatesQueue(func() int { return len(s.linkUpdates) })
+
 	slog.Info("Starts scrapper scheduler")
 	scheduler.Start()
+	s.setScheduler(scheduler)
 
 	go func() {
 		for update := range s.linkUpdates {
-			err := s.notifier.PostUpdates(ctx, &update.Link, update.TgIDs, update.Description)
-			if err != nil {
-				slog.Error(err.Error(), "url", update.Link, "tgIDS", update.TgIDs, "description", update.Description)
-			}
+			s.handleUpdate(ctx, &update)
 		}
 	}()
 
 	<-ctx.Done()
-	close(s.linkUpdates)
+	// После setScheduler(nil) enqueueUpdate не пишет в очередь, а после Shutdown не осталось задач,
+	// которые в неё пишут, поэтому закрыть очередь безопасно
+	s.setScheduler(nil)
 	slog.Info("Shutting down scrapper")
 
 	err = scheduler.Shutdown()
+
+	close(s.linkUpdates)
+
 	if err != nil {
 		slog.Error("Failed to shutdown scrapper", "error", err.Error())
 		return fmt.Errorf("could not shutdown scrapper: %w", err)
@@ -102,6 +192,68 @@ func (s *Scrapper) Run(ctx context.Context) error {
 	return nil
 }
 
+// handleUpdate сохраняет обновление в историю и доставляет его подписчикам.
+func (s *Scrapper) handleUpdate(ctx context.Context, update *domain.LinkUpdate) {
+	ctx, span := tracing.Start(ctx, "scrapper.deliver_update", attribute.String("link.url", update.Link.URL))
+	defer span.End()
+
+	s.RecordUpdate(ctx, update)
+	s.DeliverUpdate(ctx, update)
+}
+
+// enqueueUpdate передаёт обновление в очередь доставки, не блокируясь. Если планировщик не запущен
+// или очередь заполнена, обновление обрабатывается на месте.
+func (s *Scrapper) enqueueUpdate(ctx context.Context, update *domain.LinkUpdate) {
+	if s.tryEnqueueUpdate(update) {
+		return
+	}
+
+	slog.Warn("Update queue unavailable, delivering in place", "url", update.Link.URL)
+	s.handleUpdate(ctx, update)
+}
+
+func (s *Scrapper) tryEnqueueUpdate(update *domain.LinkUpdate) bool {
+	// Run закрывает очередь только после setScheduler(nil), который ждёт освобождения schedulerMu
+	s.schedulerMu.RLock()
+	defer s.schedulerMu.RUnlock()
+
+	if s.scheduler == nil {
+		return false
+	}
+
+	select {
+	case s.linkUpdates <- *update:
+		return true
+	default:
+		return false
+	}
+}
+
+// CheckScheduler проверяет, что планировщик запущен и у каждой его задачи назначен следующий запуск.
+func (s *Scrapper) CheckScheduler(_ context.Context) error {
+	s.schedulerMu.RLock()
+	defer s.schedulerMu.RUnlock()
+
+	if s.scheduler == nil {
+		return domain.ErrSchedulerNotRunning{}
+	}
+
+	for _, job := range s.scheduler.Jobs() {
+		if _, err := job.NextRun(); err != nil {
+			return fmt.Errorf("job %s not scheduled: %w", job.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Scrapper) setScheduler(scheduler gocron.Scheduler) {
+	s.schedulerMu.Lock()
+	defer s.schedulerMu.Unlock()
+
+	s.scheduler = scheduler
+}
+
 func (s *Scrapper) AddUser(ctx context.Context, tgID int64) error {
 	err := s.userRepo.CreateUser(ctx, tgID)
 	if err != nil {
@@ -138,7 +290,28 @@ func (s *Scrapper) GetUserLinks(ctx context.Context, tgID int64) ([]domain.Link,
 	return links, nil
 }
 
+// GetUnhealthyLinks возвращает ссылки, проверка которых завершилась ошибкой не меньше minFailures раз подряд.
+func (s *Scrapper) GetUnhealthyLinks(ctx context.Context, minFailures int64) ([]domain.Link, error) {
+	links, err := s.linkRepo.GetUnhealthyLinks(ctx, minFailures)
+	if err != nil {
+		slog.Error("Get unhealthy links failed", "error", err.Error(), "minFailures", minFailures)
+		return nil, err
+	}
+
+	slog.Info("Get unhealthy links done", "minFailures", minFailures, "links", len(links))
+
+	return links, nil
+}
+
 func (s *Scrapper) AddLink(ctx context.Context, tgID int64, newLink *domain.Link) (domain.Link, error) {
+	canonicalURL, err := domain.CanonicalURL(newLink.URL)
+	if err != nil {
+		slog.Error("Add link failed", "error", err.Error(), "tgID", tgID, "link", newLink.URL)
+		return domain.Link{}, err
+	}
+
+	newLink.URL = canonicalURL
+
 	userLinks, err := s.linkRepo.GetUserLinks(ctx, tgID)
 	if err != nil {
 		slog.Error("Add link failed", "error", err.Error(), "tgID", tgID, "link", newLink.URL)
@@ -151,6 +324,12 @@ func (s *Scrapper) AddLink(ctx context.Context, tgID int64, newLink *domain.Link
 		}
 	}
 
+	err = s.linkCheck.ValidateLink(ctx, newLink)
+	if err != nil {
+		slog.Error("Add link failed", "error", err.Error(), "tgID", tgID, "link", newLink.URL)
+		return domain.Link{}, err
+	}
+
 	newLinkWithID, err := s.linkRepo.AddLink(ctx, tgID, newLink)
 	if err != nil {
 		slog.Error("Add link failed", "error", err.Error(), "tgID", tgID, "link", newLink.URL)
@@ -162,7 +341,124 @@ func (s *Scrapper) AddLink(ctx context.Context, tgID int64, newLink *domain.Link
 	return newLinkWithID, nil
 }
 
+// AddLinks добавляет ссылки пачкой. Ошибка добавления отдельной ссылки не прерывает импорт,
+// а возвращается в результате для этой ссылки. Источники новых ссылок проверяются параллельно
+// до записи в базу.
+func (s *Scrapper) AddLinks(ctx context.Context, tgID int64, links []domain.Link) ([]domain.LinkImportResult, error) {
+	userLinks, err := s.linkRepo.GetUserLinks(ctx, tgID)
+	if err != nil {
+		slog.Error("Add links failed", "error", err.Error(), "tgID", tgID)
+		return nil, err
+	}
+
+	tracked := make(map[string]struct{}, len(userLinks)+len(links))
+	for _, userLink := range userLinks {
+		tracked[userLink.URL] = struct{}{}
+	}
+
+	urlErrs := canonicalizeLinks(links)
+	validationErrs := s.validateNewLinks(ctx, links, urlErrs, tracked)
+
+	results := make([]domain.LinkImportResult, 0, len(links))
+	added := 0
+
+	for i := range links {
+		if urlErrs[i] != nil {
+			results = append(results, domain.LinkImportResult{Link: links[i], Error: urlErrs[i].Error()})
+			continue
+		}
+
+		if _, ok := tracked[links[i].URL]; ok {
+			results = append(results, domain.LinkImportResult{
+				Link:  links[i],
+				Error: domain.ErrLinkAlreadyTracking{}.Error(),
+			})
+
+			continue
+		}
+
+		if err := validationErrs[links[i].URL]; err != nil {
+			results = append(results, domain.LinkImportResult{Link: links[i], Error: err.Error()})
+			continue
+		}
+
+		newLinkWithID, err := s.linkRepo.AddLink(ctx, tgID, &links[i])
+		if err != nil {
+			slog.Error("Add link failed", "error", err.Error(), "tgID", tgID, "link", links[i].URL)
+			results = append(results, domain.LinkImportResult{Link: links[i], Error: err.Error()})
+
+			continue
+		}
+
+		tracked[links[i].URL] = struct{}{}
+		added++
+
+		results = append(results, domain.LinkImportResult{Link: newLinkWithID})
+	}
+
+	slog.Info("Add links done", "tgID", tgID, "total", len(links), "added", added)
+
+	return results, nil
+}
+
+// canonicalizeLinks приводит адреса ссылок к каноническому виду на месте. Ошибка для links[i]
+// возвращается в i-м элементе результата, адрес такой ссылки не меняется.
+func canonicalizeLinks(links []domain.Link) []error {
+	errs := make([]error, len(links))
+
+	for i := range links {
+		canonicalURL, err := domain.CanonicalURL(links[i].URL)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		links[i].URL = canonicalURL
+	}
+
+	return errs
+}
+
+// validateNewLinks одним вызовом проверяет ссылки пачки, которые ещё не отслеживаются, и возвращает
+// ошибки проверки по адресу ссылки. Повторяющиеся адреса проверяются один раз.
+func (s *Scrapper) validateNewLinks(ctx context.Context, links []domain.Link, urlErrs []error,
+	tracked map[string]struct{}) map[string]error {
+	newLinks := make([]domain.Link, 0, len(links))
+	seen := make(map[string]struct{}, len(links))
+
+	for i := range links {
+		if urlErrs[i] != nil {
+			continue
+		}
+
+		if _, ok := tracked[links[i].URL]; ok {
+			continue
+		}
+
+		if _, ok := seen[links[i].URL]; ok {
+			continue
+		}
+
+		seen[links[i].URL] = struct{}{}
+		newLinks = append(newLinks, links[i])
+	}
+
+	validationErrs := make(map[string]error, len(newLinks))
+
+	if len(newLinks) == 0 {
+		return validationErrs
+	}
+
+	for i, err := range s.linkCheck.ValidateLinks(ctx, newLinks) {
+		validationErrs[newLinks[i].URL] = err
+	}
+
+	return validationErrs
+}
+
 func (s *Scrapper) DeleteLink(ctx context.Context, tgID int64, link *domain.Link) (domain.Link, error) {
+	canonicalizeLinkURL(link)
+
 	deletedLink, err := s.linkRepo.DeleteLink(ctx, tgID, link)
 	if err != nil {
 		slog.Error("Delete link failed", "error", err.Error(), "tgID", tgID, "link", link.URL)
@@ -180,6 +476,8 @@ func (s *Scrapper) DeleteLink(ctx context.Context, tgID int64, link *domain.Link
 }
 
 func (s *Scrapper) UpdateLink(ctx context.Context, tgID int64, link *domain.Link) error {
+	canonicalizeLinkURL(link)
+
 	err := s.linkRepo.UpdateLink(ctx, tgID, link)
 	if err != nil {
 		slog.Error("Update link failed", "error", err.Error(), "tgID", tgID, "link", link.URL)
@@ -190,13 +488,22 @@ func (s *Scrapper) UpdateLink(ctx context.Context, tgID int64, link *domain.Link
 	return err
 }
 
-func (s *Scrapper) CreateState(ctx context.Context, tgID int64, state int) error {
-	err := s.stateManager.CreateState(ctx, tgID, state)
+// canonicalizeLinkURL приводит адрес ссылки к каноническому виду, под которым она хранится.
+// Адрес, который не удалось разобрать, остаётся как есть: такой ссылки просто не найдётся.
+func canonicalizeLinkURL(link *domain.Link) {
+	if canonicalURL, err := domain.CanonicalURL(link.URL); err == nil {
+		link.URL = canonicalURL
+	}
+}
+
+// CreateState начинает диалог участника userID в чате tgID.
+func (s *Scrapper) CreateState(ctx context.Context, tgID, userID int64, state int) error {
+	err := s.stateManager.CreateState(ctx, tgID, userID, state, time.Now().UTC())
 	if err != nil {
-		slog.Error("Create state failed", "error", err.Error(), "tgID", tgID, "state", state)
+		slog.Error("Create state failed", "error", err.Error(), "tgID", tgID, "userID", userID, "state", state)
 	}
 
-	slog.Info("Create state done", "tgID", tgID, "state", state)
+	slog.Info("Create state done", "tgID", tgID, "userID", userID, "state", state)
 
 	return err
 }
@@ -212,20 +519,21 @@ func (s *Scrapper) DeleteState(ctx context.Context, tgID int64) error {
 	return err
 }
 
-func (s *Scrapper) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
-	state, link, err := s.stateManager.GetState(ctx, tgID)
+// GetState возвращает шаг незавершённого диалога в чате tgID и участника, который его начал.
+func (s *Scrapper) GetState(ctx context.Context, tgID int64) (int, int64, domain.Link, error) {
+	state, userID, link, err := s.stateManager.GetState(ctx, tgID, time.Now().UTC().Add(-s.stateTTL))
 	if err != nil {
 		slog.Error("Get state failed", "error", err.Error(), "tgID", tgID)
-		return -1, domain.Link{}, err
+		return -1, 0, domain.Link{}, err
 	}
 
-	slog.Info("Get state done", "tgID", tgID, "state", state, "link", link.URL)
+	slog.Info("Get state done", "tgID", tgID, "userID", userID, "state", state, "link", link.URL)
 
-	return state, link, nil
+	return state, userID, link, nil
 }
 
 func (s *Scrapper) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error {
-	err := s.stateManager.UpdateState(ctx, tgID, state, link)
+	err := s.stateManager.UpdateState(ctx, tgID, state, link, time.Now().UTC())
 	if err != nil {
 		slog.Error("Update state failed", "error", err.Error(), "tgID", tgID, "state", state, "link", link.URL)
 	}
@@ -235,6 +543,27 @@ func (s *Scrapper) UpdateState(ctx context.Context, tgID int64, state int, link
 	return err
 }
 
+func (s *Scrapper) DeleteExpiredStates(ctx context.Context) {
+	deleted, err := s.stateManager.DeleteExpiredStates(ctx, time.Now().UTC().Add(-s.stateTTL))
+	if err != nil {
+		slog.Error("Delete expired states failed", "error", err.Error())
+		return
+	}
+
+	slog.Info("Delete expired states done", "deleted", deleted)
+}
+
+// RefreshTrackingStats обновляет метрики числа активных пользователей и отслеживаемых ссылок.
+func (s *Scrapper) RefreshTrackingStats(ctx context.Context) {
+	stats, err := s.linkRepo.GetTrackingStats(ctx)
+	if err != nil {
+		slog.Error("Get tracking stats failed", "error", err.Error())
+		return
+	}
+
+	metrics.SetTrackingStats(stats.Users, stats.Links)
+}
+
 func initLinksCheckerScheduler(ctx context.Context, interval time.Duration,
 	scrapeFunc func(ctx context.Context, updates chan<- domain.LinkUpdate), updates chan<- domain.LinkUpdate) (gocron.Scheduler, error) {
 	scheduler, err := gocron.NewScheduler()
@@ -250,6 +579,7 @@ func initLinksCheckerScheduler(ctx context.Context, interval time.Duration,
 			defer cancelTimeout()
 			scrapeFunc(ctxWithTimeout, updates)
 		}),
+		gocron.WithName(scrapeJobName),
 	)
 
 	if err != nil {
@@ -259,3 +589,27 @@ func initLinksCheckerScheduler(ctx context.Context, interval time.Duration,
 
 	return scheduler, nil
 }
+
+// addIntervalJob добавляет в планировщик задачу name, которая запускает jobFunc каждые interval.
+// Каждый запуск ограничен по времени тем же интервалом.
+func addIntervalJob(ctx context.Context, scheduler gocron.Scheduler, name string, interval time.Duration,
+	jobFunc func(ctx context.Context), options ...gocron.JobOption) error {
+	options = append(options, gocron.WithName(name))
+
+	_, err := scheduler.NewJob(
+		gocron.DurationJob(interval),
+		gocron.NewTask(func() {
+			ctxWithTimeout, cancelTimeout := context.WithTimeout(ctx, interval)
+			defer cancelTimeout()
+			jobFunc(ctxWithTimeout)
+		}),
+		options...,
+	)
+
+	if err != nil {
+		slog.Error("Failed to create job", "error", err.Error(), "job", name)
+		return fmt.Errorf("could not create %s job: %w", name, err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"LinkTracker/internal/application/scrapper"
 	"LinkTracker/internal/application/scrapper/mocks"
@@ -16,6 +17,7 @@ import (
 func Test_Scrapper_AddUser_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -24,7 +26,8 @@ func Test_Scrapper_AddUser_Success(t *testing.T) {
 	tgID := int64(123)
 
 	userRepo.On("CreateUser", ctx, tgID).Return(nil)
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.AddUser(ctx, tgID)
 
@@ -35,6 +38,7 @@ func Test_Scrapper_AddUser_Success(t *testing.T) {
 func Test_Scrapper_AddUser_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -43,7 +47,8 @@ func Test_Scrapper_AddUser_Error(t *testing.T) {
 	tgID := int64(123)
 
 	userRepo.On("CreateUser", ctx, tgID).Return(errors.New("some error"))
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.AddUser(ctx, tgID)
 
@@ -53,6 +58,7 @@ func Test_Scrapper_AddUser_Error(t *testing.T) {
 func Test_Scrapper_DeleteUser_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -61,7 +67,8 @@ func Test_Scrapper_DeleteUser_Success(t *testing.T) {
 	tgID := int64(123)
 
 	userRepo.On("DeleteUser", ctx, tgID).Return(nil)
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.DeleteUser(ctx, tgID)
 
@@ -72,6 +79,7 @@ func Test_Scrapper_DeleteUser_Success(t *testing.T) {
 func Test_Scrapper_DeleteUser_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -80,7 +88,8 @@ func Test_Scrapper_DeleteUser_Error(t *testing.T) {
 	tgID := int64(123)
 
 	userRepo.On("DeleteUser", ctx, tgID).Return(errors.New("some error"))
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.DeleteUser(ctx, tgID)
 
@@ -91,16 +100,18 @@ func Test_Scrapper_DeleteUser_Error(t *testing.T) {
 func Test_Scrapper_GetLinks_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	links := []domain.Link{{URL: "https://example/example", ID: 1}, {URL: "https://example/example2", ID: 2}}
+	links := []domain.Link{{URL: "https://github.com/example/example", ID: 1}, {URL: "https://github.com/example/example2", ID: 2}}
 
 	linkRepo.On("GetUserLinks", ctx, tgID).Return(links, nil)
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	links, err := s.GetUserLinks(ctx, tgID)
 
@@ -112,6 +123,7 @@ func Test_Scrapper_GetLinks_Success(t *testing.T) {
 func Test_Scrapper_GetLinks_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -120,7 +132,8 @@ func Test_Scrapper_GetLinks_Error(t *testing.T) {
 	tgID := int64(123)
 
 	linkRepo.On("GetUserLinks", ctx, tgID).Return(nil, errors.New("some error"))
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	links, err := s.GetUserLinks(ctx, tgID)
 
@@ -132,19 +145,22 @@ func Test_Scrapper_GetLinks_Error(t *testing.T) {
 func Test_Scrapper_AddLink_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	newLink := domain.Link{URL: "https://example/example"}
-	newLinkWithID := domain.Link{URL: "https://example/example", ID: 1}
+	newLink := domain.Link{URL: "https://github.com/example/example"}
+	newLinkWithID := domain.Link{URL: "https://github.com/example/example", ID: 1}
 
 	linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{}, nil)
 	linkRepo.On("AddLink", ctx, tgID, &newLink).Return(newLinkWithID, nil)
+	linkChecker.On("ValidateLink", ctx, &newLink).Return(nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	link, err := s.AddLink(ctx, tgID, &newLink)
 	assert.Nil(t, err)
@@ -155,17 +171,19 @@ func Test_Scrapper_AddLink_Success(t *testing.T) {
 func Test_Scrapper_AddLink_GetUserLinksError(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	newLink := domain.Link{URL: "https://example/example"}
+	newLink := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("GetUserLinks", ctx, tgID).Return(nil, errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	link, err := s.AddLink(ctx, tgID, &newLink)
 	assert.Error(t, err)
@@ -176,17 +194,19 @@ func Test_Scrapper_AddLink_GetUserLinksError(t *testing.T) {
 func Test_Scrapper_AddLink_LinkAlreadyTracks(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	newLink := domain.Link{URL: "https://example/example"}
+	newLink := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{newLink}, nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	link, err := s.AddLink(ctx, tgID, &newLink)
 	assert.ErrorIs(t, err, domain.ErrLinkAlreadyTracking{})
@@ -197,18 +217,21 @@ func Test_Scrapper_AddLink_LinkAlreadyTracks(t *testing.T) {
 func Test_Scrapper_AddLink_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	newLink := domain.Link{URL: "https://example/example"}
+	newLink := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{}, nil)
 	linkRepo.On("AddLink", ctx, tgID, &newLink).Return(domain.Link{}, errors.New("some error"))
+	linkChecker.On("ValidateLink", ctx, &newLink).Return(nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	link, err := s.AddLink(ctx, tgID, &newLink)
 	assert.Error(t, err)
@@ -216,20 +239,168 @@ func Test_Scrapper_AddLink_Error(t *testing.T) {
 	linkRepo.AssertExpectations(t)
 }
 
+func Test_Scrapper_AddLink_ValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "Unsupported link", err: domain.ErrUnsupportedHost{}},
+		{name: "Source not found", err: domain.ErrSourceNotFound{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			linkRepo := &mocks.LinkRepo{}
+			linkChecker := &mocks.LinkChecker{}
+			tgID := int64(123)
+			newLink := domain.Link{URL: "https://github.com/example/example"}
+
+			linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{}, nil)
+			linkChecker.On("ValidateLink", ctx, &newLink).Return(tt.err)
+
+			s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+				time.Minute, 10*time.Minute, &mocks.Notifier{}, linkChecker)
+
+			link, err := s.AddLink(ctx, tgID, &newLink)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, domain.Link{}, link)
+			linkRepo.AssertExpectations(t)
+			linkChecker.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_Scrapper_AddLinks_Success(t *testing.T) {
+	ctx := context.Background()
+	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
+	linkRepo := &mocks.LinkRepo{}
+	userRepo := &mocks.UserRepo{}
+	stateRepo := &mocks.StateRepo{}
+	notifier := &mocks.Notifier{}
+	linkChecker := &mocks.LinkChecker{}
+	tgID := int64(123)
+	trackedLink := domain.Link{URL: "https://github.com/example/tracked"}
+	newLink := domain.Link{URL: "https://github.com/example/new"}
+	failedLink := domain.Link{URL: "https://github.com/example/failed"}
+	newLinkWithID := domain.Link{URL: "https://github.com/example/new", ID: 1}
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return([]domain.Link{trackedLink}, nil)
+	linkRepo.On("AddLink", ctx, tgID, &newLink).Return(newLinkWithID, nil).Once()
+	linkRepo.On("AddLink", ctx, tgID, &failedLink).Return(domain.Link{}, errors.New("some error"))
+	linkChecker.On("ValidateLinks", ctx, []domain.Link{newLink, failedLink}).Return([]error{nil, nil}).Once()
+
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
+
+	results, err := s.AddLinks(ctx, tgID, []domain.Link{trackedLink, newLink, failedLink, newLink})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LinkImportResult{
+		{Link: trackedLink, Error: domain.ErrLinkAlreadyTracking{}.Error()},
+		{Link: newLinkWithID},
+		{Link: failedLink, Error: "some error"},
+		{Link: newLink, Error: domain.ErrLinkAlreadyTracking{}.Error()},
+	}, results)
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_AddLinks_CanonicalURLs(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &mocks.LinkRepo{}
+	tgID := int64(123)
+	canonicalLink := domain.Link{URL: "https://github.com/example/new"}
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(nil, nil)
+	linkRepo.On("AddLink", ctx, tgID, &canonicalLink).Return(domain.Link{URL: canonicalLink.URL, ID: 1}, nil).Once()
+
+	linkChecker := &mocks.LinkChecker{}
+	linkChecker.On("ValidateLinks", ctx, []domain.Link{canonicalLink}).Return([]error{nil}).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, linkChecker)
+
+	results, err := s.AddLinks(ctx, tgID, []domain.Link{
+		{URL: "http://www.GitHub.com/Example/New/"},
+		{URL: "https://github.com/example/new.git"},
+		{URL: "https://example.com/example/new"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LinkImportResult{
+		{Link: domain.Link{URL: canonicalLink.URL, ID: 1}},
+		{Link: canonicalLink, Error: domain.ErrLinkAlreadyTracking{}.Error()},
+		{Link: domain.Link{URL: "https://example.com/example/new"}, Error: domain.ErrWrongURL{}.Error()},
+	}, results)
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_AddLinks_ValidationErrors(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &mocks.LinkRepo{}
+	tgID := int64(123)
+	missingLink := domain.Link{URL: "https://github.com/example/missing"}
+	newLink := domain.Link{URL: "https://github.com/example/new"}
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(nil, nil)
+	linkRepo.On("AddLink", ctx, tgID, &newLink).Return(domain.Link{URL: newLink.URL, ID: 2}, nil).Once()
+
+	linkChecker := &mocks.LinkChecker{}
+	linkChecker.On("ValidateLinks", ctx, []domain.Link{missingLink, newLink}).
+		Return([]error{domain.ErrSourceNotFound{}, nil}).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, linkChecker)
+
+	results, err := s.AddLinks(ctx, tgID, []domain.Link{missingLink, newLink, missingLink})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LinkImportResult{
+		{Link: missingLink, Error: domain.ErrSourceNotFound{}.Error()},
+		{Link: domain.Link{URL: newLink.URL, ID: 2}},
+		{Link: missingLink, Error: domain.ErrSourceNotFound{}.Error()},
+	}, results)
+	linkRepo.AssertExpectations(t)
+	linkChecker.AssertExpectations(t)
+	linkChecker.AssertNotCalled(t, "ValidateLink", mock.Anything, mock.Anything)
+}
+
+func Test_Scrapper_AddLinks_GetUserLinksError(t *testing.T) {
+	ctx := context.Background()
+	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
+	linkRepo := &mocks.LinkRepo{}
+	userRepo := &mocks.UserRepo{}
+	stateRepo := &mocks.StateRepo{}
+	notifier := &mocks.Notifier{}
+	linkChecker := &mocks.LinkChecker{}
+	tgID := int64(123)
+
+	linkRepo.On("GetUserLinks", ctx, tgID).Return(nil, errors.New("some error"))
+
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
+
+	results, err := s.AddLinks(ctx, tgID, []domain.Link{{URL: "https://github.com/example/example"}})
+	assert.Error(t, err)
+	assert.Nil(t, results)
+	linkRepo.AssertExpectations(t)
+}
+
 func Test_Scrapper_DeleteLink_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("DeleteLink", ctx, tgID, &link).Return(link, nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	deletedLink, err := s.DeleteLink(ctx, tgID, &link)
 	assert.Nil(t, err)
@@ -239,17 +410,19 @@ func Test_Scrapper_DeleteLink_Success(t *testing.T) {
 func Test_Scrapper_DeleteLink_LinkNotExistError(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("DeleteLink", ctx, tgID, &link).Return(domain.Link{}, domain.ErrLinkNotExist{})
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	deletedLink, err := s.DeleteLink(ctx, tgID, &link)
 	assert.ErrorIs(t, err, domain.ErrLinkNotExist{})
@@ -260,17 +433,19 @@ func Test_Scrapper_DeleteLink_LinkNotExistError(t *testing.T) {
 func Test_Scrapper_DeleteLink_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("DeleteLink", ctx, tgID, &link).Return(domain.Link{}, errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	deletedLink, err := s.DeleteLink(ctx, tgID, &link)
 	assert.Error(t, err)
@@ -281,17 +456,19 @@ func Test_Scrapper_DeleteLink_Error(t *testing.T) {
 func Test_Scrapper_UpdateLink_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("UpdateLink", ctx, tgID, &link).Return(nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.UpdateLink(ctx, tgID, &link)
 	assert.Nil(t, err)
@@ -301,17 +478,19 @@ func Test_Scrapper_UpdateLink_Success(t *testing.T) {
 func Test_Scrapper_UpdateLink_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
 	linkRepo.On("UpdateLink", ctx, tgID, &link).Return(errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.UpdateLink(ctx, tgID, &link)
 	assert.Error(t, err)
@@ -321,19 +500,22 @@ func Test_Scrapper_UpdateLink_Error(t *testing.T) {
 func Test_Scrapper_CreateState_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
+	userID := int64(456)
 	state := 1
 
-	stateRepo.On("CreateState", ctx, tgID, state).Return(nil)
+	stateRepo.On("CreateState", ctx, tgID, userID, state, mock.AnythingOfType("time.Time")).Return(nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
-	err := s.CreateState(ctx, tgID, state)
+	err := s.CreateState(ctx, tgID, userID, state)
 	assert.Nil(t, err)
 	linkRepo.AssertExpectations(t)
 }
@@ -341,19 +523,22 @@ func Test_Scrapper_CreateState_Success(t *testing.T) {
 func Test_Scrapper_CreateState_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
+	userID := int64(456)
 	state := 1
 
-	stateRepo.On("CreateState", ctx, tgID, state).Return(errors.New("some error"))
+	stateRepo.On("CreateState", ctx, tgID, userID, state, mock.AnythingOfType("time.Time")).Return(errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
-	err := s.CreateState(ctx, tgID, state)
+	err := s.CreateState(ctx, tgID, userID, state)
 	assert.Error(t, err)
 	linkRepo.AssertExpectations(t)
 }
@@ -361,6 +546,7 @@ func Test_Scrapper_CreateState_Error(t *testing.T) {
 func Test_Scrapper_DeleteState_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -370,7 +556,8 @@ func Test_Scrapper_DeleteState_Success(t *testing.T) {
 
 	stateRepo.On("DeleteState", ctx, tgID).Return(nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.DeleteState(ctx, tgID)
 	assert.Nil(t, err)
@@ -380,6 +567,7 @@ func Test_Scrapper_DeleteState_Success(t *testing.T) {
 func Test_Scrapper_DeleteState_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -389,7 +577,8 @@ func Test_Scrapper_DeleteState_Error(t *testing.T) {
 
 	stateRepo.On("DeleteState", ctx, tgID).Return(errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.DeleteState(ctx, tgID)
 	assert.Error(t, err)
@@ -399,22 +588,28 @@ func Test_Scrapper_DeleteState_Error(t *testing.T) {
 func Test_Scrapper_GetState_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
 	notifier := &mocks.Notifier{}
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
+	userID := int64(456)
 	state := 1
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
-	stateRepo.On("GetState", ctx, tgID).Return(state, link, nil)
+	stateRepo.On("GetState", ctx, tgID, mock.MatchedBy(func(createdAfter time.Time) bool {
+		return createdAfter.Before(time.Now().UTC().Add(-stateTTL + time.Second))
+	})).Return(state, userID, link, nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
-	getState, getLink, err := s.GetState(ctx, tgID)
+	getState, getUserID, getLink, err := s.GetState(ctx, tgID)
 	assert.Nil(t, err)
 	assert.Equal(t, state, getState)
+	assert.Equal(t, userID, getUserID)
 	assert.Equal(t, link, getLink)
 	linkRepo.AssertExpectations(t)
 }
@@ -422,6 +617,7 @@ func Test_Scrapper_GetState_Success(t *testing.T) {
 func Test_Scrapper_GetState_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -429,11 +625,12 @@ func Test_Scrapper_GetState_Error(t *testing.T) {
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
 
-	stateRepo.On("GetState", ctx, tgID).Return(-1, domain.Link{}, errors.New("some error"))
+	stateRepo.On("GetState", ctx, tgID, mock.Anything).Return(-1, int64(0), domain.Link{}, errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
-	getState, getLink, err := s.GetState(ctx, tgID)
+	getState, _, getLink, err := s.GetState(ctx, tgID)
 	assert.Error(t, err)
 	assert.Equal(t, -1, getState)
 	assert.Equal(t, domain.Link{}, getLink)
@@ -443,6 +640,7 @@ func Test_Scrapper_GetState_Error(t *testing.T) {
 func Test_Scrapper_UpdateState_Success(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -450,11 +648,12 @@ func Test_Scrapper_UpdateState_Success(t *testing.T) {
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
 	state := 1
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
-	stateRepo.On("UpdateState", ctx, tgID, state, &link).Return(nil)
+	stateRepo.On("UpdateState", ctx, tgID, state, &link, mock.AnythingOfType("time.Time")).Return(nil)
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.UpdateState(ctx, tgID, state, &link)
 	assert.Nil(t, err)
@@ -464,6 +663,7 @@ func Test_Scrapper_UpdateState_Success(t *testing.T) {
 func Test_Scrapper_UpdateState_Error(t *testing.T) {
 	ctx := context.Background()
 	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
 	linkRepo := &mocks.LinkRepo{}
 	userRepo := &mocks.UserRepo{}
 	stateRepo := &mocks.StateRepo{}
@@ -471,14 +671,95 @@ func Test_Scrapper_UpdateState_Error(t *testing.T) {
 	linkChecker := &mocks.LinkChecker{}
 	tgID := int64(123)
 	state := 1
-	link := domain.Link{URL: "https://example/example"}
+	link := domain.Link{URL: "https://github.com/example/example"}
 
-	stateRepo.On("UpdateState", ctx, tgID, state, &link).Return(errors.New("some error"))
+	stateRepo.On("UpdateState", ctx, tgID, state, &link, mock.AnythingOfType("time.Time")).Return(errors.New("some error"))
 
-	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, interval, notifier, linkChecker)
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
 
 	err := s.UpdateState(ctx, tgID, state, &link)
 	assert.Error(t, err)
 
 	linkRepo.AssertExpectations(t)
 }
+
+func Test_Scrapper_DeleteExpiredStates(t *testing.T) {
+	ctx := context.Background()
+	interval := 1 * time.Minute
+	stateTTL := 10 * time.Minute
+	linkRepo := &mocks.LinkRepo{}
+	userRepo := &mocks.UserRepo{}
+	stateRepo := &mocks.StateRepo{}
+	notifier := &mocks.Notifier{}
+	linkChecker := &mocks.LinkChecker{}
+
+	stateRepo.On("DeleteExpiredStates", ctx, mock.MatchedBy(func(createdBefore time.Time) bool {
+		return createdBefore.Before(time.Now().UTC().Add(-stateTTL + time.Second))
+	})).Return(int64(2), nil).Once()
+
+	s := scrapper.NewScrapper(userRepo, linkRepo, stateRepo, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		interval, stateTTL, notifier, linkChecker)
+
+	s.DeleteExpiredStates(ctx)
+
+	stateRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_GetUnhealthyLinks(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &mocks.LinkRepo{}
+	unhealthy := []domain.Link{{ID: 1, URL: "https://github.com/example/broken",
+		Health: domain.LinkHealth{LastError: "status not ok", ConsecutiveFailures: 5}}}
+
+	linkRepo.On("GetUnhealthyLinks", ctx, int64(3)).Return(unhealthy, nil).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	links, err := s.GetUnhealthyLinks(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, unhealthy, links)
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_RefreshTrackingStats(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &mocks.LinkRepo{}
+
+	linkRepo.On("GetTrackingStats", ctx).Return(domain.TrackingStats{Users: 2, Links: 3}, nil).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	s.RefreshTrackingStats(ctx)
+
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_CheckScheduler_NotRunning(t *testing.T) {
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	err := s.CheckScheduler(context.Background())
+	assert.ErrorAs(t, err, &domain.ErrSchedulerNotRunning{})
+}
+
+func Test_Scrapper_CheckScheduler_Running(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	linkRepo := &mocks.LinkRepo{}
+	linkRepo.On("GetTrackingStats", mock.Anything).Return(domain.TrackingStats{}, nil).Maybe()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Hour, time.Hour, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	done := make(chan error)
+
+	go func() { done <- s.Run(ctx) }()
+
+	assert.Eventually(t, func() bool { return s.CheckScheduler(ctx) == nil }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.ErrorAs(t, s.CheckScheduler(context.Background()), &domain.ErrSchedulerNotRunning{})
+}
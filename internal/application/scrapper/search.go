@@ -0,0 +1,51 @@
+package scrapper
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"LinkTracker/internal/domain"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+	maxSearchTerms     = 8
+)
+
+// SearchLinks ищет ссылки пользователя по адресу, тегам и истории обновлений. Запрос разбивается на
+// слова из букв и цифр, остальные символы считаются разделителями. Результаты упорядочены по
+// релевантности с поправкой на давность.
+func (s *Scrapper) SearchLinks(ctx context.Context, tgID int64, query string, limit int64) ([]domain.SearchResult, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, domain.ErrInvalidSearchQuery{}
+	}
+
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	limit = min(limit, maxSearchLimit)
+
+	results, err := s.linkRepo.SearchUserLinks(ctx, tgID, terms, limit)
+	if err != nil {
+		slog.Error("Search links failed", "error", err.Error(), "tgID", tgID, "query", query)
+		return nil, err
+	}
+
+	slog.Info("Search links done", "tgID", tgID, "terms", len(terms), "results", len(results))
+
+	return results, nil
+}
+
+// searchTerms приводит запрос к нижнему регистру и оставляет не больше maxSearchTerms слов.
+func searchTerms(query string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return terms[:min(len(terms), maxSearchTerms)]
+}
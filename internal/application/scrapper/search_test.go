@@ -0,0 +1,47 @@
+package scrapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func Test_Scrapper_SearchLinks_NormalizesQuery(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	results := []domain.SearchResult{{Link: domain.Link{ID: 1, URL: "https://github.com/golang/go"}, Rank: 0.3}}
+
+	linkRepo.On("SearchUserLinks", ctx, tgID, []string{"golang", "go", "ошибка"}, int64(50)).Return(results, nil).Once()
+	linkRepo.On("SearchUserLinks", ctx, tgID, []string{"leak"}, int64(10)).Return(nil, nil).Once()
+
+	got, err := s.SearchLinks(ctx, tgID, "GoLang/go 'Ошибка'", 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, results, got)
+
+	_, err = s.SearchLinks(ctx, tgID, "leak", 0)
+
+	assert.NoError(t, err)
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_SearchLinks_EmptyQuery(t *testing.T) {
+	ctx := context.Background()
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	_, err := s.SearchLinks(ctx, 123, " &:*! ", 10)
+
+	assert.ErrorAs(t, err, &domain.ErrInvalidSearchQuery{})
+	linkRepo.AssertNotCalled(t, "SearchUserLinks")
+}
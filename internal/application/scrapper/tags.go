@@ -0,0 +1,116 @@
+package scrapper
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"LinkTracker/internal/domain"
+)
+
+func (s *Scrapper) GetUserLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	links, err := s.linkRepo.GetUserLinksByTag(ctx, tgID, tag)
+	if err != nil {
+		slog.Error("Get user links by tag failed", "error", err.Error(), "tgID", tgID, "tag", tag)
+		return nil, err
+	}
+
+	slog.Info("Get user links by tag done", "tgID", tgID, "tag", tag)
+
+	return links, nil
+}
+
+func (s *Scrapper) GetUserTags(ctx context.Context, tgID int64) ([]domain.TagCount, error) {
+	tags, err := s.linkRepo.GetUserTags(ctx, tgID)
+	if err != nil {
+		slog.Error("Get user tags failed", "error", err.Error(), "tgID", tgID)
+		return nil, err
+	}
+
+	slog.Info("Get user tags done", "tgID", tgID, "tags", len(tags))
+
+	return tags, nil
+}
+
+// RenameTag переименовывает тег во всех ссылках пользователя вместе с режимом доставки тега
+// и возвращает число изменённых ссылок.
+func (s *Scrapper) RenameTag(ctx context.Context, tgID int64, oldTag, newTag string) (int64, error) {
+	if !validTag(newTag) {
+		return 0, domain.ErrInvalidTag{Tag: newTag}
+	}
+
+	renamed, err := s.linkRepo.RenameTag(ctx, tgID, oldTag, newTag)
+	if err != nil {
+		slog.Error("Rename tag failed", "error", err.Error(), "tgID", tgID, "tag", oldTag)
+		return 0, err
+	}
+
+	if renamed == 0 {
+		return 0, domain.ErrTagNotExist{Tag: oldTag}
+	}
+
+	s.renameTagMode(ctx, tgID, oldTag, newTag)
+
+	slog.Info("Rename tag done", "tgID", tgID, "oldTag", oldTag, "newTag", newTag, "links", renamed)
+
+	return renamed, nil
+}
+
+// renameTagMode переносит режим доставки на новое имя тега. Ошибка не отменяет переименование:
+// в худшем случае тег вернётся к общему режиму пользователя.
+func (s *Scrapper) renameTagMode(ctx context.Context, tgID int64, oldTag, newTag string) {
+	settings, err := s.deliveryRepo.GetSettings(ctx, tgID)
+	if err != nil {
+		slog.Error("Rename tag mode failed", "error", err.Error(), "tgID", tgID, "tag", oldTag)
+		return
+	}
+
+	mode, ok := settings.TagModes[oldTag]
+	if !ok {
+		return
+	}
+
+	if err := s.deliveryRepo.SetTagMode(ctx, tgID, newTag, mode); err != nil {
+		slog.Error("Rename tag mode failed", "error", err.Error(), "tgID", tgID, "tag", newTag)
+		return
+	}
+
+	if err := s.deliveryRepo.DeleteTagMode(ctx, tgID, oldTag); err != nil {
+		slog.Error("Rename tag mode failed", "error", err.Error(), "tgID", tgID, "tag", oldTag)
+	}
+}
+
+// DeleteLinksByTag прекращает отслеживание всех ссылок пользователя с заданным тегом
+// и возвращает удалённые ссылки.
+func (s *Scrapper) DeleteLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	links, err := s.linkRepo.GetUserLinksByTag(ctx, tgID, tag)
+	if err != nil {
+		slog.Error("Delete links by tag failed", "error", err.Error(), "tgID", tgID, "tag", tag)
+		return nil, err
+	}
+
+	if len(links) == 0 {
+		return nil, domain.ErrTagNotExist{Tag: tag}
+	}
+
+	deleted := make([]domain.Link, 0, len(links))
+
+	for i := range links {
+		deletedLink, err := s.linkRepo.DeleteLink(ctx, tgID, &links[i])
+		if err != nil {
+			slog.Error("Delete links by tag failed", "error", err.Error(), "tgID", tgID, "link", links[i].URL)
+			return deleted, err
+		}
+
+		deleted = append(deleted, deletedLink)
+	}
+
+	slog.Info("Delete links by tag done", "tgID", tgID, "tag", tag, "links", len(deleted))
+
+	return deleted, nil
+}
+
+// validTag проверяет, что тег можно передать одним аргументом команды и сохранить в массиве тегов.
+func validTag(tag string) bool {
+	return tag != "" && !strings.ContainsAny(tag, " \t\n,")
+}
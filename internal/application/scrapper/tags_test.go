@@ -0,0 +1,93 @@
+package scrapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func Test_Scrapper_RenameTag_MovesTagMode(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	deliveryRepo := &mocks.DeliveryRepo{}
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, deliveryRepo, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	settings := domain.DefaultDeliverySettings()
+	settings.TagModes["work"] = domain.DeliveryDaily
+
+	linkRepo.On("RenameTag", ctx, tgID, "work", "job").Return(int64(2), nil).Once()
+	deliveryRepo.On("GetSettings", ctx, tgID).Return(settings, nil).Once()
+	deliveryRepo.On("SetTagMode", ctx, tgID, "job", domain.DeliveryDaily).Return(nil).Once()
+	deliveryRepo.On("DeleteTagMode", ctx, tgID, "work").Return(nil).Once()
+
+	renamed, err := s.RenameTag(ctx, tgID, "work", "job")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), renamed)
+	linkRepo.AssertExpectations(t)
+	deliveryRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_RenameTag_NotExist(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	linkRepo.On("RenameTag", ctx, tgID, "work", "job").Return(int64(0), nil).Once()
+
+	_, err := s.RenameTag(ctx, tgID, "work", "job")
+
+	assert.ErrorAs(t, err, &domain.ErrTagNotExist{})
+}
+
+func Test_Scrapper_RenameTag_InvalidTag(t *testing.T) {
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	_, err := s.RenameTag(context.Background(), 123, "work", "two words")
+
+	assert.ErrorAs(t, err, &domain.ErrInvalidTag{})
+	linkRepo.AssertNotCalled(t, "RenameTag")
+}
+
+func Test_Scrapper_DeleteLinksByTag(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	tagged := []domain.Link{pauseTestLinks()[0], pauseTestLinks()[2]}
+
+	linkRepo.On("GetUserLinksByTag", ctx, tgID, "work").Return(tagged, nil).Once()
+	linkRepo.On("DeleteLink", ctx, tgID, &tagged[0]).Return(tagged[0], nil).Once()
+	linkRepo.On("DeleteLink", ctx, tgID, &tagged[1]).Return(tagged[1], nil).Once()
+
+	deleted, err := s.DeleteLinksByTag(ctx, tgID, "work")
+
+	assert.NoError(t, err)
+	assert.Equal(t, tagged, deleted)
+	linkRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_DeleteLinksByTag_NotExist(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	linkRepo := &mocks.LinkRepo{}
+	s := newPauseScrapper(linkRepo)
+
+	linkRepo.On("GetUserLinksByTag", ctx, tgID, "work").Return([]domain.Link{}, nil).Once()
+
+	_, err := s.DeleteLinksByTag(ctx, tgID, "work")
+
+	assert.ErrorAs(t, err, &domain.ErrTagNotExist{})
+	linkRepo.AssertNotCalled(t, "DeleteLink")
+}
@@ -0,0 +1,43 @@
+package scrapper
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"LinkTracker/internal/domain"
+)
+
+// IssueToken выпускает новый персональный токен публичного API для пользователя tgID. Выпущенный ранее
+// токен перестаёт действовать. Сам токен не сохраняется, его нужно сразу передать пользователю.
+func (s *Scrapper) IssueToken(ctx context.Context, tgID int64) (string, error) {
+	token, err := domain.NewAPIToken()
+	if err != nil {
+		slog.Error("Issue token failed", "error", err.Error(), "tgID", tgID)
+		return "", err
+	}
+
+	if err := s.userRepo.SaveTokenHash(ctx, tgID, domain.HashAPIToken(token)); err != nil {
+		slog.Error("Issue token failed", "error", err.Error(), "tgID", tgID)
+		return "", err
+	}
+
+	slog.Info("Issue token done", "tgID", tgID)
+
+	return token, nil
+}
+
+// AuthenticateToken возвращает tg_id владельца персонального токена или ErrInvalidToken, если токен не выпускался
+// или уже заменён новым.
+func (s *Scrapper) AuthenticateToken(ctx context.Context, token string) (int64, error) {
+	if !strings.HasPrefix(token, domain.APITokenPrefix) {
+		return 0, domain.ErrInvalidToken{}
+	}
+
+	tgID, err := s.userRepo.GetUserByTokenHash(ctx, domain.HashAPIToken(token))
+	if err != nil {
+		return 0, err
+	}
+
+	return tgID, nil
+}
@@ -0,0 +1,78 @@
+package scrapper_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+)
+
+func Test_Scrapper_IssueToken(t *testing.T) {
+	ctx := context.Background()
+
+	var savedHash string
+
+	userRepo := &mocks.UserRepo{}
+	userRepo.On("SaveTokenHash", ctx, int64(1), mock.Anything).
+		Run(func(args mock.Arguments) { savedHash = args.String(2) }).
+		Return(nil).Once()
+
+	s := scrapper.NewScrapper(userRepo, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	token, err := s.IssueToken(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, domain.APITokenPrefix))
+	assert.Equal(t, domain.HashAPIToken(token), savedHash, "в базе должен храниться только хеш токена")
+	assert.NotContains(t, savedHash, token)
+	userRepo.AssertExpectations(t)
+}
+
+func Test_Scrapper_IssueToken_SaveFailed(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := &mocks.UserRepo{}
+	userRepo.On("SaveTokenHash", ctx, int64(1), mock.Anything).Return(errors.New("db down")).Once()
+
+	s := scrapper.NewScrapper(userRepo, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	token, err := s.IssueToken(ctx, 1)
+	require.Error(t, err)
+	assert.Empty(t, token)
+}
+
+func Test_Scrapper_AuthenticateToken(t *testing.T) {
+	ctx := context.Background()
+	token := domain.APITokenPrefix + "secret"
+
+	userRepo := &mocks.UserRepo{}
+	userRepo.On("GetUserByTokenHash", ctx, domain.HashAPIToken(token)).Return(int64(42), nil).Once()
+	userRepo.On("GetUserByTokenHash", ctx, domain.HashAPIToken(domain.APITokenPrefix+"revoked")).
+		Return(int64(0), domain.ErrInvalidToken{}).Once()
+
+	s := scrapper.NewScrapper(userRepo, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, 10*time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+
+	tgID, err := s.AuthenticateToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), tgID)
+
+	_, err = s.AuthenticateToken(ctx, domain.APITokenPrefix+"revoked")
+	assert.ErrorAs(t, err, &domain.ErrInvalidToken{})
+
+	// Строка без префикса не может быть токеном, база не опрашивается
+	_, err = s.AuthenticateToken(ctx, "secret")
+	assert.ErrorAs(t, err, &domain.ErrInvalidToken{})
+
+	userRepo.AssertExpectations(t)
+}
@@ -24,15 +24,22 @@ func SignalWarden(signals ...os.Signal) chan struct{} {
 	return result
 }
 
-func StopScrapperSignalReceiving(ctx context.Context, cancel context.CancelFunc, server *http.Server) {
+// StopScrapperSignalReceiving по сигналу останавливает серверы scrapper и отменяет ctx. Сервер, равный nil,
+// пропускается: так передаётся выключенный публичный API.
+func StopScrapperSignalReceiving(ctx context.Context, cancel context.CancelFunc, servers ...*http.Server) {
 	<-SignalWarden(syscall.SIGINT, syscall.SIGTERM)
 
 	ctxServerShutdown, cancelServerShutdown := context.WithTimeout(ctx, 10*time.Second)
 	defer cancelServerShutdown()
 
-	err := server.Shutdown(ctxServerShutdown)
-	if err != nil {
-		slog.Error(err.Error())
+	for _, server := range servers {
+		if server == nil {
+			continue
+		}
+
+		if err := server.Shutdown(ctxServerShutdown); err != nil {
+			slog.Error(err.Error())
+		}
 	}
 
 	cancel()
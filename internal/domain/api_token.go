@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// APITokenPrefix отличает персональные токены публичного API от других секретов, например в логах и менеджерах паролей.
+const APITokenPrefix = "lt_"
+
+const apiTokenBytes = 32
+
+// NewAPIToken создаёт случайный персональный токен публичного API.
+func NewAPIToken() (string, error) {
+	buf := make([]byte, apiTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashAPIToken возвращает SHA-256 токена в шестнадцатеричном виде. В базе хранится только хеш, поэтому
+// утечка таблицы не раскрывает сами токены.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DeliveryMode string
+
+const (
+	DeliveryImmediate DeliveryMode = "immediate"
+	DeliveryHourly    DeliveryMode = "hourly"
+	DeliveryDaily     DeliveryMode = "daily"
+	// DeliveryQuiet — обновления, отложенные до конца тихих часов. Пользователь не выбирает этот режим явно.
+	DeliveryQuiet DeliveryMode = "quiet"
+)
+
+const (
+	DefaultDigestTime = 9 * 60
+	DefaultTimezone   = "UTC"
+	minutesInDay      = 24 * 60
+)
+
+func ParseDeliveryMode(value string) (DeliveryMode, bool) {
+	switch mode := DeliveryMode(strings.ToLower(value)); mode {
+	case DeliveryImmediate, DeliveryHourly, DeliveryDaily:
+		return mode, true
+	default:
+		return "", false
+	}
+}
+
+// IsMoreUrgent сообщает, доставляются ли обновления в режиме m раньше, чем в режиме other.
+func (m DeliveryMode) IsMoreUrgent(other DeliveryMode) bool {
+	return m.urgency() < other.urgency()
+}
+
+func (m DeliveryMode) urgency() int {
+	switch m {
+	case DeliveryImmediate:
+		return 0
+	case DeliveryHourly:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// DeliverySettings — настройки доставки уведомлений пользователя. DigestTime — время ежедневной
+// сводки в минутах от полуночи в часовом поясе Timezone. TagModes переопределяет режим
+// для ссылок с указанными тегами.
+type DeliverySettings struct {
+	Mode       DeliveryMode
+	DigestTime int
+	Timezone   string
+	Quiet      QuietHours
+	TagModes   map[string]DeliveryMode
+}
+
+// DeliverySettingsUpdate — частичное изменение настроек: nil-поля не меняются.
+// Пустые тихие часы (QuietHours{}) отключают их.
+type DeliverySettingsUpdate struct {
+	Mode       *DeliveryMode
+	DigestTime *int
+	Timezone   *string
+	Quiet      *QuietHours
+}
+
+// QuietHours — интервал тихих часов в минутах от полуночи в часовом поясе пользователя.
+// Интервал может переходить через полночь (23:00-08:00). Start == End означает, что тихие часы выключены.
+type QuietHours struct {
+	Start int
+	End   int
+}
+
+// DeliveryTarget — как доставить обновление ссылки конкретному пользователю.
+type DeliveryTarget struct {
+	Mode DeliveryMode
+	// Urgent — режим immediate задан тегом ссылки: такие обновления доставляются и в тихие часы.
+	Urgent   bool
+	Quiet    QuietHours
+	Timezone string
+}
+
+func DefaultDeliverySettings() DeliverySettings {
+	return DeliverySettings{
+		Mode:       DeliveryImmediate,
+		DigestTime: DefaultDigestTime,
+		Timezone:   DefaultTimezone,
+		TagModes:   map[string]DeliveryMode{},
+	}
+}
+
+// Apply применяет изменение и проверяет получившиеся настройки.
+func (s *DeliverySettings) Apply(update *DeliverySettingsUpdate) error {
+	if update.Mode != nil {
+		if _, ok := ParseDeliveryMode(string(*update.Mode)); !ok {
+			return ErrInvalidSettings{Reason: fmt.Sprintf("unknown delivery mode %q", *update.Mode)}
+		}
+
+		s.Mode = *update.Mode
+	}
+
+	if update.DigestTime != nil {
+		if *update.DigestTime < 0 || *update.DigestTime >= minutesInDay {
+			return ErrInvalidSettings{Reason: fmt.Sprintf("digest time %d out of range", *update.DigestTime)}
+		}
+
+		s.DigestTime = *update.DigestTime
+	}
+
+	if update.Quiet != nil {
+		if !update.Quiet.valid() {
+			return ErrInvalidSettings{Reason: fmt.Sprintf("quiet hours %d-%d out of range", update.Quiet.Start, update.Quiet.End)}
+		}
+
+		s.Quiet = *update.Quiet
+	}
+
+	if update.Timezone != nil {
+		if _, err := LoadTimezone(*update.Timezone); err != nil {
+			return ErrInvalidSettings{Reason: err.Error()}
+		}
+
+		s.Timezone = *update.Timezone
+	}
+
+	return nil
+}
+
+// LastHourlyDigest возвращает начало текущего часа в часовом поясе пользователя.
+func (s *DeliverySettings) LastHourlyDigest(now time.Time) time.Time {
+	local := now.In(s.location())
+
+	return time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, local.Location()).UTC()
+}
+
+// LastDailyDigest возвращает последний момент ежедневной сводки, не позже now.
+func (s *DeliverySettings) LastDailyDigest(now time.Time) time.Time {
+	local := now.In(s.location())
+	digest := time.Date(local.Year(), local.Month(), local.Day(), s.DigestTime/60, s.DigestTime%60, 0, 0,
+		local.Location())
+
+	if digest.After(local) {
+		digest = digest.AddDate(0, 0, -1)
+	}
+
+	return digest.UTC()
+}
+
+// InQuietHours сообщает, идут ли у пользователя тихие часы в момент now.
+func (s *DeliverySettings) InQuietHours(now time.Time) bool {
+	return s.Quiet.Contains(now.In(s.location()))
+}
+
+func (s *DeliverySettings) location() *time.Location {
+	return locationOrUTC(s.Timezone)
+}
+
+// InQuietHours сообщает, нужно ли отложить несрочное обновление до конца тихих часов.
+func (t *DeliveryTarget) InQuietHours(now time.Time) bool {
+	return !t.Urgent && t.Quiet.Contains(now.In(locationOrUTC(t.Timezone)))
+}
+
+func locationOrUTC(name string) *time.Location {
+	location, err := LoadTimezone(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+func (q QuietHours) IsEmpty() bool {
+	return q.Start == q.End
+}
+
+// Contains сообщает, попадает ли локальное время local в тихие часы.
+func (q QuietHours) Contains(local time.Time) bool {
+	if q.IsEmpty() {
+		return false
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	if q.Start < q.End {
+		return minute >= q.Start && minute < q.End
+	}
+
+	return minute >= q.Start || minute < q.End
+}
+
+func (q QuietHours) String() string {
+	return FormatDigestTime(q.Start) + "-" + FormatDigestTime(q.End)
+}
+
+func (q QuietHours) valid() bool {
+	return q.Start >= 0 && q.Start < minutesInDay && q.End >= 0 && q.End < minutesInDay
+}
+
+// ParseQuietHours разбирает интервал тихих часов в формате ЧЧ:ММ-ЧЧ:ММ.
+func ParseQuietHours(value string) (QuietHours, error) {
+	rawStart, rawEnd, ok := strings.Cut(value, "-")
+	if !ok {
+		return QuietHours{}, ErrInvalidSettings{Reason: fmt.Sprintf("quiet hours %q, expected HH:MM-HH:MM", value)}
+	}
+
+	start, err := ParseDigestTime(rawStart)
+	if err != nil {
+		return QuietHours{}, err
+	}
+
+	end, err := ParseDigestTime(rawEnd)
+	if err != nil {
+		return QuietHours{}, err
+	}
+
+	return QuietHours{Start: start, End: end}, nil
+}
+
+// LoadTimezone принимает имя часового пояса из базы IANA (Europe/Moscow) или смещение
+// относительно UTC (+03:00, UTC+3, -5).
+func LoadTimezone(name string) (*time.Location, error) {
+	if location, err := time.LoadLocation(name); err == nil {
+		return location, nil
+	}
+
+	offset := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(name)), "UTC")
+	offset = strings.TrimPrefix(offset, "GMT")
+
+	if len(offset) < 2 || (offset[0] != '+' && offset[0] != '-') {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+
+	hoursPart, minutesPart, _ := strings.Cut(offset[1:], ":")
+
+	hours, err := strconv.Atoi(hoursPart)
+	if err != nil || hours > 14 {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+
+	minutes := 0
+	if minutesPart != "" {
+		minutes, err = strconv.Atoi(minutesPart)
+		if err != nil || minutes >= 60 {
+			return nil, fmt.Errorf("unknown timezone %q", name)
+		}
+	}
+
+	seconds := (hours*60 + minutes) * 60
+	if offset[0] == '-' {
+		seconds = -seconds
+	}
+
+	return time.FixedZone("UTC"+offset, seconds), nil
+}
+
+// ParseDigestTime разбирает время в формате ЧЧ:ММ и возвращает число минут от полуночи.
+func ParseDigestTime(value string) (int, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, ErrInvalidSettings{Reason: fmt.Sprintf("digest time %q, expected HH:MM", value)}
+	}
+
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func FormatDigestTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// PendingUpdate — обновление, отложенное до ближайшей сводки.
+type PendingUpdate struct {
+	ID     int64
+	Update LinkUpdate
+}
+
+// Digest — сводка накопленных обновлений для одного пользователя.
+type Digest struct {
+	TgID    int64
+	Mode    DeliveryMode
+	Updates []LinkUpdate
+}
@@ -85,12 +85,46 @@ func (e ErrLinkNotExist) Error() string {
 	return "link not exists"
 }
 
+type ErrTagNotExist struct {
+	Tag string
+}
+
+func (e ErrTagNotExist) Error() string {
+	return fmt.Sprintf("tag %q not exists", e.Tag)
+}
+
+type ErrInvalidTag struct {
+	Tag string
+}
+
+func (e ErrInvalidTag) Error() string {
+	return fmt.Sprintf("invalid tag %q: tag must be non-empty and contain no spaces or commas", e.Tag)
+}
+
+type ErrInvalidSearchQuery struct{}
+
+func (e ErrInvalidSearchQuery) Error() string {
+	return "search query must contain at least one word"
+}
+
 type ErrUpdatesNotFound struct{}
 
 func (e ErrUpdatesNotFound) Error() string {
 	return "updates not found"
 }
 
+type ErrSourceDeleted struct{}
+
+func (e ErrSourceDeleted) Error() string {
+	return "source deleted"
+}
+
+type ErrSourceNotFound struct{}
+
+func (e ErrSourceNotFound) Error() string {
+	return "source not found"
+}
+
 type ErrStatusNotOK struct {
 	StatusCode int
 }
@@ -98,3 +132,37 @@ type ErrStatusNotOK struct {
 func (e ErrStatusNotOK) Error() string {
 	return fmt.Sprintf("status not ok, status code [%d]", e.StatusCode)
 }
+
+type ErrFileTooLarge struct {
+	Limit int64
+}
+
+func (e ErrFileTooLarge) Error() string {
+	return fmt.Sprintf("file too large, limit [%d] bytes", e.Limit)
+}
+
+type ErrInvalidSettings struct {
+	Reason string
+}
+
+func (e ErrInvalidSettings) Error() string {
+	return "invalid settings: " + e.Reason
+}
+
+type ErrSchedulerNotRunning struct{}
+
+func (e ErrSchedulerNotRunning) Error() string {
+	return "scheduler not running"
+}
+
+type ErrScrapeInProgress struct{}
+
+func (e ErrScrapeInProgress) Error() string {
+	return "scrape already in progress"
+}
+
+type ErrInvalidToken struct{}
+
+func (e ErrInvalidToken) Error() string {
+	return "invalid api token"
+}
@@ -8,4 +8,121 @@ type Link struct {
 	Filters     []string
 	ID          int64
 	LastUpdated time.Time
+	// Paused — уведомления по ссылке сейчас приостановлены. PausedUntil задаёт конец временной паузы,
+	// нулевое значение означает паузу до /resume.
+	Paused      bool
+	PausedUntil time.Time
+	Metadata    LinkMetadata
+	Health      LinkHealth
+}
+
+// SourceStatus — состояние источника ссылки по данным его API.
+type SourceStatus string
+
+const (
+	SourceActive   SourceStatus = "active"
+	SourceArchived SourceStatus = "archived"
+	SourceClosed   SourceStatus = "closed"
+	SourceDeleted  SourceStatus = "deleted"
+)
+
+// IsInactive сообщает, что новых событий у источника больше не будет: репозиторий в архиве,
+// вопрос закрыт или удалён.
+func (s SourceStatus) IsInactive() bool {
+	return s == SourceArchived || s == SourceClosed || s == SourceDeleted
+}
+
+// LinkMetadata — сведения об источнике ссылки, обновляемые при каждой проверке. У репозитория GitHub
+// заполняются описание и число звёзд, у вопроса StackOverflow — число ответов. Пустой Status означает,
+// что источник ещё не проверялся.
+type LinkMetadata struct {
+	Title       string
+	Description string
+	Stars       int64
+	Answers     int64
+	Status      SourceStatus
+}
+
+func (m *LinkMetadata) IsEmpty() bool {
+	return m.Status == ""
+}
+
+// LinkHealth — результат последних проверок ссылки. Нулевой LastCheckedAt означает, что ссылка
+// ещё не проверялась. ConsecutiveFailures считает ошибки подряд и сбрасывается успешной проверкой,
+// LastError хранит текст последней ошибки.
+type LinkHealth struct {
+	LastCheckedAt       time.Time
+	LastError           string
+	ConsecutiveFailures int64
+}
+
+// IsFailing сообщает, что последняя проверка ссылки завершилась ошибкой.
+func (h *LinkHealth) IsFailing() bool {
+	return h.ConsecutiveFailures > 0
+}
+
+// LinkSelector выбирает ссылки пользователя по адресу, идентификатору или тегу.
+// Пустой селектор выбирает все ссылки.
+type LinkSelector struct {
+	URL string
+	ID  int64
+	Tag string
+}
+
+func (s LinkSelector) IsEmpty() bool {
+	return s.URL == "" && s.ID == 0 && s.Tag == ""
+}
+
+func (s LinkSelector) Matches(link *Link) bool {
+	switch {
+	case s.URL != "":
+		if canonical, err := CanonicalURL(s.URL); err == nil && link.URL == canonical {
+			return true
+		}
+
+		return link.URL == s.URL
+	case s.ID != 0:
+		return link.ID == s.ID
+	case s.Tag != "":
+		for _, tag := range link.Tags {
+			if tag == s.Tag {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return true
+	}
+}
+
+// TagCount — тег пользователя и число ссылок с этим тегом.
+type TagCount struct {
+	Tag   string
+	Links int64
+}
+
+// MaxImportLinks — сколько ссылок можно импортировать за раз. Импорт выполняется одним запросом
+// к scrapper, и проверка источников вместе с записью ссылок должна уложиться в его таймаут.
+const MaxImportLinks = 100
+
+// LinkImportResult — результат добавления одной ссылки при массовом импорте.
+// Error пустая, если ссылка добавлена.
+type LinkImportResult struct {
+	Link  Link
+	Error string
+}
+
+// SearchResult — ссылка пользователя, найденная полнотекстовым поиском. Update — лучше всего подходящее
+// под запрос обновление из истории ссылки, если совпадение нашлось там. Rank учитывает релевантность и давность.
+type SearchResult struct {
+	Link   Link
+	Update *UpdateRecord
+	Rank   float64
+}
+
+// TrackingStats — число пользователей, отслеживающих хотя бы одну ссылку, и число отслеживаемых ссылок.
+type TrackingStats struct {
+	Users int64
+	Links int64
 }
@@ -1,7 +1,63 @@
 package domain
 
+import (
+	"fmt"
+	"time"
+)
+
+// LinkUpdate — уведомление подписчикам ссылки. Warning отмечает служебные предупреждения скраппера
+// (перенос, сбои проверки, смена статуса источника): они доставляются как обычно, но не попадают в историю.
 type LinkUpdate struct {
 	Link        Link
 	TgIDs       []int64
 	Description string
+	Details     UpdateDetails
+	Warning     bool
+}
+
+// UpdateDetails — сведения о последнем событии по ссылке (issue, PR, ответ или комментарий),
+// из которых бот оформляет уведомление. Preview содержит текст без разметки.
+type UpdateDetails struct {
+	Kind      string
+	Title     string
+	URL       string
+	Author    string
+	AuthorURL string
+	CreatedAt time.Time
+	Preview   string
+}
+
+func (d *UpdateDetails) IsEmpty() bool {
+	return d.Title == "" && d.Author == "" && d.Preview == ""
+}
+
+// PlainText возвращает описание события простым текстом.
+func (d *UpdateDetails) PlainText() string {
+	return fmt.Sprintf("%s: %s\nUser: %s\nCreated At: %s\nPreview: %s",
+		d.Kind,
+		d.Title,
+		d.Author,
+		d.CreatedAt.UTC().Format(time.RFC3339),
+		d.Preview,
+	)
+}
+
+// UpdateRecord — обнаруженное обновление ссылки, сохранённое в истории. DetectedAt — момент,
+// когда скраппер заметил изменение; время самого события хранится в Details.CreatedAt.
+type UpdateRecord struct {
+	ID          int64
+	LinkID      int64
+	Description string
+	Details     UpdateDetails
+	DetectedAt  time.Time
+}
+
+// CheckResult — итог проверки ссылки обработчиком источника: время и сведения о последнем событии
+// и актуальные метаданные источника. Metadata заполняется, даже если новых событий не найдено.
+// MovedTo содержит новый адрес, если источник переименован или перенесён.
+type CheckResult struct {
+	LastUpdate time.Time
+	Details    UpdateDetails
+	Metadata   LinkMetadata
+	MovedTo    string
 }
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// ScrapeStats — итог прохода проверки ссылок. Failed не включает проверки, не нашедшие новых событий.
+type ScrapeStats struct {
+	Checked int64
+	Failed  int64
+}
+
+// SchedulerStatus — состояние планировщика проверок ссылок для оператора. Пока не было ни одного
+// прохода, LastRunAt нулевое.
+type SchedulerStatus struct {
+	Paused          bool
+	Running         bool
+	LastRunAt       time.Time
+	LastRunDuration time.Duration
+	LastRun         ScrapeStats
+	NextRunAt       time.Time
+}
+
+// UserLinkCount — пользователь и число ссылок, которые он отслеживает.
+type UserLinkCount struct {
+	TgID  int64
+	Links int64
+}
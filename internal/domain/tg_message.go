@@ -1,6 +1,61 @@
 package domain
 
+// Message — входящее сообщение или нажатие кнопки. TgID — идентификатор чата: в группах и каналах
+// подписки принадлежат чату, а не отдельному участнику. UserID — отправитель; 0, если сообщение
+// отправлено от имени самого чата (посты канала, анонимные администраторы группы).
 type Message struct {
-	TgID int64
+	TgID         int64
+	UserID       int64
+	ChatType     ChatType
+	Text         string
+	CallbackID   string
+	CallbackData string
+	MessageID    int
+	Document     *Document
+}
+
+// Document — файл, приложенный к сообщению. Содержимое загружается отдельно по FileID.
+type Document struct {
+	FileID   string
+	FileName string
+}
+
+func (m *Message) IsCallback() bool {
+	return m.CallbackID != ""
+}
+
+// ChatType — тип чата Telegram, значения совпадают с полем Chat.Type в Bot API.
+type ChatType string
+
+const (
+	ChatPrivate    ChatType = "private"
+	ChatGroup      ChatType = "group"
+	ChatSupergroup ChatType = "supergroup"
+	ChatChannel    ChatType = "channel"
+)
+
+// IsGroup сообщает, что в чате несколько участников, которые могут писать боту.
+func (c ChatType) IsGroup() bool {
+	return c == ChatGroup || c == ChatSupergroup
+}
+
+type InlineButton struct {
 	Text string
+	Data string
+}
+
+type InlineKeyboard [][]InlineButton
+
+type ParseMode string
+
+const (
+	ParseModePlain      ParseMode = ""
+	ParseModeHTML       ParseMode = "HTML"
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
+)
+
+// MessageFormat задаёт разметку сообщения и показ превью ссылок.
+type MessageFormat struct {
+	ParseMode          ParseMode
+	DisableLinkPreview bool
 }
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+)
+
+const (
+	GitHubHost        = "github.com"
+	StackOverflowHost = "stackoverflow.com"
+)
+
+// CanonicalHost приводит хост к нижнему регистру и убирает префикс www.
+func CanonicalHost(host string) string {
+	return strings.TrimPrefix(strings.ToLower(host), "www.")
+}
+
+// CanonicalURL приводит ссылку на репозиторий GitHub или вопрос StackOverflow к единому виду, чтобы
+// один источник хранился одной записью: схема https, хост без www, без параметров, якоря и лишних
+// частей пути. Имена владельца и репозитория GitHub не зависят от регистра и приводятся к нижнему,
+// у вопроса StackOverflow остаётся только номер. Для других адресов возвращается ErrWrongURL.
+func CanonicalURL(rawURL string) (string, error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", ErrWrongURL{}
+	}
+
+	scheme := strings.ToLower(parsedURL.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", ErrWrongURL{}
+	}
+
+	parts := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
+
+	switch CanonicalHost(parsedURL.Host) {
+	case GitHubHost:
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return "", ErrWrongURL{}
+		}
+
+		owner, repo := strings.ToLower(parts[0]), strings.ToLower(strings.TrimSuffix(parts[1], ".git"))
+
+		return "https://" + GitHubHost + "/" + owner + "/" + repo, nil
+	case StackOverflowHost:
+		if len(parts) < 2 || (parts[0] != "questions" && parts[0] != "q") || !isDigits(parts[1]) {
+			return "", ErrWrongURL{}
+		}
+
+		return "https://" + StackOverflowHost + "/questions/" + parts[1], nil
+	default:
+		return "", ErrWrongURL{}
+	}
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
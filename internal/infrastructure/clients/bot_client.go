@@ -11,7 +11,10 @@ import (
 	"time"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
 	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
+	"LinkTracker/internal/infrastructure/serviceauth"
+	"LinkTracker/internal/tracing"
 )
 
 type BotHTTPClient struct {
@@ -19,28 +22,29 @@ type BotHTTPClient struct {
 	botBaseURL *url.URL
 }
 
-func NewBotHTTPClient(botBaseURL string, timeout time.Duration) (*BotHTTPClient, error) {
+func NewBotHTTPClient(botBaseURL string, timeout time.Duration, credentials serviceauth.Credentials) (*BotHTTPClient, error) {
 	parsedURL, err := url.Parse(botBaseURL)
 	if err != nil {
 		return nil, err
 	}
 
 	return &BotHTTPClient{
-		client:     &http.Client{Timeout: timeout},
+		client:     &http.Client{Timeout: timeout, Transport: tracing.Transport(credentials.Transport())},
 		botBaseURL: parsedURL}, nil
 }
 
-func (c *BotHTTPClient) PostUpdates(ctx context.Context, link *domain.Link, tgID []int64, description string) error {
-	endpoint := c.botBaseURL.JoinPath("/updates")
+func (c *BotHTTPClient) PostUpdates(ctx context.Context, update *domain.LinkUpdate) error {
+	return c.post(ctx, "/updates", dto.LinkUpdateToLinkUpdateDTO(update))
+}
 
-	linkUpdate := botdto.LinkUpdate{
-		Description: &description,
-		Id:          &link.ID,
-		TgChatIds:   &tgID,
-		Url:         &link.URL,
-	}
+func (c *BotHTTPClient) PostDigest(ctx context.Context, digest *domain.Digest) error {
+	return c.post(ctx, "/digests", dto.DigestToDigestDTO(digest))
+}
+
+func (c *BotHTTPClient) post(ctx context.Context, path string, body any) error {
+	endpoint := c.botBaseURL.JoinPath(path)
 
-	payload, err := json.Marshal(linkUpdate)
+	payload, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package clients_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -12,21 +13,33 @@ import (
 
 	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/clients"
+	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
+	"LinkTracker/internal/infrastructure/serviceauth"
 )
 
 func Test_BotHTTPClient_PostUpdates_Success(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
 		assert.Equal(t, "/updates", r.URL.Path)
+
+		var linkUpdate botdto.LinkUpdate
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&linkUpdate))
+		assert.Equal(t, "description", *linkUpdate.Description)
+		assert.Equal(t, "Issue title", *linkUpdate.Details.Title)
+		assert.Equal(t, createdAt, *linkUpdate.Details.CreatedAt)
+
 		w.WriteHeader(http.StatusOK)
 	}))
 	defer server.Close()
 
-	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	assert.NoError(t, err)
 
-	link := domain.Link{ID: 1, URL: "https://example.com"}
-	err = client.PostUpdates(context.Background(), &link, []int64{123456}, "description")
+	update := domain.LinkUpdate{Link: domain.Link{ID: 1, URL: "https://example.com"}, TgIDs: []int64{123456},
+		Description: "description", Details: domain.UpdateDetails{Title: "Issue title", CreatedAt: createdAt}}
+	err = client.PostUpdates(context.Background(), &update)
 	assert.NoError(t, err)
 }
 
@@ -38,11 +51,12 @@ func Test_BotHTTPClient_PostUpdates_BadRequest(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	assert.NoError(t, err)
 
-	link := domain.Link{ID: 1, URL: "https://example.com"}
-	err = client.PostUpdates(context.Background(), &link, []int64{123456}, "description")
+	update := domain.LinkUpdate{Link: domain.Link{ID: 1, URL: "https://example.com"}, TgIDs: []int64{123456},
+		Description: "description"}
+	err = client.PostUpdates(context.Background(), &update)
 	assert.Error(t, err)
 
 	var apiErr domain.ErrAPI
@@ -59,11 +73,12 @@ func Test_BotHTTPClient_PostUpdates_UnexpectedStatusCode(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	assert.NoError(t, err)
 
-	link := domain.Link{ID: 1, URL: "https://example.com"}
-	err = client.PostUpdates(context.Background(), &link, []int64{123456}, "description")
+	update := domain.LinkUpdate{Link: domain.Link{ID: 1, URL: "https://example.com"}, TgIDs: []int64{123456},
+		Description: "description"}
+	err = client.PostUpdates(context.Background(), &update)
 	assert.Error(t, err)
 
 	var errUnexpectedStatusCode domain.ErrUnexpectedStatusCode
@@ -72,3 +87,57 @@ func Test_BotHTTPClient_PostUpdates_UnexpectedStatusCode(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, http.StatusInternalServerError, errUnexpectedStatusCode.StatusCode)
 }
+
+func Test_BotHTTPClient_PostDigest_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/digests", r.URL.Path)
+
+		var digest botdto.Digest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&digest))
+		assert.Equal(t, int64(123456), digest.TgChatId)
+		assert.Equal(t, botdto.Hourly, *digest.Mode)
+		assert.Len(t, digest.Updates, 2)
+		assert.Equal(t, "second", *digest.Updates[1].Description)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	assert.NoError(t, err)
+
+	digest := domain.Digest{TgID: 123456, Mode: domain.DeliveryHourly, Updates: []domain.LinkUpdate{
+		{Link: domain.Link{ID: 1, URL: "https://example.com"}, Description: "first"},
+		{Link: domain.Link{ID: 1, URL: "https://example.com"}, Description: "second"},
+	}}
+	err = client.PostDigest(context.Background(), &digest)
+	assert.NoError(t, err)
+}
+
+func Test_BotHTTPClient_Ping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/healthz", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.Ping(context.Background()))
+}
+
+func Test_BotHTTPClient_Ping_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewBotHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	assert.NoError(t, err)
+
+	err = client.Ping(context.Background())
+	assert.ErrorAs(t, err, &domain.ErrUnexpectedStatusCode{})
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/tracing"
 
 	"golang.org/x/time/rate"
 )
@@ -30,59 +31,188 @@ type GitHubHTTPClient struct {
 // NewGitHubHTTPClient создаёт нового клиента с заданным timeout.
 func NewGitHubHTTPClient() *GitHubHTTPClient {
 	return &GitHubHTTPClient{Client: &http.Client{
-		Timeout: githubHTTPTimeout},
+		Timeout:   githubHTTPTimeout,
+		Transport: tracing.Transport(http.DefaultTransport)},
 		globalLimiter: rate.NewLimiter(rate.Every(time.Hour/allowedRequestsPerHour), allowedRequestsPerHour)}
 }
 
 func (c *GitHubHTTPClient) Supports(link *url.URL) bool {
-	return link.Host == "github.com"
+	return domain.CanonicalHost(link.Host) == domain.GitHubHost
 }
 
-func (c *GitHubHTTPClient) Check(ctx context.Context, link *domain.Link) (lastUpdate time.Time, description string, err error) {
-	err = c.globalLimiter.Wait(ctx)
+// Check запрашивает метаданные репозитория и последний PR или Issue. Ошибка получения метаданных
+// не мешает проверке обновлений: метаданные в этом случае остаются пустыми. Удалённый репозиторий
+// возвращает ErrSourceDeleted, а переименованный или перенесённый — новый адрес в MovedTo.
+func (c *GitHubHTTPClient) Check(ctx context.Context, link *domain.Link) (domain.CheckResult, error) {
+	err := waitLimiter(ctx, c.globalLimiter, "github")
 	if err != nil {
 		slog.Error("Rate limit error", "error", err.Error())
-		return time.Time{}, "", err
+		return domain.CheckResult{}, err
 	}
 
-	return c.GetLatestPROrIssue(ctx, link.URL)
+	var result domain.CheckResult
+
+	result.Metadata, err = c.GetRepoMetadata(ctx, link.URL)
+	if err != nil {
+		slog.Error("Get repository metadata failed", "error", err.Error(), "link", link.URL)
+	}
+
+	if result.Metadata.Status == domain.SourceDeleted {
+		return result, domain.ErrSourceDeleted{}
+	}
+
+	checkURL := link.URL
+
+	result.MovedTo = movedRepoURL(link.URL, result.Metadata.Title)
+	if result.MovedTo != "" {
+		checkURL = result.MovedTo
+	}
+
+	err = waitLimiter(ctx, c.globalLimiter, "github")
+	if err != nil {
+		slog.Error("Rate limit error", "error", err.Error())
+		return result, err
+	}
+
+	result.LastUpdate, result.Details, err = c.GetLatestPROrIssue(ctx, checkURL)
+
+	return result, err
+}
+
+// Probe проверяет, что репозиторий существует. Для ненайденного репозитория возвращается ErrSourceNotFound.
+func (c *GitHubHTTPClient) Probe(ctx context.Context, link *domain.Link) error {
+	err := waitLimiter(ctx, c.globalLimiter, "github")
+	if err != nil {
+		return err
+	}
+
+	metadata, err := c.GetRepoMetadata(ctx, link.URL)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Status == domain.SourceDeleted {
+		return domain.ErrSourceNotFound{}
+	}
+
+	return nil
+}
+
+// movedRepoURL возвращает адрес репозитория с полным именем fullName, если он отличается от адреса
+// ссылки. На запрос по старому имени API отвечает редиректом 301, HTTP-клиент следует ему,
+// и в ответе приходит уже новое имя.
+func movedRepoURL(link, fullName string) string {
+	if fullName == "" {
+		return ""
+	}
+
+	movedURL, err := domain.CanonicalURL("https://" + domain.GitHubHost + "/" + fullName)
+	if err != nil {
+		return ""
+	}
+
+	if canonicalURL, err := domain.CanonicalURL(link); err == nil && canonicalURL == movedURL {
+		return ""
+	}
+
+	return movedURL
 }
 
 // Ожидается формат: github.com/{owner}/{repo}.
 func apiGitURLGeneration(link string) (string, error) {
-	parsedURL, err := url.Parse(link)
+	canonicalURL, err := domain.CanonicalURL(link)
 	if err != nil {
 		return "", err
 	}
 
-	parts := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
-	if len(parts) < 2 {
+	ownerAndRepo, ok := strings.CutPrefix(canonicalURL, "https://"+domain.GitHubHost+"/")
+	if !ok {
 		return "", fmt.Errorf("wrong url format, expected github.com/{owner}/{repo}")
 	}
 
-	owner, repo := parts[0], parts[1]
-	apiURL := fmt.Sprintf("%s/repos/%s/%s", githubAPIBaseURL, owner, repo)
-
-	return apiURL, nil
+	return fmt.Sprintf("%s/repos/%s", githubAPIBaseURL, ownerAndRepo), nil
 }
 
 // GitHubIssue представляет Issue или Pull Request из GitHub API.
 type GitHubIssue struct {
-	Title string `json:"title"`
-	User  struct {
-		Login string `json:"login"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+	User    struct {
+		Login   string `json:"login"`
+		HTMLURL string `json:"html_url"`
 	} `json:"user"`
-	Body      string `json:"body"`
-	CreatedAt string `json:"created_at"`
+	Body        string    `json:"body"`
+	CreatedAt   string    `json:"created_at"`
+	PullRequest *struct{} `json:"pull_request"`
+}
+
+// GitHubRepo представляет репозиторий из GitHub API.
+type GitHubRepo struct {
+	FullName        string `json:"full_name"`
+	Description     string `json:"description"`
+	StargazersCount int64  `json:"stargazers_count"`
+	Archived        bool   `json:"archived"`
 }
 
-// GetLatestPROrIssue возвращает сообщение с данными о последнем PR или Issue:
-// название, имя пользователя, время создания и превью описания (200 символов).
+// GetRepoMetadata возвращает полное имя, описание, число звёзд и статус репозитория.
+// Если репозиторий не найден, возвращаются метаданные со статусом SourceDeleted.
+func (c *GitHubHTTPClient) GetRepoMetadata(ctx context.Context, link string) (domain.LinkMetadata, error) {
+	apiURL, err := apiGitURLGeneration(link)
+	if err != nil {
+		return domain.LinkMetadata{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, "GET", apiURL, http.NoBody)
+	if err != nil {
+		return domain.LinkMetadata{}, err
+	}
+
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return domain.LinkMetadata{}, err
+	}
+
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			slog.Error("could not close resource", "error", cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusGone:
+		return domain.LinkMetadata{Status: domain.SourceDeleted}, nil
+	default:
+		return domain.LinkMetadata{}, domain.ErrStatusNotOK{StatusCode: response.StatusCode}
+	}
+
+	var repo GitHubRepo
+	if err := json.NewDecoder(response.Body).Decode(&repo); err != nil {
+		return domain.LinkMetadata{}, err
+	}
+
+	metadata := domain.LinkMetadata{
+		Title:       repo.FullName,
+		Description: repo.Description,
+		Stars:       repo.StargazersCount,
+		Status:      domain.SourceActive,
+	}
+
+	if repo.Archived {
+		metadata.Status = domain.SourceArchived
+	}
+
+	return metadata, nil
+}
+
+// GetLatestPROrIssue возвращает данные о последнем PR или Issue:
+// название, автора, время создания и превью описания (200 символов без разметки).
 // Пример ссылки: "https://github.com/TimofeyMosk/fractalFlame-image-creator"
-func (c *GitHubHTTPClient) GetLatestPROrIssue(ctx context.Context, link string) (lastUpdate time.Time, description string, err error) {
+func (c *GitHubHTTPClient) GetLatestPROrIssue(ctx context.Context, link string) (
+	lastUpdate time.Time, details domain.UpdateDetails, err error) {
 	apiURL, err := apiGitURLGeneration(link)
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
 	// Формируем URL для получения списка issues, сортируем по дате создания (от новых к старым), выбираем только один элемент.
@@ -90,12 +220,12 @@ func (c *GitHubHTTPClient) GetLatestPROrIssue(ctx context.Context, link string)
 
 	request, err := http.NewRequestWithContext(ctx, "GET", issuesURL, http.NoBody)
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
 	response, err := c.Client.Do(request)
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
 	defer func() {
@@ -106,37 +236,36 @@ func (c *GitHubHTTPClient) GetLatestPROrIssue(ctx context.Context, link string)
 
 	var issues []GitHubIssue
 	if err := json.NewDecoder(response.Body).Decode(&issues); err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
 	if len(issues) == 0 {
-		return time.Time{}, "", domain.ErrUpdatesNotFound{}
+		return time.Time{}, domain.UpdateDetails{}, domain.ErrUpdatesNotFound{}
 	}
 
 	issue := issues[0]
 
 	lastUpdate, err = time.Parse(time.RFC3339, issue.CreatedAt)
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
-	description = createDescription(issue)
-
-	return lastUpdate, description, nil
+	return lastUpdate, createDetails(&issue, lastUpdate), nil
 }
 
-func createDescription(issue GitHubIssue) string {
-	preview := issue.Body
-	if len(preview) > 200 {
-		preview = preview[:200]
+func createDetails(issue *GitHubIssue, createdAt time.Time) domain.UpdateDetails {
+	kind := "Issue"
+	if issue.PullRequest != nil {
+		kind = "Pull request"
 	}
 
-	description := fmt.Sprintf("Title: %s\nUser: %s\nCreated At: %s\nPreview: %s",
-		issue.Title,
-		issue.User.Login,
-		issue.CreatedAt,
-		preview,
-	)
-
-	return description
+	return domain.UpdateDetails{
+		Kind:      kind,
+		Title:     issue.Title,
+		URL:       issue.HTMLURL,
+		Author:    issue.User.Login,
+		AuthorURL: issue.User.HTMLURL,
+		CreatedAt: createdAt,
+		Preview:   previewText(issue.Body, previewLength),
+	}
 }
@@ -3,6 +3,7 @@ package clients_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/clients"
 )
 
@@ -62,7 +64,7 @@ func TestGitHubHTTPClient_GetLatestPROrIssue(t *testing.T) {
 				}
 			]`,
 			expectError:  false,
-			expectedDesc: "Title: Test Issue\nUser: testuser\nCreated At: 2020-01-01T12:00:00Z\nPreview: This is a test issue body",
+			expectedDesc: "Issue: Test Issue\nUser: testuser\nCreated At: 2020-01-01T12:00:00Z\nPreview: This is a test issue body",
 			expectedTime: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
 		},
 		{
@@ -102,7 +104,7 @@ func TestGitHubHTTPClient_GetLatestPROrIssue(t *testing.T) {
 			})
 
 			client := newTestGitHubHTTPClient(testServerURL, 5*time.Second, rt)
-			lastUpdate, description, err := client.GetLatestPROrIssue(context.Background(), "https://github.com/owner/repo")
+			lastUpdate, details, err := client.GetLatestPROrIssue(context.Background(), "https://github.com/owner/repo")
 
 			if tc.expectError {
 				assert.Error(t, err, "expected an error but got none")
@@ -111,7 +113,115 @@ func TestGitHubHTTPClient_GetLatestPROrIssue(t *testing.T) {
 
 			assert.NoError(t, err, "unexpected error occurred")
 			assert.Equal(t, tc.expectedTime, lastUpdate, "unexpected timestamp")
-			assert.Equal(t, tc.expectedDesc, description, "unexpected description")
+			assert.Equal(t, tc.expectedDesc, details.PlainText(), "unexpected description")
+		})
+	}
+}
+
+func TestGitHubHTTPClient_Check_Metadata(t *testing.T) {
+	rt := roundTripGitFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"full_name": "owner/repo", "description": "Test repo", "stargazers_count": 42, "archived": true}`
+		if strings.HasSuffix(req.URL.Path, "/issues") {
+			body = `[{"title": "Test Issue", "user": {"login": "testuser"}, "created_at": "2020-01-01T12:00:00Z"}]`
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client := newTestGitHubHTTPClient("http://example.com", 5*time.Second, rt)
+	result, err := client.Check(context.Background(), &domain.Link{URL: "https://github.com/owner/repo"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), result.LastUpdate)
+	assert.Equal(t, "Test Issue", result.Details.Title)
+	assert.Equal(t, domain.LinkMetadata{Title: "owner/repo", Description: "Test repo", Stars: 42,
+		Status: domain.SourceArchived}, result.Metadata)
+}
+
+func TestGitHubHTTPClient_Check_DeletedRepo(t *testing.T) {
+	rt := roundTripGitFunc(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/issues") {
+			return nil, fmt.Errorf("unexpected request: %s", req.URL.Path)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"message": "Not Found"}`)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client := newTestGitHubHTTPClient("http://example.com", 5*time.Second, rt)
+	result, err := client.Check(context.Background(), &domain.Link{URL: "https://github.com/owner/repo"})
+
+	assert.ErrorIs(t, err, domain.ErrSourceDeleted{})
+	assert.Equal(t, domain.SourceDeleted, result.Metadata.Status)
+}
+
+func TestGitHubHTTPClient_Check_RenamedRepo(t *testing.T) {
+	var issuesPath string
+
+	rt := roundTripGitFunc(func(req *http.Request) (*http.Response, error) {
+		response := &http.Response{StatusCode: 200, Header: make(http.Header)}
+
+		switch req.URL.Path {
+		case "/repos/old-owner/old-repo":
+			response.StatusCode = http.StatusMovedPermanently
+			response.Header.Set("Location", "https://api.github.com/repositories/42")
+			response.Body = io.NopCloser(bytes.NewBufferString(`{"message": "Moved Permanently"}`))
+		case "/repositories/42":
+			response.Body = io.NopCloser(bytes.NewBufferString(`{"full_name": "new-owner/new-repo"}`))
+		default:
+			issuesPath = req.URL.Path
+			response.Body = io.NopCloser(bytes.NewBufferString(
+				`[{"title": "Test Issue", "user": {"login": "testuser"}, "created_at": "2020-01-01T12:00:00Z"}]`))
+		}
+
+		return response, nil
+	})
+
+	client := newTestGitHubHTTPClient("http://example.com", 5*time.Second, rt)
+	result, err := client.Check(context.Background(), &domain.Link{URL: "https://github.com/old-owner/old-repo"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/new-owner/new-repo", result.MovedTo)
+	assert.Equal(t, "new-owner/new-repo", result.Metadata.Title)
+	assert.Equal(t, "/repos/new-owner/new-repo/issues", issuesPath)
+}
+
+func TestGitHubHTTPClient_Probe(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		expectedErr error
+	}{
+		{name: "Repository exists", statusCode: http.StatusOK},
+		{name: "Repository not found", statusCode: http.StatusNotFound, expectedErr: domain.ErrSourceNotFound{}},
+		{name: "API error", statusCode: http.StatusForbidden, expectedErr: domain.ErrStatusNotOK{StatusCode: http.StatusForbidden}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := roundTripGitFunc(func(_ *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: tt.statusCode,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"full_name": "owner/repo"}`)),
+					Header:     make(http.Header),
+				}, nil
+			})
+
+			client := newTestGitHubHTTPClient("http://example.com", 5*time.Second, rt)
+			err := client.Probe(context.Background(), &domain.Link{URL: "https://github.com/owner/repo"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
 		})
 	}
 }
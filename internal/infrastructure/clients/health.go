@@ -0,0 +1,59 @@
+package clients
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"LinkTracker/internal/domain"
+)
+
+// Ping проверяет, что bot запущен и отвечает на /healthz.
+func (c *BotHTTPClient) Ping(ctx context.Context) error {
+	return ping(ctx, c.client, c.botBaseURL)
+}
+
+// Ping проверяет, что scrapper запущен и отвечает на /healthz.
+func (c *ScrapperHTTPClient) Ping(ctx context.Context) error {
+	return ping(ctx, c.client, c.scrapperBaseURL)
+}
+
+// Ping проверяет доступность Telegram Bot API и токена бота запросом getMe.
+func (t *TelegramHTTPClient) Ping(ctx context.Context) error {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		return err
+	}
+
+	return t.call(ctx, "getMe", func() error {
+		_, err := t.tgBotAPI.GetMe()
+		return err
+	})
+}
+
+// ping запрашивает liveness соседнего сервиса, а не его готовность, чтобы bot и scrapper
+// не объявляли друг друга неготовыми по кругу.
+func ping(ctx context.Context, client *http.Client, baseURL *url.URL) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL.JoinPath("/healthz").String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+
+	return nil
+}
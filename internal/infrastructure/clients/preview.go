@@ -0,0 +1,31 @@
+package clients
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+const previewLength = 200
+
+var (
+	htmlCommentRegexp  = regexp.MustCompile(`(?s)<!--.*?-->`)
+	htmlBlockTagRegexp = regexp.MustCompile(`(?i)</?(p|br|div|li|ul|ol|pre|blockquote|h[1-6]|tr|td|hr)\b[^>]*>`)
+	htmlTagRegexp      = regexp.MustCompile(`<[^>]*>`)
+)
+
+// previewText убирает из текста HTML-разметку, схлопывает пробельные символы и обрезает
+// результат до limit символов, не разрывая многобайтовые символы.
+func previewText(body string, limit int) string {
+	text := htmlCommentRegexp.ReplaceAllString(body, "")
+	text = htmlBlockTagRegexp.ReplaceAllString(text, " ")
+	text = htmlTagRegexp.ReplaceAllString(text, "")
+	text = strings.Join(strings.Fields(html.UnescapeString(text)), " ")
+
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
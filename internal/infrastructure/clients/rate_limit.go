@@ -0,0 +1,19 @@
+package clients
+
+import (
+	"context"
+	"time"
+
+	"LinkTracker/internal/metrics"
+
+	"golang.org/x/time/rate"
+)
+
+// waitLimiter ждёт разрешения от limiter и учитывает время ожидания в метриках клиента client.
+func waitLimiter(ctx context.Context, limiter *rate.Limiter, client string) error {
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	metrics.ObserveRateLimiterWait(client, time.Since(start))
+
+	return err
+}
@@ -8,11 +8,14 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/dto"
 	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/serviceauth"
+	"LinkTracker/internal/tracing"
 )
 
 type ScrapperHTTPClient struct {
@@ -20,14 +23,15 @@ type ScrapperHTTPClient struct {
 	scrapperBaseURL *url.URL
 }
 
-func NewScrapperHTTPClient(scrapperBaseURL string, timeout time.Duration) (*ScrapperHTTPClient, error) {
+func NewScrapperHTTPClient(scrapperBaseURL string, timeout time.Duration,
+	credentials serviceauth.Credentials) (*ScrapperHTTPClient, error) {
 	parsedURL, err := url.Parse(scrapperBaseURL)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ScrapperHTTPClient{
-		client:          &http.Client{Timeout: timeout},
+		client:          &http.Client{Timeout: timeout, Transport: tracing.Transport(credentials.Transport())},
 		scrapperBaseURL: parsedURL}, nil
 }
 
@@ -91,6 +95,45 @@ func (c *ScrapperHTTPClient) DeleteUser(ctx context.Context, tgID int64) error {
 	}
 }
 
+// IssueToken просит scrapper выпустить чату новый персональный токен публичного API.
+func (c *ScrapperHTTPClient) IssueToken(ctx context.Context, tgID int64) (string, error) {
+	endpoint := c.scrapperBaseURL.JoinPath(fmt.Sprintf("/tg-chat/%d/token", tgID))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var tokenResponse scrapperdto.ApiTokenResponse
+		if err := json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+			return "", err
+		}
+
+		if tokenResponse.Token == nil || *tokenResponse.Token == "" {
+			return "", domain.ErrNoRequiredAttribute{Attribute: "token"}
+		}
+
+		return *tokenResponse.Token, nil
+	case http.StatusBadRequest:
+		return "", HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return "", domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
 func (c *ScrapperHTTPClient) GetLinks(ctx context.Context, tgID int64) ([]domain.Link, error) {
 	endpoint := c.scrapperBaseURL.JoinPath("/links")
 
@@ -119,21 +162,7 @@ func (c *ScrapperHTTPClient) GetLinks(ctx context.Context, tgID int64) ([]domain
 			return nil, err
 		}
 
-		if listLinksResponse.Links == nil || listLinksResponse.Size == nil {
-			return []domain.Link{}, nil
-		}
-
-		listLinks := make([]domain.Link, 0, len(*listLinksResponse.Links))
-		for _, link := range *listLinksResponse.Links {
-			listLinks = append(listLinks, domain.Link{
-				URL:     *link.Url,
-				Tags:    *link.Tags,
-				Filters: *link.Filters,
-				ID:      *link.Id,
-			})
-		}
-
-		return listLinks, nil
+		return dto.ListLinksResponseDTOToLinks(listLinksResponse), nil
 	case http.StatusBadRequest:
 		return nil, HandleAPIErrorResponseFromScrapper(response)
 	default:
@@ -187,6 +216,47 @@ func (c *ScrapperHTTPClient) AddLink(ctx context.Context, tgID int64, link *doma
 	}
 }
 
+func (c *ScrapperHTTPClient) AddLinks(ctx context.Context, tgID int64, links []domain.Link) ([]domain.LinkImportResult, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/links/batch")
+
+	payload, err := json.Marshal(dto.LinksToBatchLinksRequestDTO(links))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var batchResponse scrapperdto.BatchLinksResponse
+		if err := json.NewDecoder(response.Body).Decode(&batchResponse); err != nil {
+			return nil, err
+		}
+
+		return dto.BatchLinksResponseDTOToLinkImportResults(batchResponse), nil
+	case http.StatusBadRequest:
+		return nil, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
 func (c *ScrapperHTTPClient) RemoveLink(ctx context.Context, tgID int64, link *domain.Link) error {
 	endpoint := c.scrapperBaseURL.JoinPath("/links")
 
@@ -270,10 +340,11 @@ func (c *ScrapperHTTPClient) UpdateLink(ctx context.Context, tgID int64, link *d
 	}
 }
 
-func (c *ScrapperHTTPClient) CreateState(ctx context.Context, tgID int64, state int) error {
+// CreateState начинает диалог участника userID в чате tgID.
+func (c *ScrapperHTTPClient) CreateState(ctx context.Context, tgID, userID int64, state int) error {
 	endpoint := c.scrapperBaseURL.JoinPath("/states")
 
-	payload, err := json.Marshal(scrapperdto.StateRequest{State: &state})
+	payload, err := json.Marshal(scrapperdto.StateRequest{State: &state, UserId: &userID})
 	if err != nil {
 		return err
 	}
@@ -373,19 +444,20 @@ func (c *ScrapperHTTPClient) UpdateState(ctx context.Context, tgID int64, state
 	}
 }
 
-func (c *ScrapperHTTPClient) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
+// GetState возвращает шаг диалога в чате tgID, начавшего его участника и собранные данные ссылки.
+func (c *ScrapperHTTPClient) GetState(ctx context.Context, tgID int64) (int, int64, domain.Link, error) {
 	endpoint := c.scrapperBaseURL.JoinPath("/states")
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
 	if err != nil {
-		return -1, domain.Link{}, err
+		return -1, 0, domain.Link{}, err
 	}
 
 	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
 
 	response, err := c.client.Do(request)
 	if err != nil {
-		return -1, domain.Link{}, err
+		return -1, 0, domain.Link{}, err
 	}
 
 	defer func() {
@@ -398,7 +470,7 @@ func (c *ScrapperHTTPClient) GetState(ctx context.Context, tgID int64) (int, dom
 	case http.StatusOK:
 		var responseData scrapperdto.StateResponse
 		if err := json.NewDecoder(response.Body).Decode(&responseData); err != nil {
-			return -1, domain.Link{}, err
+			return -1, 0, domain.Link{}, err
 		}
 
 		if responseData.Link == nil {
@@ -413,13 +485,414 @@ func (c *ScrapperHTTPClient) GetState(ctx context.Context, tgID int64) (int, dom
 			*responseData.Filters = []string{}
 		}
 
+		var userID int64
+		if responseData.UserId != nil {
+			userID = *responseData.UserId
+		}
+
 		responseLink := domain.Link{URL: *responseData.Link, Tags: *responseData.Tags, Filters: *responseData.Filters}
 
-		return *responseData.State, responseLink, nil
+		return *responseData.State, userID, responseLink, nil
 	case http.StatusInternalServerError:
-		return -1, domain.Link{}, HandleAPIErrorResponseFromScrapper(response)
+		return -1, 0, domain.Link{}, HandleAPIErrorResponseFromScrapper(response)
 	default:
-		return -1, domain.Link{}, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+		return -1, 0, domain.Link{}, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/settings")
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	return c.doSettingsRequest(request)
+}
+
+func (c *ScrapperHTTPClient) UpdateSettings(ctx context.Context, tgID int64,
+	update *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/settings")
+
+	payload, err := json.Marshal(dto.DeliverySettingsUpdateToSettingsRequestDTO(update))
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint.String(), bytes.NewReader(payload))
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	return c.doSettingsRequest(request)
+}
+
+func (c *ScrapperHTTPClient) doSettingsRequest(request *http.Request) (domain.DeliverySettings, error) {
+	response, err := c.client.Do(request)
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var responseData scrapperdto.SettingsResponse
+		if err := json.NewDecoder(response.Body).Decode(&responseData); err != nil {
+			return domain.DeliverySettings{}, err
+		}
+
+		return dto.SettingsResponseDTOToDeliverySettings(responseData)
+	case http.StatusBadRequest, http.StatusInternalServerError:
+		return domain.DeliverySettings{}, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return domain.DeliverySettings{}, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+// SetTagMode задаёт режим доставки для тега. Пустой режим сбрасывает настройку тега.
+func (c *ScrapperHTTPClient) SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error {
+	endpoint := c.scrapperBaseURL.JoinPath("/settings/tags")
+
+	tagModeRequest := scrapperdto.TagModeRequest{Tag: &tag}
+	if mode != "" {
+		modeValue := string(mode)
+		tagModeRequest.Mode = &modeValue
+	}
+
+	payload, err := json.Marshal(tagModeRequest)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest, http.StatusInternalServerError:
+		return HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+// PauseLinks приостанавливает уведомления по выбранным ссылкам до until, нулевое until — до ResumeLinks.
+func (c *ScrapperHTTPClient) PauseLinks(ctx context.Context, tgID int64, selector domain.LinkSelector,
+	until time.Time) ([]domain.Link, error) {
+	return c.doPauseRequest(ctx, tgID, "/links/pause", dto.PauseToPauseRequestDTO(selector, until))
+}
+
+// ResumeLinks возобновляет уведомления по выбранным ссылкам.
+func (c *ScrapperHTTPClient) ResumeLinks(ctx context.Context, tgID int64, selector domain.LinkSelector) ([]domain.Link, error) {
+	return c.doPauseRequest(ctx, tgID, "/links/resume", dto.LinkSelectorToResumeRequestDTO(selector))
+}
+
+func (c *ScrapperHTTPClient) doPauseRequest(ctx context.Context, tgID int64, path string, body any) ([]domain.Link, error) {
+	endpoint := c.scrapperBaseURL.JoinPath(path)
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var listLinksResponse scrapperdto.ListLinksResponse
+		if err := json.NewDecoder(response.Body).Decode(&listLinksResponse); err != nil {
+			return nil, err
+		}
+
+		return dto.ListLinksResponseDTOToLinks(listLinksResponse), nil
+	case http.StatusNotFound:
+		return nil, domain.ErrLinkNotExist{}
+	case http.StatusBadRequest:
+		return nil, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) GetLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/links")
+	endpoint.RawQuery = url.Values{"tag": {tag}}.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var listLinksResponse scrapperdto.ListLinksResponse
+		if err := json.NewDecoder(response.Body).Decode(&listLinksResponse); err != nil {
+			return nil, err
+		}
+
+		return dto.ListLinksResponseDTOToLinks(listLinksResponse), nil
+	case http.StatusBadRequest:
+		return nil, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) GetLinkUpdates(ctx context.Context, tgID, linkID, limit, offset int64) (
+	[]domain.UpdateRecord, int64, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/links", strconv.FormatInt(linkID, 10), "updates")
+	endpoint.RawQuery = url.Values{
+		"limit":  {strconv.FormatInt(limit, 10)},
+		"offset": {strconv.FormatInt(offset, 10)},
+	}.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var listUpdatesResponse scrapperdto.ListUpdatesResponse
+		if err := json.NewDecoder(response.Body).Decode(&listUpdatesResponse); err != nil {
+			return nil, 0, err
+		}
+
+		records, total := dto.ListUpdatesResponseDTOToUpdateRecords(listUpdatesResponse)
+
+		return records, total, nil
+	case http.StatusNotFound:
+		return nil, 0, domain.ErrLinkNotExist{}
+	case http.StatusBadRequest:
+		return nil, 0, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, 0, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) SearchLinks(ctx context.Context, tgID int64, query string, limit int64) ([]domain.SearchResult, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/search")
+	endpoint.RawQuery = url.Values{"q": {query}, "limit": {strconv.FormatInt(limit, 10)}}.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var searchResponse scrapperdto.SearchResponse
+		if err := json.NewDecoder(response.Body).Decode(&searchResponse); err != nil {
+			return nil, err
+		}
+
+		return dto.SearchResponseDTOToSearchResults(searchResponse), nil
+	case http.StatusBadRequest:
+		return nil, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) GetTags(ctx context.Context, tgID int64) ([]domain.TagCount, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/tags")
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var listTagsResponse scrapperdto.ListTagsResponse
+		if err := json.NewDecoder(response.Body).Decode(&listTagsResponse); err != nil {
+			return nil, err
+		}
+
+		return dto.ListTagsResponseDTOToTagCounts(listTagsResponse), nil
+	case http.StatusBadRequest:
+		return nil, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) RenameTag(ctx context.Context, tgID int64, oldTag, newTag string) (int64, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/tags", oldTag)
+
+	payload, err := json.Marshal(scrapperdto.RenameTagRequest{Name: &newTag})
+	if err != nil {
+		return 0, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint.String(), bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var tagResponse scrapperdto.TagResponse
+		if err := json.NewDecoder(response.Body).Decode(&tagResponse); err != nil {
+			return 0, err
+		}
+
+		if tagResponse.Links == nil {
+			return 0, nil
+		}
+
+		return *tagResponse.Links, nil
+	case http.StatusNotFound:
+		return 0, domain.ErrTagNotExist{Tag: oldTag}
+	case http.StatusBadRequest:
+		return 0, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return 0, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+}
+
+func (c *ScrapperHTTPClient) RemoveLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	endpoint := c.scrapperBaseURL.JoinPath("/tags", tag, "links")
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Tg-Chat-Id", fmt.Sprint(tgID))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var listLinksResponse scrapperdto.ListLinksResponse
+		if err := json.NewDecoder(response.Body).Decode(&listLinksResponse); err != nil {
+			return nil, err
+		}
+
+		return dto.ListLinksResponseDTOToLinks(listLinksResponse), nil
+	case http.StatusNotFound:
+		return nil, domain.ErrTagNotExist{Tag: tag}
+	case http.StatusBadRequest:
+		return nil, HandleAPIErrorResponseFromScrapper(response)
+	default:
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
 	}
 }
 
@@ -13,7 +13,9 @@ import (
 
 	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/clients"
+	"LinkTracker/internal/infrastructure/dto"
 	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/serviceauth"
 )
 
 func ptrString(s string) *string {
@@ -32,7 +34,7 @@ func Test_ScrapperHTTPClient_RegisterUser_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	err = client.RegisterUser(context.Background(), 12345)
@@ -55,7 +57,7 @@ func Test_ScrapperHTTPClient_RegisterUser_BadRequest(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	err = client.RegisterUser(context.Background(), 12345)
@@ -74,7 +76,7 @@ func Test_ScrapperHTTPClient_RegisterUser_UnexpectedStatus(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	err = client.RegisterUser(context.Background(), 12345)
@@ -94,7 +96,7 @@ func Test_ScrapperHTTPClient_DeleteUser_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	err = client.DeleteUser(context.Background(), 12345)
@@ -114,7 +116,7 @@ func Test_ScrapperHTTPClient_DeleteUser_NotFound(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	err = client.DeleteUser(context.Background(), 12345)
@@ -133,7 +135,7 @@ func Test_ScrapperHTTPClient_DeleteUser_UnexpectedStatus(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	err = client.DeleteUser(context.Background(), 12345)
@@ -145,6 +147,40 @@ func Test_ScrapperHTTPClient_DeleteUser_UnexpectedStatus(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, unexpected.StatusCode)
 }
 
+func Test_ScrapperHTTPClient_IssueToken_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tg-chat/12345/token", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(scrapperdto.ApiTokenResponse{Token: ptrString("lt_token")}))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	token, err := client.IssueToken(context.Background(), 12345)
+
+	require.NoError(t, err)
+	assert.Equal(t, "lt_token", token)
+}
+
+func Test_ScrapperHTTPClient_IssueToken_EmptyToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(scrapperdto.ApiTokenResponse{}))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	_, err = client.IssueToken(context.Background(), 12345)
+
+	assert.ErrorAs(t, err, &domain.ErrNoRequiredAttribute{})
+}
+
 func Test_ScrapperHTTPClient_GetLinks_Success(t *testing.T) {
 	linkResponse := scrapperdto.LinkResponse{
 		Url:     ptrString("https://example.com"),
@@ -167,7 +203,7 @@ func Test_ScrapperHTTPClient_GetLinks_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	result, err := client.GetLinks(context.Background(), 12345)
@@ -195,7 +231,7 @@ func Test_ScrapperHTTPClient_GetLinks_BadRequest(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	result, err := client.GetLinks(context.Background(), 12345)
@@ -217,7 +253,7 @@ func Test_ScrapperHTTPClient_GetLinks_UnexpectedStatus(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	result, err := client.GetLinks(context.Background(), 12345)
@@ -248,7 +284,7 @@ func Test_ScrapperHTTPClient_AddLink_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	testLink := domain.Link{
@@ -275,7 +311,7 @@ func Test_ScrapperHTTPClient_AddLink_BadRequest(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	testLink := domain.Link{
@@ -301,7 +337,7 @@ func Test_ScrapperHTTPClient_AddLink_UnexpectedStatus(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	testLink := domain.Link{
@@ -320,6 +356,68 @@ func Test_ScrapperHTTPClient_AddLink_UnexpectedStatus(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, unexpected.StatusCode)
 }
 
+func Test_ScrapperHTTPClient_AddLinks_Success(t *testing.T) {
+	batchResponse := scrapperdto.BatchLinksResponse{
+		Results: &[]scrapperdto.BatchLinkResult{
+			{Link: ptrString("https://example.com/first"), Id: ptrInt64(1), Tags: &[]string{"tag1"}, Filters: &[]string{}},
+			{Link: ptrString("https://example.com/second"), Error: ptrString("link already tracking")},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/links/batch", r.URL.Path)
+		assert.Equal(t, "12345", r.Header.Get("Tg-Chat-Id"))
+
+		var batchRequest scrapperdto.BatchLinksRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&batchRequest))
+		assert.Len(t, *batchRequest.Links, 2)
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(batchResponse)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	results, err := client.AddLinks(context.Background(), 12345, []domain.Link{
+		{URL: "https://example.com/first", Tags: []string{"tag1"}, Filters: []string{}},
+		{URL: "https://example.com/second"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.LinkImportResult{
+		{Link: domain.Link{URL: "https://example.com/first", Tags: []string{"tag1"}, Filters: []string{}, ID: 1}},
+		{Link: domain.Link{URL: "https://example.com/second"}, Error: "link already tracking"},
+	}, results)
+}
+
+func Test_ScrapperHTTPClient_AddLinks_BadRequest(t *testing.T) {
+	errorResp := scrapperdto.ApiErrorResponse{
+		Code:        ptrString("ERR_ADD_LINKS"),
+		Description: ptrString("Failed to add links"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(errorResp)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	results, err := client.AddLinks(context.Background(), 12345, []domain.Link{{URL: "https://example.com"}})
+
+	var apiErr domain.ErrAPI
+
+	require.Error(t, err)
+	assert.Nil(t, results)
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "ERR_ADD_LINKS", apiErr.Code)
+}
+
 func Test_ScrapperHTTPClient_RemoveLink_Success(t *testing.T) {
 	linkResponse := scrapperdto.LinkResponse{
 		Url:     ptrString("https://example.com"),
@@ -336,7 +434,7 @@ func Test_ScrapperHTTPClient_RemoveLink_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	testLink := domain.Link{
@@ -363,7 +461,7 @@ func Test_ScrapperHTTPClient_RemoveLink_BadRequest(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	testLink := domain.Link{
@@ -389,7 +487,7 @@ func Test_ScrapperHTTPClient_RemoveLink_UnexpectedStatus(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second)
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
 	require.NoError(t, err)
 
 	testLink := domain.Link{
@@ -407,3 +505,255 @@ func Test_ScrapperHTTPClient_RemoveLink_UnexpectedStatus(t *testing.T) {
 	assert.ErrorAs(t, err, &unexpected)
 	assert.Equal(t, http.StatusInternalServerError, unexpected.StatusCode)
 }
+
+func Test_ScrapperHTTPClient_UpdateSettings_Success(t *testing.T) {
+	settingsResponse := scrapperdto.SettingsResponse{
+		Mode:       ptrString("daily"),
+		DigestTime: ptrString("20:00"),
+		Timezone:   ptrString("Europe/Moscow"),
+		TagModes:   &[]scrapperdto.TagMode{{Tag: ptrString("work"), Mode: ptrString("immediate")}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/settings", r.URL.Path)
+		assert.Equal(t, "12345", r.Header.Get("Tg-Chat-Id"))
+
+		var settingsRequest scrapperdto.SettingsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&settingsRequest))
+		assert.Equal(t, "daily", *settingsRequest.Mode)
+		assert.Equal(t, "20:00", *settingsRequest.DigestTime)
+		assert.Nil(t, settingsRequest.Timezone)
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(settingsResponse)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	mode, digestTime := domain.DeliveryDaily, 20*60
+
+	settings, err := client.UpdateSettings(context.Background(), 12345,
+		&domain.DeliverySettingsUpdate{Mode: &mode, DigestTime: &digestTime})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeliverySettings{
+		Mode:       domain.DeliveryDaily,
+		DigestTime: 20 * 60,
+		Timezone:   "Europe/Moscow",
+		TagModes:   map[string]domain.DeliveryMode{"work": domain.DeliveryImmediate},
+	}, settings)
+}
+
+func Test_ScrapperHTTPClient_SetTagMode_Reset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/settings/tags", r.URL.Path)
+
+		var tagModeRequest scrapperdto.TagModeRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&tagModeRequest))
+		assert.Equal(t, "work", *tagModeRequest.Tag)
+		assert.Nil(t, tagModeRequest.Mode)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	err = client.SetTagMode(context.Background(), 12345, "work", "")
+
+	assert.NoError(t, err)
+}
+
+func Test_ScrapperHTTPClient_PauseLinks_Success(t *testing.T) {
+	until := time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/links/pause", r.URL.Path)
+
+		var pauseRequest scrapperdto.PauseRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&pauseRequest))
+		assert.Equal(t, "work", *pauseRequest.Tag)
+		assert.True(t, until.Equal(*pauseRequest.Until))
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(dto.LinksToListLinksResponseDTO([]domain.Link{{
+			ID: 1, URL: "https://github.com/owner/repo", Tags: []string{"work"}, Filters: []string{},
+			Paused: true, PausedUntil: until,
+		}})))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	links, err := client.PauseLinks(context.Background(), 12345, domain.LinkSelector{Tag: "work"}, until)
+
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.True(t, links[0].Paused)
+	assert.Equal(t, until, links[0].PausedUntil)
+}
+
+func Test_ScrapperHTTPClient_ResumeLinks_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	_, err = client.ResumeLinks(context.Background(), 12345, domain.LinkSelector{ID: 42})
+
+	assert.ErrorAs(t, err, &domain.ErrLinkNotExist{})
+}
+
+func Test_ScrapperHTTPClient_GetLinksByTag_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/links", r.URL.Path)
+		assert.Equal(t, "c++", r.URL.Query().Get("tag"))
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(dto.LinksToListLinksResponseDTO([]domain.Link{{
+			ID: 1, URL: "https://github.com/owner/repo", Tags: []string{"c++"}, Filters: []string{},
+		}})))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	links, err := client.GetLinksByTag(context.Background(), 12345, "c++")
+
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, []string{"c++"}, links[0].Tags)
+}
+
+func Test_ScrapperHTTPClient_GetTags_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tags", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(dto.TagCountsToListTagsResponseDTO([]domain.TagCount{
+			{Tag: "go", Links: 2}, {Tag: "work", Links: 1},
+		})))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	tags, err := client.GetTags(context.Background(), 12345)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.TagCount{{Tag: "go", Links: 2}, {Tag: "work", Links: 1}}, tags)
+}
+
+func Test_ScrapperHTTPClient_RenameTag_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/tags/work", r.URL.Path)
+
+		var renameTagRequest scrapperdto.RenameTagRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&renameTagRequest))
+		assert.Equal(t, "job", *renameTagRequest.Name)
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(dto.TagCountToTagResponseDTO(domain.TagCount{Tag: "job", Links: 3})))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	renamed, err := client.RenameTag(context.Background(), 12345, "work", "job")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), renamed)
+}
+
+func Test_ScrapperHTTPClient_RemoveLinksByTag_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/tags/work/links", r.URL.Path)
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	_, err = client.RemoveLinksByTag(context.Background(), 12345, "work")
+
+	assert.ErrorAs(t, err, &domain.ErrTagNotExist{})
+}
+
+func Test_ScrapperHTTPClient_GetLinkUpdates_Success(t *testing.T) {
+	detectedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/links/7/updates", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		assert.Equal(t, "0", r.URL.Query().Get("offset"))
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(dto.UpdateRecordsToListUpdatesResponseDTO([]domain.UpdateRecord{{
+			ID: 10, LinkID: 7, Description: "New answer", DetectedAt: detectedAt,
+			Details: domain.UpdateDetails{Kind: "Answer", Author: "user"},
+		}}, 12)))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	records, total, err := client.GetLinkUpdates(context.Background(), 12345, 7, 5, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), total)
+	require.Len(t, records, 1)
+	assert.Equal(t, "Answer", records[0].Details.Kind)
+	assert.Equal(t, detectedAt, records[0].DetectedAt)
+	assert.True(t, records[0].Details.CreatedAt.IsZero())
+}
+
+func Test_ScrapperHTTPClient_SearchLinks_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "memory leak", r.URL.Query().Get("q"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(dto.SearchResultsToSearchResponseDTO([]domain.SearchResult{
+			{
+				Link:   domain.Link{ID: 1, URL: "https://github.com/golang/go", Tags: []string{"go"}},
+				Update: &domain.UpdateRecord{ID: 3, LinkID: 1, Details: domain.UpdateDetails{Title: "Memory leak"}},
+				Rank:   0.4,
+			},
+			{Link: domain.Link{ID: 2, URL: "https://github.com/golang/tools"}, Rank: 0.1},
+		})))
+	}))
+	defer server.Close()
+
+	client, err := clients.NewScrapperHTTPClient(server.URL, 2*time.Second, serviceauth.Credentials{})
+	require.NoError(t, err)
+
+	results, err := client.SearchLinks(context.Background(), 12345, "memory leak", 10)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.NotNil(t, results[0].Update)
+	assert.Equal(t, "Memory leak", results[0].Update.Details.Title)
+	assert.Equal(t, []string{"go"}, results[0].Link.Tags)
+	assert.Nil(t, results[1].Update)
+	assert.InDelta(t, 0.1, results[1].Rank, 1e-9)
+}
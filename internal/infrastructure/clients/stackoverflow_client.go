@@ -3,7 +3,9 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"log/slog"
 	"net/http"
 	"net/url"
@@ -11,6 +13,7 @@ import (
 	"time"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/tracing"
 
 	"golang.org/x/time/rate"
 )
@@ -28,34 +31,99 @@ type StackOverflowHTTPClient struct {
 
 func NewStackOverflowHTTPClient() *StackOverflowHTTPClient {
 	return &StackOverflowHTTPClient{
-		Client:        &http.Client{Timeout: stackOverflowHTTPTimeout},
+		Client:        &http.Client{Timeout: stackOverflowHTTPTimeout, Transport: tracing.Transport(http.DefaultTransport)},
 		globalLimiter: rate.NewLimiter(rate.Every((24*time.Hour)/allowedRequestsPerDay), allowedRequestsPerDay)}
 }
 
 func (c *StackOverflowHTTPClient) Supports(link *url.URL) bool {
-	return link.Host == "stackoverflow.com"
+	return domain.CanonicalHost(link.Host) == domain.StackOverflowHost
 }
 
-func (c *StackOverflowHTTPClient) Check(ctx context.Context, link *domain.Link) (lastUpdate time.Time, description string, err error) {
-	err = c.globalLimiter.Wait(ctx)
+// Check запрашивает вопрос и его последний ответ или комментарий. Метаданные вопроса возвращаются,
+// даже если ответов и комментариев нет. Вопрос, которого больше нет в API, считается удалённым:
+// API отвечает на него пустым списком, и Check возвращает ErrSourceDeleted.
+func (c *StackOverflowHTTPClient) Check(ctx context.Context, link *domain.Link) (domain.CheckResult, error) {
+	err := waitLimiter(ctx, c.globalLimiter, "stackoverflow")
 	if err != nil {
 		slog.Error("Rate limit error", "error", err.Error())
-		return time.Time{}, "", err
+		return domain.CheckResult{}, err
 	}
 
-	return c.GetLatestAnswerOrComment(ctx, link.URL)
+	questionID, err := extractQuestionID(link.URL)
+	if err != nil {
+		return domain.CheckResult{}, err
+	}
+
+	question, err := c.getQuestionDetails(ctx, questionID)
+	if errors.As(err, &domain.ErrWrongURL{}) {
+		return domain.CheckResult{Metadata: domain.LinkMetadata{Status: domain.SourceDeleted}}, domain.ErrSourceDeleted{}
+	}
+
+	if err != nil {
+		return domain.CheckResult{}, err
+	}
+
+	result := domain.CheckResult{Metadata: questionMetadata(&question)}
+	result.LastUpdate, result.Details, err = c.latestAnswerOrComment(ctx, questionID, &question)
+
+	return result, err
+}
+
+// Probe проверяет, что вопрос существует. Для ненайденного вопроса возвращается ErrSourceNotFound.
+func (c *StackOverflowHTTPClient) Probe(ctx context.Context, link *domain.Link) error {
+	err := waitLimiter(ctx, c.globalLimiter, "stackoverflow")
+	if err != nil {
+		return err
+	}
+
+	questionID, err := extractQuestionID(link.URL)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getQuestionDetails(ctx, questionID)
+	if errors.As(err, &domain.ErrWrongURL{}) {
+		return domain.ErrSourceNotFound{}
+	}
+
+	return err
 }
 
 // SOQuestion представляет данные вопроса из StackOverflow API.
+// ClosedDate отличен от нуля, если вопрос закрыт.
 type SOQuestion struct {
-	Title string `json:"title"`
+	Title        string `json:"title"`
+	Link         string `json:"link"`
+	AnswerCount  int64  `json:"answer_count"`
+	ClosedDate   int64  `json:"closed_date"`
+	ClosedReason string `json:"closed_reason"`
+}
+
+// questionMetadata возвращает заголовок, число ответов и статус вопроса. Для закрытого вопроса
+// в описание попадает причина закрытия.
+func questionMetadata(question *SOQuestion) domain.LinkMetadata {
+	metadata := domain.LinkMetadata{
+		Title:   html.UnescapeString(question.Title),
+		Answers: question.AnswerCount,
+		Status:  domain.SourceActive,
+	}
+
+	if question.ClosedDate != 0 {
+		metadata.Status = domain.SourceClosed
+		metadata.Description = question.ClosedReason
+	}
+
+	return metadata
 }
 
 // SOPost представляет общий тип для ответа или комментария.
 type SOPost struct {
 	Owner struct {
 		DisplayName string `json:"display_name"`
+		Link        string `json:"link"`
 	} `json:"owner"`
+	AnswerID     int64  `json:"answer_id"`
+	CommentID    int64  `json:"comment_id"`
 	CreationDate int64  `json:"creation_date"`
 	Body         string `json:"body"`
 }
@@ -68,20 +136,20 @@ type SOListResponse struct {
 // extractQuestionID извлекает ID вопроса из ссылки.
 // Ожидаемый формат: stackoverflow.com/questions/{id}/...
 func extractQuestionID(link string) (string, error) {
-	parsedURL, err := url.Parse(link)
+	canonicalURL, err := domain.CanonicalURL(link)
 	if err != nil {
 		return "", err
 	}
 
-	parts := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
-	if len(parts) < 2 || parts[0] != "questions" {
+	questionID, ok := strings.CutPrefix(canonicalURL, "https://"+domain.StackOverflowHost+"/questions/")
+	if !ok {
 		return "", domain.ErrWrongURL{}
 	}
 
-	return parts[1], nil
+	return questionID, nil
 }
 
-// getQuestionDetails получает данные вопроса для получения заголовка.
+// getQuestionDetails получает заголовок, число ответов и сведения о закрытии вопроса.
 func (c *StackOverflowHTTPClient) getQuestionDetails(ctx context.Context, questionID string) (SOQuestion, error) {
 	apiURL := fmt.Sprintf("%s/questions/%s?site=stackoverflow", stackOverflowAPIBaseURL, questionID)
 
@@ -156,62 +224,76 @@ func (c *StackOverflowHTTPClient) getLatestByTag(ctx context.Context, questionID
 	return &answerResp.Items[0], nil
 }
 
-// GetLatestAnswerOrComment возвращает сообщение с данными о последнем ответе или комментарии к вопросу:
-// заголовок вопроса, имя автора, время создания и превью текста (200 символов).
+// GetLatestAnswerOrComment возвращает данные о последнем ответе или комментарии к вопросу:
+// заголовок вопроса, автора, время создания и превью текста (200 символов без HTML-разметки).
 // Пример ссылки: "https://stackoverflow.com/questions/79467368/horizontal-scroll-component-does-not-work-as-expected-with-overflow"
 func (c *StackOverflowHTTPClient) GetLatestAnswerOrComment(ctx context.Context, link string) (
-	lastUpdate time.Time, description string, err error) {
+	lastUpdate time.Time, details domain.UpdateDetails, err error) {
 	questionID, err := extractQuestionID(link)
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
 	// Получаем заголовок вопроса.
 	question, err := c.getQuestionDetails(ctx, questionID)
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
+	return c.latestAnswerOrComment(ctx, questionID, &question)
+}
+
+// latestAnswerOrComment ищет последний ответ на вопрос, а если ответов нет — последний комментарий.
+func (c *StackOverflowHTTPClient) latestAnswerOrComment(ctx context.Context, questionID string, question *SOQuestion) (
+	lastUpdate time.Time, details domain.UpdateDetails, err error) {
 	// Пытаемся получить последний ответ.
 	latestAnswer, err := c.getLatestByTag(ctx, questionID, "answers")
 	if err != nil {
-		return time.Time{}, "", err
+		return time.Time{}, domain.UpdateDetails{}, err
 	}
 
 	// Если ответов нет, пробуем получить последний комментарий.
 	var latestPost *SOPost
+
+	kind := "Answer"
+
 	if latestAnswer != nil {
 		latestPost = latestAnswer
 	} else {
+		kind = "Comment"
+
 		latestComment, err := c.getLatestByTag(ctx, questionID, "comments")
 		if err != nil {
-			return time.Time{}, "", err
+			return time.Time{}, domain.UpdateDetails{}, err
 		}
 
 		if latestComment == nil {
-			return time.Time{}, "", domain.ErrUpdatesNotFound{}
+			return time.Time{}, domain.UpdateDetails{}, domain.ErrUpdatesNotFound{}
 		}
 
 		latestPost = latestComment
 	}
 
 	lastUpdate = time.Unix(latestPost.CreationDate, 0)
-	description = createSODescription(question.Title, *latestPost)
 
-	return lastUpdate, description, nil
+	return lastUpdate, createSODetails(question, latestPost, kind), nil
 }
 
-// createSODescription формирует строку с информацией о последнем ответе или комментарии.
-func createSODescription(questionTitle string, post SOPost) string {
-	preview := post.Body
-	if len(preview) > 200 {
-		preview = preview[:200]
+// createSODetails формирует сведения о последнем ответе или комментарии.
+func createSODetails(question *SOQuestion, post *SOPost, kind string) domain.UpdateDetails {
+	details := domain.UpdateDetails{
+		Kind:      kind,
+		Title:     html.UnescapeString(question.Title),
+		URL:       question.Link,
+		Author:    html.UnescapeString(post.Owner.DisplayName),
+		AuthorURL: post.Owner.Link,
+		CreatedAt: time.Unix(post.CreationDate, 0),
+		Preview:   previewText(post.Body, previewLength),
+	}
+
+	if post.AnswerID != 0 {
+		details.URL = fmt.Sprintf("https://stackoverflow.com/a/%d", post.AnswerID)
 	}
 
-	return fmt.Sprintf("Question: %s\nUser: %s\nCreated At: %s\nPreview: %s",
-		questionTitle,
-		post.Owner.DisplayName,
-		time.Unix(post.CreationDate, 0).Format(time.RFC1123),
-		preview,
-	)
+	return details
 }
@@ -9,9 +9,11 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 
+	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/clients"
 )
 
@@ -57,7 +59,7 @@ func TestStackOverflowHTTPClient_GetLatestAnswerOrComment(t *testing.T) {
 			}`,
 			commentResponse:    `{"items": []}`,
 			expectError:        false,
-			expectedDescSubstr: "Question: Test Question",
+			expectedDescSubstr: "Answer: Test Question",
 			expectedTime:       time.Unix(1580505600, 0),
 		},
 		{
@@ -74,7 +76,7 @@ func TestStackOverflowHTTPClient_GetLatestAnswerOrComment(t *testing.T) {
 				}]
 			}`,
 			expectError:        false,
-			expectedDescSubstr: "Question: Test Question 2",
+			expectedDescSubstr: "Comment: Test Question 2",
 			expectedTime:       time.Unix(1609459200, 0),
 		},
 		{
@@ -122,7 +124,7 @@ func TestStackOverflowHTTPClient_GetLatestAnswerOrComment(t *testing.T) {
 			})
 
 			client := newTestClient(rt)
-			lastUpdate, description, err := client.GetLatestAnswerOrComment(context.Background(), testQuestionLink)
+			lastUpdate, details, err := client.GetLatestAnswerOrComment(context.Background(), testQuestionLink)
 
 			if tc.expectError {
 				assert.Error(t, err, "expected an error but got none")
@@ -131,7 +133,102 @@ func TestStackOverflowHTTPClient_GetLatestAnswerOrComment(t *testing.T) {
 
 			assert.NoError(t, err, "unexpected error occurred")
 			assert.Equal(t, tc.expectedTime, lastUpdate, "unexpected timestamp")
-			assert.Contains(t, description, tc.expectedDescSubstr, "description does not contain expected substring")
+			assert.Contains(t, details.PlainText(), tc.expectedDescSubstr, "description does not contain expected substring")
+		})
+	}
+}
+
+func TestStackOverflowHTTPClient_GetLatestAnswerOrComment_Details(t *testing.T) {
+	longBody := "<p>" + strings.Repeat("ответ ", 50) + "</p>"
+
+	rt := roundTripSOFunc(func(req *http.Request) (*http.Response, error) {
+		var bodyStr string
+
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/questions/12345"):
+			bodyStr = `{"items": [{"title": "How to use &quot;go&quot;?", "link": "https://stackoverflow.com/questions/12345/go"}]}`
+		case strings.HasSuffix(req.URL.Path, "/answers"):
+			bodyStr = `{"items": [{
+				"owner": {"display_name": "AnswerUser", "link": "https://stackoverflow.com/users/1/answeruser"},
+				"answer_id": 777,
+				"creation_date": 1580505600,
+				"body": ` + fmt.Sprintf("%q", "<p>Use <code>go run</code> &amp; enjoy</p>\n<pre>code</pre>"+longBody) + `
+			}]}`
+		default:
+			return nil, fmt.Errorf("unexpected request: %s", req.URL.Path)
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewBufferString(bodyStr)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client := newTestClient(rt)
+	_, details, err := client.GetLatestAnswerOrComment(context.Background(), testQuestionLink)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Answer", details.Kind)
+	assert.Equal(t, `How to use "go"?`, details.Title)
+	assert.Equal(t, "https://stackoverflow.com/a/777", details.URL)
+	assert.Equal(t, "AnswerUser", details.Author)
+	assert.Equal(t, "https://stackoverflow.com/users/1/answeruser", details.AuthorURL)
+	assert.True(t, strings.HasPrefix(details.Preview, "Use go run & enjoy code ответ ответ"), details.Preview)
+	assert.True(t, strings.HasSuffix(details.Preview, "…"), details.Preview)
+	assert.Equal(t, 200, utf8.RuneCountInString(details.Preview))
+	assert.True(t, utf8.ValidString(details.Preview))
+}
+
+func TestStackOverflowHTTPClient_Check_Metadata(t *testing.T) {
+	tests := []struct {
+		name             string
+		questionResponse string
+		expectedMetadata domain.LinkMetadata
+		expectedErr      error
+	}{
+		{
+			name:             "Open question",
+			questionResponse: `{"items": [{"title": "Test &amp; Question", "answer_count": 3}]}`,
+			expectedMetadata: domain.LinkMetadata{Title: "Test & Question", Answers: 3, Status: domain.SourceActive},
+			expectedErr:      domain.ErrUpdatesNotFound{},
+		},
+		{
+			name: "Closed question",
+			questionResponse: `{"items": [{"title": "Test Question", "answer_count": 1, "closed_date": 1609459200,
+				"closed_reason": "Duplicate"}]}`,
+			expectedMetadata: domain.LinkMetadata{Title: "Test Question", Description: "Duplicate", Answers: 1,
+				Status: domain.SourceClosed},
+			expectedErr: domain.ErrUpdatesNotFound{},
+		},
+		{
+			name:             "Deleted question",
+			questionResponse: `{"items": []}`,
+			expectedMetadata: domain.LinkMetadata{Status: domain.SourceDeleted},
+			expectedErr:      domain.ErrSourceDeleted{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := roundTripSOFunc(func(req *http.Request) (*http.Response, error) {
+				bodyStr := `{"items": []}`
+				if strings.HasSuffix(req.URL.Path, "/questions/12345") {
+					bodyStr = tc.questionResponse
+				}
+
+				return &http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(bytes.NewBufferString(bodyStr)),
+					Header:     make(http.Header),
+				}, nil
+			})
+
+			client := newTestClient(rt)
+			result, err := client.Check(context.Background(), &domain.Link{URL: testQuestionLink})
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedMetadata, result.Metadata)
 		})
 	}
 }
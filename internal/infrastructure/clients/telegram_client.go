@@ -3,19 +3,106 @@ package clients
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
 	"time"
 
 	"LinkTracker/internal/domain"
+	"LinkTracker/internal/metrics"
+	"LinkTracker/internal/tracing"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"golang.org/x/time/rate"
 )
 
+const maxDownloadFileSize = 1 << 20
+
+// botCommands — меню команд, которое Telegram показывает пользователю.
+var botCommands = []tgbotapi.BotCommand{
+	{
+		Command:     "start",
+		Description: "Начало работы с ботом",
+	},
+	{
+		Command:     "help",
+		Description: "Помощь по командам",
+	},
+	{
+		Command:     "track",
+		Description: "Начать отслеживание ссылки",
+	},
+	{
+		Command:     "untrack",
+		Description: "Прекратить отслеживание",
+	},
+	{
+		Command:     "settags",
+		Description: "Изменить теги у ссылки",
+	},
+	{
+		Command:     "list",
+		Description: "Список отслеживаемых ссылок",
+	},
+	{
+		Command:     "tags",
+		Description: "Список тегов",
+	},
+	{
+		Command:     "renametag",
+		Description: "Переименовать тег",
+	},
+	{
+		Command:     "history",
+		Description: "История обновлений ссылки",
+	},
+	{
+		Command:     "search",
+		Description: "Поиск по ссылкам и обновлениям",
+	},
+	{
+		Command:     "import",
+		Description: "Импортировать ссылки списком или файлом",
+	},
+	{
+		Command:     "export",
+		Description: "Выгрузить ссылки в файл",
+	},
+	{
+		Command:     "mode",
+		Description: "Режим доставки уведомлений",
+	},
+	{
+		Command:     "timezone",
+		Description: "Часовой пояс для сводки",
+	},
+	{
+		Command:     "quiet",
+		Description: "Тихие часы",
+	},
+	{
+		Command:     "pause",
+		Description: "Приостановить уведомления",
+	},
+	{
+		Command:     "resume",
+		Description: "Возобновить уведомления",
+	},
+	{
+		Command:     "token",
+		Description: "Токен для API",
+	},
+	{
+		Command:     "cancel",
+		Description: "Отменить текущее действие",
+	},
+}
+
 type TelegramHTTPClient struct {
 	tgBotAPI      *tgbotapi.BotAPI
 	updates       tgbotapi.UpdatesChannel
 	globalLimiter *rate.Limiter
+	fileClient    *http.Client
 }
 
 func NewTelegramHTTPClient(token string) (*TelegramHTTPClient, error) {
@@ -24,33 +111,6 @@ func NewTelegramHTTPClient(token string) (*TelegramHTTPClient, error) {
 		return nil, fmt.Errorf("failed to create bot: %w", err)
 	}
 
-	botCommands := []tgbotapi.BotCommand{
-		{
-			Command:     "start",
-			Description: "Начало работы с ботом",
-		},
-		{
-			Command:     "help",
-			Description: "Помощь по командам",
-		},
-		{
-			Command:     "track",
-			Description: "Начать отслеживание ссылки",
-		},
-		{
-			Command:     "untrack",
-			Description: "Прекратить отслеживание",
-		},
-		{
-			Command:     "settags",
-			Description: "Изменить теги у ссылки",
-		},
-		{
-			Command:     "list",
-			Description: "Список отслеживаемых ссылок",
-		},
-	}
-
 	if err := setBotCommands(tgBotAPI, botCommands); err != nil {
 		slog.Error("Failed to set bot commands: %v", "error", err.Error())
 	}
@@ -64,36 +124,287 @@ func NewTelegramHTTPClient(token string) (*TelegramHTTPClient, error) {
 		tgBotAPI:      tgBotAPI,
 		updates:       updates,
 		globalLimiter: rate.NewLimiter(rate.Every(time.Second/30), 30),
+		fileClient:    &http.Client{Timeout: 30 * time.Second},
 	}, nil
 }
 
 func (t *TelegramHTTPClient) ReceiveMessage(messageCh chan domain.Message) {
 	for update := range t.updates {
-		if update.Message != nil {
-			messageCh <- domain.Message{TgID: update.Message.From.ID, Text: update.Message.Text}
+		switch {
+		case update.Message != nil:
+			messageCh <- toDomainMessage(update.Message)
+		case update.ChannelPost != nil:
+			messageCh <- toDomainMessage(update.ChannelPost)
+		case update.CallbackQuery != nil:
+			message := domain.Message{
+				TgID:         update.CallbackQuery.From.ID,
+				UserID:       update.CallbackQuery.From.ID,
+				ChatType:     domain.ChatPrivate,
+				CallbackID:   update.CallbackQuery.ID,
+				CallbackData: update.CallbackQuery.Data,
+			}
+
+			if update.CallbackQuery.Message != nil {
+				message.TgID = update.CallbackQuery.Message.Chat.ID
+				message.ChatType = domain.ChatType(update.CallbackQuery.Message.Chat.Type)
+				message.MessageID = update.CallbackQuery.Message.MessageID
+			}
+
+			messageCh <- message
 		}
 	}
 }
 
+// call выполняет запрос method к Telegram Bot API в отдельном спане и учитывает ошибку в метриках.
+// Спаны создаются здесь, а не в транспорте: tgbotapi не передаёт контекст в запросы, а их URL содержит токен бота.
+func (t *TelegramHTTPClient) call(ctx context.Context, method string, do func() error) error {
+	_, span := tracing.Start(ctx, "telegram "+method)
+
+	err := do()
+	if err != nil {
+		metrics.ObserveTelegramError(method)
+	}
+
+	tracing.Finish(span, err)
+
+	return err
+}
+
 func (t *TelegramHTTPClient) StopReceiveMessage() {
 	t.tgBotAPI.StopReceivingUpdates()
 }
 
 func (t *TelegramHTTPClient) SendMessage(ctx context.Context, chatID int64, text string) {
-	err := t.globalLimiter.Wait(ctx)
+	t.SendFormattedMessage(ctx, chatID, text, domain.MessageFormat{})
+}
+
+func (t *TelegramHTTPClient) SendFormattedMessage(ctx context.Context, chatID int64, text string,
+	format domain.MessageFormat) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
 	if err != nil {
 		slog.Error("Rate limit error", "chatID", chatID, "text", text, "error", err.Error())
 		return
 	}
 
 	msg := tgbotapi.NewMessage(chatID, text)
+	msg.ParseMode = string(format.ParseMode)
+	msg.DisableWebPagePreview = format.DisableLinkPreview
 
-	_, err = t.tgBotAPI.Send(msg)
+	err = t.call(ctx, "sendMessage", func() error {
+		_, err := t.tgBotAPI.Send(msg)
+		return err
+	})
 	if err != nil {
 		slog.Error(err.Error())
 	}
 }
 
+func (t *TelegramHTTPClient) SendMessageWithKeyboard(ctx context.Context, chatID int64, text string,
+	keyboard domain.InlineKeyboard) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		slog.Error("Rate limit error", "chatID", chatID, "text", text, "error", err.Error())
+		return
+	}
+
+	msg := tgbotapi.NewMessage(chatID, text)
+	msg.ReplyMarkup = toInlineKeyboardMarkup(keyboard)
+
+	err = t.call(ctx, "sendMessage", func() error {
+		_, err := t.tgBotAPI.Send(msg)
+		return err
+	})
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+func (t *TelegramHTTPClient) EditMessageKeyboard(ctx context.Context, chatID int64, messageID int,
+	keyboard domain.InlineKeyboard) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		slog.Error("Rate limit error", "chatID", chatID, "messageID", messageID, "error", err.Error())
+		return
+	}
+
+	edit := tgbotapi.NewEditMessageReplyMarkup(chatID, messageID, toInlineKeyboardMarkup(keyboard))
+
+	err = t.call(ctx, "editMessageReplyMarkup", func() error {
+		_, err := t.tgBotAPI.Request(edit)
+		return err
+	})
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+func (t *TelegramHTTPClient) EditMessage(ctx context.Context, chatID int64, messageID int, text string,
+	keyboard domain.InlineKeyboard) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		slog.Error("Rate limit error", "chatID", chatID, "messageID", messageID, "error", err.Error())
+		return
+	}
+
+	edit := tgbotapi.NewEditMessageTextAndMarkup(chatID, messageID, text, toInlineKeyboardMarkup(keyboard))
+
+	err = t.call(ctx, "editMessageText", func() error {
+		_, err := t.tgBotAPI.Request(edit)
+		return err
+	})
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+func (t *TelegramHTTPClient) AnswerCallback(ctx context.Context, callbackID, text string) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		slog.Error("Rate limit error", "callbackID", callbackID, "error", err.Error())
+		return
+	}
+
+	err = t.call(ctx, "answerCallbackQuery", func() error {
+		_, err := t.tgBotAPI.Request(tgbotapi.NewCallback(callbackID, text))
+		return err
+	})
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+func (t *TelegramHTTPClient) SendDocument(ctx context.Context, chatID int64, fileName string, data []byte) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		slog.Error("Rate limit error", "chatID", chatID, "fileName", fileName, "error", err.Error())
+		return
+	}
+
+	document := tgbotapi.NewDocument(chatID, tgbotapi.FileBytes{Name: fileName, Bytes: data})
+
+	err = t.call(ctx, "sendDocument", func() error {
+		_, err := t.tgBotAPI.Send(document)
+		return err
+	})
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+// DownloadFile загружает содержимое файла, отправленного пользователем. Файлы больше
+// maxDownloadFileSize не загружаются.
+func (t *TelegramHTTPClient) DownloadFile(ctx context.Context, fileID string) ([]byte, error) {
+	fileURL, err := t.tgBotAPI.GetFileDirectURL(fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *http.Response
+
+	// Адрес файла содержит токен бота, поэтому запрос трейсится без URL
+	err = t.call(ctx, "downloadFile", func() error {
+		response, err = t.fileClient.Do(request) //nolint:bodyclose // body is closed below
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if Cerr := response.Body.Close(); Cerr != nil {
+			slog.Error("could not close resource", "error", Cerr.Error())
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, domain.ErrUnexpectedStatusCode{StatusCode: response.StatusCode}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxDownloadFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxDownloadFileSize {
+		return nil, domain.ErrFileTooLarge{Limit: maxDownloadFileSize}
+	}
+
+	return data, nil
+}
+
+// BotUsername возвращает имя бота без "@", к которому обращаются команды вида /command@botname.
+func (t *TelegramHTTPClient) BotUsername() string {
+	return t.tgBotAPI.Self.UserName
+}
+
+// IsChatAdmin проверяет, что пользователь — создатель или администратор чата.
+func (t *TelegramHTTPClient) IsChatAdmin(ctx context.Context, chatID, userID int64) (bool, error) {
+	err := waitLimiter(ctx, t.globalLimiter, "telegram")
+	if err != nil {
+		return false, err
+	}
+
+	var member tgbotapi.ChatMember
+
+	err = t.call(ctx, "getChatMember", func() error {
+		member, err = t.tgBotAPI.GetChatMember(tgbotapi.GetChatMemberConfig{
+			ChatConfigWithUser: tgbotapi.ChatConfigWithUser{ChatID: chatID, UserID: userID},
+		})
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return member.IsCreator() || member.IsAdministrator(), nil
+}
+
+// toDomainMessage переводит сообщение Telegram в domain.Message. Сообщение привязывается к чату,
+// поэтому в группах и каналах все участники работают с общими подписками.
+func toDomainMessage(msg *tgbotapi.Message) domain.Message {
+	message := domain.Message{
+		TgID:     msg.Chat.ID,
+		ChatType: domain.ChatType(msg.Chat.Type),
+		Text:     msg.Text,
+	}
+
+	// Посты канала приходят без отправителя, а анонимные администраторы группы пишут от имени самой группы
+	if msg.From != nil && (msg.SenderChat == nil || msg.SenderChat.ID != msg.Chat.ID) {
+		message.UserID = msg.From.ID
+	}
+
+	if msg.Document != nil {
+		message.Text = msg.Caption
+		message.Document = &domain.Document{
+			FileID:   msg.Document.FileID,
+			FileName: msg.Document.FileName,
+		}
+	}
+
+	return message
+}
+
+func toInlineKeyboardMarkup(keyboard domain.InlineKeyboard) tgbotapi.InlineKeyboardMarkup {
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(keyboard))
+
+	for _, row := range keyboard {
+		buttons := make([]tgbotapi.InlineKeyboardButton, 0, len(row))
+		for _, button := range row {
+			buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(button.Text, button.Data))
+		}
+
+		rows = append(rows, buttons)
+	}
+
+	return tgbotapi.InlineKeyboardMarkup{InlineKeyboard: rows}
+}
+
 func setBotCommands(bot *tgbotapi.BotAPI, botCommands []tgbotapi.BotCommand) error {
 	cfg := tgbotapi.NewSetMyCommands(botCommands...)
 	_, err := bot.Request(cfg)
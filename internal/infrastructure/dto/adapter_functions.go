@@ -1,7 +1,12 @@
 package dto
 
 import (
+	"errors"
+	"sort"
+	"time"
+
 	"LinkTracker/internal/domain"
+	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
 	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
 )
 
@@ -18,7 +23,97 @@ func LinkToLinkResponseDTO(link *domain.Link) scrapperdto.LinkResponse {
 		return scrapperdto.LinkResponse{}
 	}
 
-	return scrapperdto.LinkResponse{Url: &link.URL, Id: &link.ID, Tags: &link.Tags, Filters: &link.Filters}
+	linkResponse := scrapperdto.LinkResponse{Url: &link.URL, Id: &link.ID, Tags: &link.Tags, Filters: &link.Filters}
+	setLinkResponsePause(&linkResponse, link)
+
+	if !link.LastUpdated.IsZero() {
+		linkResponse.LastUpdated = &link.LastUpdated
+	}
+
+	if !link.Metadata.IsEmpty() {
+		linkResponse.Metadata = linkMetadataToDTO(&link.Metadata)
+	}
+
+	if !link.Health.LastCheckedAt.IsZero() {
+		linkResponse.Health = &scrapperdto.LinkHealth{
+			LastCheckedAt:       &link.Health.LastCheckedAt,
+			LastError:           &link.Health.LastError,
+			ConsecutiveFailures: &link.Health.ConsecutiveFailures,
+		}
+	}
+
+	return linkResponse
+}
+
+func linkMetadataToDTO(metadata *domain.LinkMetadata) *scrapperdto.LinkMetadata {
+	status := scrapperdto.LinkMetadataStatus(metadata.Status)
+
+	return &scrapperdto.LinkMetadata{
+		Title:       &metadata.Title,
+		Description: &metadata.Description,
+		Stars:       &metadata.Stars,
+		Answers:     &metadata.Answers,
+		Status:      &status,
+	}
+}
+
+func linkMetadataDTOToLinkMetadata(metadataDTO *scrapperdto.LinkMetadata) domain.LinkMetadata {
+	var metadata domain.LinkMetadata
+
+	if metadataDTO.Title != nil {
+		metadata.Title = *metadataDTO.Title
+	}
+
+	if metadataDTO.Description != nil {
+		metadata.Description = *metadataDTO.Description
+	}
+
+	if metadataDTO.Stars != nil {
+		metadata.Stars = *metadataDTO.Stars
+	}
+
+	if metadataDTO.Answers != nil {
+		metadata.Answers = *metadataDTO.Answers
+	}
+
+	if metadataDTO.Status != nil {
+		metadata.Status = domain.SourceStatus(*metadataDTO.Status)
+	}
+
+	return metadata
+}
+
+func linkHealthDTOToLinkHealth(healthDTO *scrapperdto.LinkHealth) domain.LinkHealth {
+	var health domain.LinkHealth
+
+	if healthDTO.LastCheckedAt != nil {
+		health.LastCheckedAt = healthDTO.LastCheckedAt.UTC()
+	}
+
+	if healthDTO.LastError != nil {
+		health.LastError = *healthDTO.LastError
+	}
+
+	if healthDTO.ConsecutiveFailures != nil {
+		health.ConsecutiveFailures = *healthDTO.ConsecutiveFailures
+	}
+
+	return health
+}
+
+// setLinkResponsePause заполняет поля паузы, если уведомления по ссылке приостановлены.
+func setLinkResponsePause(linkResponse *scrapperdto.LinkResponse, link *domain.Link) {
+	if !link.Paused {
+		return
+	}
+
+	paused := true
+	linkResponse.Paused = &paused
+
+	if !link.PausedUntil.IsZero() {
+		pausedUntil := link.PausedUntil
+		linkResponse.PausedUntil = &pausedUntil
+	}
 }
 
 func LinkRequestDTOToLink(linkRequest scrapperdto.LinkRequest) (domain.Link, error) {
@@ -45,12 +140,7 @@ func LinkRequestDTOToLink(linkRequest scrapperdto.LinkRequest) (domain.Link, err
 func LinksToListLinksResponseDTO(links []domain.Link) scrapperdto.ListLinksResponse {
 	linksResponse := make([]scrapperdto.LinkResponse, len(links))
 	for i := range links {
-		linksResponse[i] = scrapperdto.LinkResponse{
-			Id:      &links[i].ID,
-			Url:     &links[i].URL,
-			Tags:    &links[i].Tags,
-			Filters: &links[i].Filters,
-		}
+		linksResponse[i] = LinkToLinkResponseDTO(&links[i])
 	}
 
 	length := int32(len(linksResponse)) //nolint:gosec //api contract compliance(+ overflow is unlikely to be possible in real life)
@@ -58,6 +148,285 @@ func LinksToListLinksResponseDTO(links []domain.Link) scrapperdto.ListLinksRespo
 	return scrapperdto.ListLinksResponse{Links: &linksResponse, Size: &length}
 }
 
+func ListLinksResponseDTOToLinks(listLinksResponse scrapperdto.ListLinksResponse) []domain.Link {
+	if listLinksResponse.Links == nil {
+		return []domain.Link{}
+	}
+
+	links := make([]domain.Link, 0, len(*listLinksResponse.Links))
+	for i := range *listLinksResponse.Links {
+		links = append(links, linkResponseDTOToLink(&(*listLinksResponse.Links)[i]))
+	}
+
+	return links
+}
+
+func linkResponseDTOToLink(linkResponse *scrapperdto.LinkResponse) domain.Link {
+	link := domain.Link{Tags: []string{}, Filters: []string{}}
+
+	if linkResponse.Url != nil {
+		link.URL = *linkResponse.Url
+	}
+
+	if linkResponse.Id != nil {
+		link.ID = *linkResponse.Id
+	}
+
+	if linkResponse.Tags != nil {
+		link.Tags = *linkResponse.Tags
+	}
+
+	if linkResponse.Filters != nil {
+		link.Filters = *linkResponse.Filters
+	}
+
+	link.Paused = linkResponse.Paused != nil && *linkResponse.Paused
+	if link.Paused && linkResponse.PausedUntil != nil {
+		link.PausedUntil = linkResponse.PausedUntil.UTC()
+	}
+
+	if linkResponse.LastUpdated != nil {
+		link.LastUpdated = linkResponse.LastUpdated.UTC()
+	}
+
+	if linkResponse.Metadata != nil {
+		link.Metadata = linkMetadataDTOToLinkMetadata(linkResponse.Metadata)
+	}
+
+	if linkResponse.Health != nil {
+		link.Health = linkHealthDTOToLinkHealth(linkResponse.Health)
+	}
+
+	return link
+}
+
+func TagCountToTagResponseDTO(tag domain.TagCount) scrapperdto.TagResponse {
+	return scrapperdto.TagResponse{Tag: &tag.Tag, Links: &tag.Links}
+}
+
+func TagCountsToListTagsResponseDTO(tags []domain.TagCount) scrapperdto.ListTagsResponse {
+	tagsResponse := make([]scrapperdto.TagResponse, len(tags))
+	for i := range tags {
+		tagsResponse[i] = TagCountToTagResponseDTO(tags[i])
+	}
+
+	length := int32(len(tagsResponse)) //nolint:gosec //api contract compliance(+ overflow is unlikely to be possible in real life)
+
+	return scrapperdto.ListTagsResponse{Tags: &tagsResponse, Size: &length}
+}
+
+func ListTagsResponseDTOToTagCounts(listTagsResponse scrapperdto.ListTagsResponse) []domain.TagCount {
+	if listTagsResponse.Tags == nil {
+		return []domain.TagCount{}
+	}
+
+	tags := make([]domain.TagCount, 0, len(*listTagsResponse.Tags))
+
+	for _, tagResponse := range *listTagsResponse.Tags {
+		if tagResponse.Tag == nil {
+			continue
+		}
+
+		tag := domain.TagCount{Tag: *tagResponse.Tag}
+		if tagResponse.Links != nil {
+			tag.Links = *tagResponse.Links
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+func UpdateRecordToUpdateResponseDTO(record *domain.UpdateRecord) scrapperdto.UpdateResponse {
+	details := &record.Details
+
+	updateResponse := scrapperdto.UpdateResponse{
+		Id:          &record.ID,
+		LinkId:      &record.LinkID,
+		Description: &record.Description,
+		Kind:        &details.Kind,
+		Title:       &details.Title,
+		Url:         &details.URL,
+		Author:      &details.Author,
+		AuthorUrl:   &details.AuthorURL,
+		Preview:     &details.Preview,
+		DetectedAt:  &record.DetectedAt,
+	}
+
+	if !details.CreatedAt.IsZero() {
+		updateResponse.CreatedAt = &details.CreatedAt
+	}
+
+	return updateResponse
+}
+
+func UpdateRecordsToListUpdatesResponseDTO(records []domain.UpdateRecord, total int64) scrapperdto.ListUpdatesResponse {
+	updatesResponse := make([]scrapperdto.UpdateResponse, len(records))
+	for i := range records {
+		updatesResponse[i] = UpdateRecordToUpdateResponseDTO(&records[i])
+	}
+
+	length := int32(len(updatesResponse)) //nolint:gosec //api contract compliance(+ overflow is unlikely to be possible in real life)
+
+	return scrapperdto.ListUpdatesResponse{Updates: &updatesResponse, Size: &length, Total: &total}
+}
+
+func ListUpdatesResponseDTOToUpdateRecords(listUpdatesResponse scrapperdto.ListUpdatesResponse) ([]domain.UpdateRecord, int64) {
+	var total int64
+	if listUpdatesResponse.Total != nil {
+		total = *listUpdatesResponse.Total
+	}
+
+	if listUpdatesResponse.Updates == nil {
+		return []domain.UpdateRecord{}, total
+	}
+
+	records := make([]domain.UpdateRecord, 0, len(*listUpdatesResponse.Updates))
+	for i := range *listUpdatesResponse.Updates {
+		records = append(records, updateResponseDTOToUpdateRecord(&(*listUpdatesResponse.Updates)[i]))
+	}
+
+	return records, total
+}
+
+func updateResponseDTOToUpdateRecord(updateResponse *scrapperdto.UpdateResponse) domain.UpdateRecord {
+	var record domain.UpdateRecord
+
+	if updateResponse.Id != nil {
+		record.ID = *updateResponse.Id
+	}
+
+	if updateResponse.LinkId != nil {
+		record.LinkID = *updateResponse.LinkId
+	}
+
+	if updateResponse.Description != nil {
+		record.Description = *updateResponse.Description
+	}
+
+	if updateResponse.DetectedAt != nil {
+		record.DetectedAt = *updateResponse.DetectedAt
+	}
+
+	record.Details = updateDetailsDTOToUpdateDetails(&botdto.UpdateDetails{
+		Kind:      updateResponse.Kind,
+		Title:     updateResponse.Title,
+		Url:       updateResponse.Url,
+		Author:    updateResponse.Author,
+		AuthorUrl: updateResponse.AuthorUrl,
+		CreatedAt: updateResponse.CreatedAt,
+		Preview:   updateResponse.Preview,
+	})
+
+	return record
+}
+
+func SearchResultsToSearchResponseDTO(results []domain.SearchResult) scrapperdto.SearchResponse {
+	resultsResponse := make([]scrapperdto.SearchResult, len(results))
+
+	for i := range results {
+		linkResponse := LinkToLinkResponseDTO(&results[i].Link)
+		resultsResponse[i] = scrapperdto.SearchResult{Link: &linkResponse, Rank: &results[i].Rank}
+
+		if results[i].Update != nil {
+			updateResponse := UpdateRecordToUpdateResponseDTO(results[i].Update)
+			resultsResponse[i].Update = &updateResponse
+		}
+	}
+
+	length := int32(len(resultsResponse)) //nolint:gosec //api contract compliance(+ overflow is unlikely to be possible in real life)
+
+	return scrapperdto.SearchResponse{Results: &resultsResponse, Size: &length}
+}
+
+func SearchResponseDTOToSearchResults(searchResponse scrapperdto.SearchResponse) []domain.SearchResult {
+	if searchResponse.Results == nil {
+		return []domain.SearchResult{}
+	}
+
+	results := make([]domain.SearchResult, 0, len(*searchResponse.Results))
+
+	for _, resultResponse := range *searchResponse.Results {
+		if resultResponse.Link == nil {
+			continue
+		}
+
+		result := domain.SearchResult{Link: linkResponseDTOToLink(resultResponse.Link)}
+
+		if resultResponse.Rank != nil {
+			result.Rank = *resultResponse.Rank
+		}
+
+		if resultResponse.Update != nil {
+			record := updateResponseDTOToUpdateRecord(resultResponse.Update)
+			result.Update = &record
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func PauseToPauseRequestDTO(selector domain.LinkSelector, until time.Time) scrapperdto.PauseRequest {
+	resumeRequest := LinkSelectorToResumeRequestDTO(selector)
+	pauseRequest := scrapperdto.PauseRequest{Link: resumeRequest.Link, Id: resumeRequest.Id, Tag: resumeRequest.Tag}
+
+	if !until.IsZero() {
+		pauseRequest.Until = &until
+	}
+
+	return pauseRequest
+}
+
+func PauseRequestDTOToPause(pauseRequest scrapperdto.PauseRequest) (domain.LinkSelector, time.Time) {
+	selector := ResumeRequestDTOToLinkSelector(scrapperdto.ResumeRequest{
+		Link: pauseRequest.Link,
+		Id:   pauseRequest.Id,
+		Tag:  pauseRequest.Tag,
+	})
+
+	if pauseRequest.Until == nil {
+		return selector, time.Time{}
+	}
+
+	return selector, pauseRequest.Until.UTC()
+}
+
+func LinkSelectorToResumeRequestDTO(selector domain.LinkSelector) scrapperdto.ResumeRequest {
+	var resumeRequest scrapperdto.ResumeRequest
+
+	switch {
+	case selector.URL != "":
+		resumeRequest.Link = &selector.URL
+	case selector.ID != 0:
+		resumeRequest.Id = &selector.ID
+	case selector.Tag != "":
+		resumeRequest.Tag = &selector.Tag
+	}
+
+	return resumeRequest
+}
+
+func ResumeRequestDTOToLinkSelector(resumeRequest scrapperdto.ResumeRequest) domain.LinkSelector {
+	var selector domain.LinkSelector
+
+	if resumeRequest.Link != nil {
+		selector.URL = *resumeRequest.Link
+	}
+
+	if resumeRequest.Id != nil {
+		selector.ID = *resumeRequest.Id
+	}
+
+	if resumeRequest.Tag != nil {
+		selector.Tag = *resumeRequest.Tag
+	}
+
+	return selector
+}
+
 func RemoveLinkRequestDTOToLink(removeLinkRequestDTO scrapperdto.RemoveLinkRequest) (domain.Link, error) {
 	if removeLinkRequestDTO.Link == nil || *removeLinkRequestDTO.Link == "" {
 		return domain.Link{}, domain.ErrNoRequiredAttribute{Attribute: "link"}
@@ -75,3 +444,411 @@ func LinkToRemoveListRequestDTO(link *domain.Link) scrapperdto.RemoveLinkRequest
 		Link: &link.URL,
 	}
 }
+
+func LinksToBatchLinksRequestDTO(links []domain.Link) scrapperdto.BatchLinksRequest {
+	linkRequests := make([]scrapperdto.LinkRequest, len(links))
+	for i := range links {
+		linkRequests[i] = LinkToLinkRequestDTO(&links[i])
+	}
+
+	return scrapperdto.BatchLinksRequest{Links: &linkRequests}
+}
+
+func BatchLinksRequestDTOToLinks(batchRequest scrapperdto.BatchLinksRequest) ([]domain.Link, error) {
+	if batchRequest.Links == nil || len(*batchRequest.Links) == 0 {
+		return nil, domain.ErrNoRequiredAttribute{Attribute: "links"}
+	}
+
+	links := make([]domain.Link, 0, len(*batchRequest.Links))
+
+	for _, linkRequest := range *batchRequest.Links {
+		link, err := LinkRequestDTOToLink(linkRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+func LinkImportResultsToBatchLinksResponseDTO(results []domain.LinkImportResult) scrapperdto.BatchLinksResponse {
+	batchResults := make([]scrapperdto.BatchLinkResult, len(results))
+
+	var added, failed int32
+
+	for i := range results {
+		batchResults[i] = scrapperdto.BatchLinkResult{
+			Link:    &results[i].Link.URL,
+			Tags:    &results[i].Link.Tags,
+			Filters: &results[i].Link.Filters,
+		}
+
+		if results[i].Error != "" {
+			batchResults[i].Error = &results[i].Error
+			failed++
+
+			continue
+		}
+
+		batchResults[i].Id = &results[i].Link.ID
+		added++
+	}
+
+	return scrapperdto.BatchLinksResponse{Results: &batchResults, Added: &added, Failed: &failed}
+}
+
+func BatchLinksResponseDTOToLinkImportResults(batchResponse scrapperdto.BatchLinksResponse) []domain.LinkImportResult {
+	if batchResponse.Results == nil {
+		return []domain.LinkImportResult{}
+	}
+
+	results := make([]domain.LinkImportResult, 0, len(*batchResponse.Results))
+
+	for _, batchResult := range *batchResponse.Results {
+		var result domain.LinkImportResult
+
+		if batchResult.Link != nil {
+			result.Link.URL = *batchResult.Link
+		}
+
+		if batchResult.Id != nil {
+			result.Link.ID = *batchResult.Id
+		}
+
+		if batchResult.Tags != nil {
+			result.Link.Tags = *batchResult.Tags
+		}
+
+		if batchResult.Filters != nil {
+			result.Link.Filters = *batchResult.Filters
+		}
+
+		if batchResult.Error != nil {
+			result.Error = *batchResult.Error
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func LinkUpdateToLinkUpdateDTO(update *domain.LinkUpdate) botdto.LinkUpdate {
+	linkUpdate := botdto.LinkUpdate{
+		Description: &update.Description,
+		Id:          &update.Link.ID,
+		TgChatIds:   &update.TgIDs,
+		Url:         &update.Link.URL,
+	}
+
+	if !update.Details.IsEmpty() {
+		details := &update.Details
+		linkUpdate.Details = &botdto.UpdateDetails{
+			Kind:      &details.Kind,
+			Title:     &details.Title,
+			Url:       &details.URL,
+			Author:    &details.Author,
+			AuthorUrl: &details.AuthorURL,
+			CreatedAt: &details.CreatedAt,
+			Preview:   &details.Preview,
+		}
+	}
+
+	return linkUpdate
+}
+
+func LinkUpdateDTOToLinkUpdate(linkUpdate botdto.LinkUpdate) (domain.LinkUpdate, error) {
+	if linkUpdate.TgChatIds == nil || linkUpdate.Url == nil {
+		return domain.LinkUpdate{}, domain.ErrNoRequiredAttribute{Attribute: "tgChatIds, url"}
+	}
+
+	update := domain.LinkUpdate{
+		Link:  domain.Link{URL: *linkUpdate.Url},
+		TgIDs: *linkUpdate.TgChatIds,
+	}
+
+	if linkUpdate.Id != nil {
+		update.Link.ID = *linkUpdate.Id
+	}
+
+	if linkUpdate.Description != nil {
+		update.Description = *linkUpdate.Description
+	}
+
+	if linkUpdate.Details != nil {
+		update.Details = updateDetailsDTOToUpdateDetails(linkUpdate.Details)
+	}
+
+	return update, nil
+}
+
+func DigestToDigestDTO(digest *domain.Digest) botdto.Digest {
+	mode := botdto.DigestMode(digest.Mode)
+
+	digestDTO := botdto.Digest{
+		Mode:     &mode,
+		TgChatId: digest.TgID,
+		Updates:  make([]botdto.LinkUpdate, 0, len(digest.Updates)),
+	}
+
+	for i := range digest.Updates {
+		digestDTO.Updates = append(digestDTO.Updates, LinkUpdateToLinkUpdateDTO(&digest.Updates[i]))
+	}
+
+	return digestDTO
+}
+
+func DigestDTOToDigest(digestDTO botdto.Digest) (domain.Digest, error) {
+	if len(digestDTO.Updates) == 0 {
+		return domain.Digest{}, domain.ErrNoRequiredAttribute{Attribute: "updates"}
+	}
+
+	digest := domain.Digest{
+		TgID:    digestDTO.TgChatId,
+		Updates: make([]domain.LinkUpdate, 0, len(digestDTO.Updates)),
+	}
+
+	if digestDTO.Mode != nil {
+		digest.Mode = domain.DeliveryMode(*digestDTO.Mode)
+	}
+
+	for _, updateDTO := range digestDTO.Updates {
+		if updateDTO.TgChatIds == nil {
+			updateDTO.TgChatIds = &[]int64{digestDTO.TgChatId}
+		}
+
+		update, err := LinkUpdateDTOToLinkUpdate(updateDTO)
+		if err != nil {
+			return domain.Digest{}, err
+		}
+
+		digest.Updates = append(digest.Updates, update)
+	}
+
+	return digest, nil
+}
+
+func updateDetailsDTOToUpdateDetails(detailsDTO *botdto.UpdateDetails) domain.UpdateDetails {
+	var details domain.UpdateDetails
+
+	if detailsDTO.Kind != nil {
+		details.Kind = *detailsDTO.Kind
+	}
+
+	if detailsDTO.Title != nil {
+		details.Title = *detailsDTO.Title
+	}
+
+	if detailsDTO.Url != nil {
+		details.URL = *detailsDTO.Url
+	}
+
+	if detailsDTO.Author != nil {
+		details.Author = *detailsDTO.Author
+	}
+
+	if detailsDTO.AuthorUrl != nil {
+		details.AuthorURL = *detailsDTO.AuthorUrl
+	}
+
+	if detailsDTO.CreatedAt != nil {
+		details.CreatedAt = *detailsDTO.CreatedAt
+	}
+
+	if detailsDTO.Preview != nil {
+		details.Preview = *detailsDTO.Preview
+	}
+
+	return details
+}
+
+func DeliverySettingsToSettingsResponseDTO(settings *domain.DeliverySettings) scrapperdto.SettingsResponse {
+	mode := string(settings.Mode)
+	digestTime := domain.FormatDigestTime(settings.DigestTime)
+	timezone := settings.Timezone
+
+	var quietHours string
+	if !settings.Quiet.IsEmpty() {
+		quietHours = settings.Quiet.String()
+	}
+
+	tags := make([]string, 0, len(settings.TagModes))
+	for tag := range settings.TagModes {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	tagModes := make([]scrapperdto.TagMode, 0, len(tags))
+
+	for _, tag := range tags {
+		tagMode := string(settings.TagModes[tag])
+		tagModes = append(tagModes, scrapperdto.TagMode{Tag: &tag, Mode: &tagMode})
+	}
+
+	return scrapperdto.SettingsResponse{
+		Mode:       &mode,
+		DigestTime: &digestTime,
+		Timezone:   &timezone,
+		QuietHours: &quietHours,
+		TagModes:   &tagModes,
+	}
+}
+
+func SettingsResponseDTOToDeliverySettings(settingsResponse scrapperdto.SettingsResponse) (domain.DeliverySettings, error) {
+	settings := domain.DefaultDeliverySettings()
+
+	if settingsResponse.Mode != nil {
+		settings.Mode = domain.DeliveryMode(*settingsResponse.Mode)
+	}
+
+	if settingsResponse.DigestTime != nil {
+		digestTime, err := domain.ParseDigestTime(*settingsResponse.DigestTime)
+		if err != nil {
+			return domain.DeliverySettings{}, err
+		}
+
+		settings.DigestTime = digestTime
+	}
+
+	if settingsResponse.Timezone != nil {
+		settings.Timezone = *settingsResponse.Timezone
+	}
+
+	if settingsResponse.QuietHours != nil && *settingsResponse.QuietHours != "" {
+		quiet, err := domain.ParseQuietHours(*settingsResponse.QuietHours)
+		if err != nil {
+			return domain.DeliverySettings{}, err
+		}
+
+		settings.Quiet = quiet
+	}
+
+	if settingsResponse.TagModes != nil {
+		for _, tagMode := range *settingsResponse.TagModes {
+			if tagMode.Tag != nil && tagMode.Mode != nil {
+				settings.TagModes[*tagMode.Tag] = domain.DeliveryMode(*tagMode.Mode)
+			}
+		}
+	}
+
+	return settings, nil
+}
+
+func DeliverySettingsUpdateToSettingsRequestDTO(update *domain.DeliverySettingsUpdate) scrapperdto.SettingsRequest {
+	var settingsRequest scrapperdto.SettingsRequest
+
+	if update.Mode != nil {
+		mode := string(*update.Mode)
+		settingsRequest.Mode = &mode
+	}
+
+	if update.DigestTime != nil {
+		digestTime := domain.FormatDigestTime(*update.DigestTime)
+		settingsRequest.DigestTime = &digestTime
+	}
+
+	settingsRequest.Timezone = update.Timezone
+
+	if update.Quiet != nil {
+		var quietHours string
+		if !update.Quiet.IsEmpty() {
+			quietHours = update.Quiet.String()
+		}
+
+		settingsRequest.QuietHours = &quietHours
+	}
+
+	return settingsRequest
+}
+
+func SettingsRequestDTOToDeliverySettingsUpdate(settingsRequest scrapperdto.SettingsRequest) (domain.DeliverySettingsUpdate, error) {
+	var update domain.DeliverySettingsUpdate
+
+	if settingsRequest.Mode != nil {
+		mode := domain.DeliveryMode(*settingsRequest.Mode)
+		update.Mode = &mode
+	}
+
+	if settingsRequest.DigestTime != nil {
+		digestTime, err := domain.ParseDigestTime(*settingsRequest.DigestTime)
+		if err != nil {
+			return domain.DeliverySettingsUpdate{}, err
+		}
+
+		update.DigestTime = &digestTime
+	}
+
+	update.Timezone = settingsRequest.Timezone
+
+	if settingsRequest.QuietHours != nil {
+		var quiet domain.QuietHours
+
+		if *settingsRequest.QuietHours != "" {
+			parsed, err := domain.ParseQuietHours(*settingsRequest.QuietHours)
+			if err != nil {
+				return domain.DeliverySettingsUpdate{}, err
+			}
+
+			quiet = parsed
+		}
+
+		update.Quiet = &quiet
+	}
+
+	return update, nil
+}
+
+func UserLinkCountsToListAdminUsersResponseDTO(users []domain.UserLinkCount) scrapperdto.ListAdminUsersResponse {
+	usersResponse := make([]scrapperdto.AdminUser, len(users))
+	for i := range users {
+		usersResponse[i] = scrapperdto.AdminUser{TgId: &users[i].TgID, Links: &users[i].Links}
+	}
+
+	length := int32(len(usersResponse)) //nolint:gosec //api contract compliance(+ overflow is unlikely to be possible in real life)
+
+	return scrapperdto.ListAdminUsersResponse{Users: &usersResponse, Size: &length}
+}
+
+// LinkCheckToCheckLinkResponseDTO описывает итог внеплановой проверки ссылки: ErrUpdatesNotFound
+// означает, что новых событий нет, остальные ошибки — что проверка не удалась.
+func LinkCheckToCheckLinkResponseDTO(link *domain.Link, checkErr error) scrapperdto.CheckLinkResponse {
+	linkResponse := LinkToLinkResponseDTO(link)
+	outcome := scrapperdto.Updated
+	checkResponse := scrapperdto.CheckLinkResponse{Link: &linkResponse, Outcome: &outcome}
+
+	switch {
+	case errors.As(checkErr, &domain.ErrUpdatesNotFound{}):
+		outcome = scrapperdto.NoUpdates
+	case checkErr != nil:
+		outcome = scrapperdto.Failed
+		errorText := checkErr.Error()
+		checkResponse.Error = &errorText
+	}
+
+	return checkResponse
+}
+
+func SchedulerStatusToSchedulerStatusResponseDTO(status *domain.SchedulerStatus) scrapperdto.SchedulerStatusResponse {
+	durationMs := status.LastRunDuration.Milliseconds()
+
+	statusResponse := scrapperdto.SchedulerStatusResponse{
+		Paused:            &status.Paused,
+		Running:           &status.Running,
+		LastRunDurationMs: &durationMs,
+		Checked:           &status.LastRun.Checked,
+		Failed:            &status.LastRun.Failed,
+	}
+
+	if !status.LastRunAt.IsZero() {
+		statusResponse.LastRunAt = &status.LastRunAt
+	}
+
+	if !status.NextRunAt.IsZero() {
+		statusResponse.NextRunAt = &status.NextRunAt
+	}
+
+	return statusResponse
+}
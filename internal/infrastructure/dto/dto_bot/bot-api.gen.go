@@ -3,6 +3,14 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package botdto
 
+import (
+	"time"
+)
+
+const (
+	ServiceTokenScopes = "serviceToken.Scopes"
+)
+
 // ApiErrorResponse defines model for ApiErrorResponse.
 type ApiErrorResponse struct {
 	Code             *string   `json:"code,omitempty"`
@@ -12,13 +20,45 @@ type ApiErrorResponse struct {
 	Stacktrace       *[]string `json:"stacktrace,omitempty"`
 }
 
+// Defines values for DigestMode.
+const (
+	Daily  DigestMode = "daily"
+	Hourly DigestMode = "hourly"
+	Quiet  DigestMode = "quiet"
+)
+
+// Digest defines model for Digest.
+type Digest struct {
+	Mode     *DigestMode  `json:"mode,omitempty"`
+	TgChatId int64        `json:"tgChatId"`
+	Updates  []LinkUpdate `json:"updates"`
+}
+
+// DigestMode defines model for Digest.Mode.
+type DigestMode string
+
 // LinkUpdate defines model for LinkUpdate.
 type LinkUpdate struct {
-	Description *string  `json:"description,omitempty"`
-	Id          *int64   `json:"id,omitempty"`
-	TgChatIds   *[]int64 `json:"tgChatIds,omitempty"`
-	Url         *string  `json:"url,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Details     *UpdateDetails `json:"details,omitempty"`
+	Id          *int64         `json:"id,omitempty"`
+	TgChatIds   *[]int64       `json:"tgChatIds,omitempty"`
+	Url         *string        `json:"url,omitempty"`
+}
+
+// UpdateDetails defines model for UpdateDetails.
+type UpdateDetails struct {
+	Author    *string    `json:"author,omitempty"`
+	AuthorUrl *string    `json:"authorUrl,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Kind      *string    `json:"kind,omitempty"`
+	Preview   *string    `json:"preview,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+	Url       *string    `json:"url,omitempty"`
 }
 
+// PostDigestsJSONRequestBody defines body for PostDigests for application/json ContentType.
+type PostDigestsJSONRequestBody = Digest
+
 // PostUpdatesJSONRequestBody defines body for PostUpdates for application/json ContentType.
 type PostUpdatesJSONRequestBody = LinkUpdate
@@ -3,6 +3,36 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package scrapperdto
 
+import (
+	"time"
+)
+
+const (
+	AdminTokenScopes   = "adminToken.Scopes"
+	ServiceTokenScopes = "serviceToken.Scopes"
+)
+
+// Defines values for CheckLinkResponseOutcome.
+const (
+	Failed    CheckLinkResponseOutcome = "failed"
+	NoUpdates CheckLinkResponseOutcome = "no_updates"
+	Updated   CheckLinkResponseOutcome = "updated"
+)
+
+// Defines values for LinkMetadataStatus.
+const (
+	Active   LinkMetadataStatus = "active"
+	Archived LinkMetadataStatus = "archived"
+	Closed   LinkMetadataStatus = "closed"
+	Deleted  LinkMetadataStatus = "deleted"
+)
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	Links *int64 `json:"links,omitempty"`
+	TgId  *int64 `json:"tgId,omitempty"`
+}
+
 // ApiErrorResponse defines model for ApiErrorResponse.
 type ApiErrorResponse struct {
 	Code             *string   `json:"code,omitempty"`
@@ -12,6 +42,75 @@ type ApiErrorResponse struct {
 	Stacktrace       *[]string `json:"stacktrace,omitempty"`
 }
 
+// ApiTokenResponse defines model for ApiTokenResponse.
+type ApiTokenResponse struct {
+	// Token Персональный токен публичного API, показывается один раз
+	Token *string `json:"token,omitempty"`
+}
+
+// BatchLinkResult defines model for BatchLinkResult.
+type BatchLinkResult struct {
+	Error   *string   `json:"error,omitempty"`
+	Filters *[]string `json:"filters,omitempty"`
+	Id      *int64    `json:"id,omitempty"`
+	Link    *string   `json:"link,omitempty"`
+	Tags    *[]string `json:"tags,omitempty"`
+}
+
+// BatchLinksRequest defines model for BatchLinksRequest.
+type BatchLinksRequest struct {
+	Links *[]LinkRequest `json:"links,omitempty"`
+}
+
+// BatchLinksResponse defines model for BatchLinksResponse.
+type BatchLinksResponse struct {
+	Added   *int32             `json:"added,omitempty"`
+	Failed  *int32             `json:"failed,omitempty"`
+	Results *[]BatchLinkResult `json:"results,omitempty"`
+}
+
+// CheckLinkRequest defines model for CheckLinkRequest.
+type CheckLinkRequest struct {
+	Link *string `json:"link,omitempty"`
+}
+
+// CheckLinkResponse defines model for CheckLinkResponse.
+type CheckLinkResponse struct {
+	// Error Текст ошибки проверки, есть только при outcome failed
+	Error   *string                   `json:"error,omitempty"`
+	Link    *LinkResponse             `json:"link,omitempty"`
+	Outcome *CheckLinkResponseOutcome `json:"outcome,omitempty"`
+}
+
+// CheckLinkResponseOutcome defines model for CheckLinkResponse.Outcome.
+type CheckLinkResponseOutcome string
+
+// LinkHealth Результат последних проверок ссылки, отсутствует, пока ссылка не проверялась
+type LinkHealth struct {
+	// ConsecutiveFailures Число ошибок проверки подряд
+	ConsecutiveFailures *int64     `json:"consecutiveFailures,omitempty"`
+	LastCheckedAt       *time.Time `json:"lastCheckedAt,omitempty"`
+
+	// LastError Текст ошибки последней проверки, пустой после успешной проверки
+	LastError *string `json:"lastError,omitempty"`
+}
+
+// LinkMetadata Сведения об источнике ссылки, отсутствуют, пока источник не проверялся
+type LinkMetadata struct {
+	Answers *int64 `json:"answers,omitempty"`
+
+	// Description Описание репозитория или причина закрытия вопроса
+	Description *string             `json:"description,omitempty"`
+	Stars       *int64              `json:"stars,omitempty"`
+	Status      *LinkMetadataStatus `json:"status,omitempty"`
+
+	// Title Полное имя репозитория или заголовок вопроса
+	Title *string `json:"title,omitempty"`
+}
+
+// LinkMetadataStatus defines model for LinkMetadata.Status.
+type LinkMetadataStatus string
+
 // LinkRequest defines model for LinkRequest.
 type LinkRequest struct {
 	Filters *[]string `json:"filters,omitempty"`
@@ -22,9 +121,28 @@ type LinkRequest struct {
 // LinkResponse defines model for LinkResponse.
 type LinkResponse struct {
 	Filters *[]string `json:"filters,omitempty"`
-	Id      *int64    `json:"id,omitempty"`
-	Tags    *[]string `json:"tags,omitempty"`
-	Url     *string   `json:"url,omitempty"`
+
+	// Health Результат последних проверок ссылки, отсутствует, пока ссылка не проверялась
+	Health *LinkHealth `json:"health,omitempty"`
+	Id     *int64      `json:"id,omitempty"`
+
+	// LastUpdated Время последнего события по ссылке в источнике
+	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+
+	// Metadata Сведения об источнике ссылки, отсутствуют, пока источник не проверялся
+	Metadata *LinkMetadata `json:"metadata,omitempty"`
+	Paused   *bool         `json:"paused,omitempty"`
+
+	// PausedUntil Конец временной паузы, отсутствует у бессрочной паузы
+	PausedUntil *time.Time `json:"pausedUntil,omitempty"`
+	Tags        *[]string  `json:"tags,omitempty"`
+	Url         *string    `json:"url,omitempty"`
+}
+
+// ListAdminUsersResponse defines model for ListAdminUsersResponse.
+type ListAdminUsersResponse struct {
+	Size  *int32       `json:"size,omitempty"`
+	Users *[]AdminUser `json:"users,omitempty"`
 }
 
 // ListLinksResponse defines model for ListLinksResponse.
@@ -33,17 +151,108 @@ type ListLinksResponse struct {
 	Size  *int32          `json:"size,omitempty"`
 }
 
+// ListTagsResponse defines model for ListTagsResponse.
+type ListTagsResponse struct {
+	Size *int32         `json:"size,omitempty"`
+	Tags *[]TagResponse `json:"tags,omitempty"`
+}
+
+// ListUpdatesResponse defines model for ListUpdatesResponse.
+type ListUpdatesResponse struct {
+	Size    *int32            `json:"size,omitempty"`
+	Total   *int64            `json:"total,omitempty"`
+	Updates *[]UpdateResponse `json:"updates,omitempty"`
+}
+
+// PauseRequest defines model for PauseRequest.
+type PauseRequest struct {
+	Id    *int64     `json:"id,omitempty"`
+	Link  *string    `json:"link,omitempty"`
+	Tag   *string    `json:"tag,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+}
+
 // RemoveLinkRequest defines model for RemoveLinkRequest.
 type RemoveLinkRequest struct {
 	Link *string `json:"link,omitempty"`
 }
 
+// RenameTagRequest defines model for RenameTagRequest.
+type RenameTagRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ResumeRequest defines model for ResumeRequest.
+type ResumeRequest struct {
+	Id   *int64  `json:"id,omitempty"`
+	Link *string `json:"link,omitempty"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+// SchedulerStatusResponse defines model for SchedulerStatusResponse.
+type SchedulerStatusResponse struct {
+	// Checked Число ссылок, проверенных за последний проход
+	Checked *int64 `json:"checked,omitempty"`
+
+	// Failed Число ссылок, проверка которых в последнем проходе завершилась ошибкой
+	Failed *int64 `json:"failed,omitempty"`
+
+	// LastRunAt Начало последнего прохода, отсутствует, пока проходов не было
+	LastRunAt         *time.Time `json:"lastRunAt,omitempty"`
+	LastRunDurationMs *int64     `json:"lastRunDurationMs,omitempty"`
+	NextRunAt         *time.Time `json:"nextRunAt,omitempty"`
+	Paused            *bool      `json:"paused,omitempty"`
+
+	// Running Идёт ли сейчас проход проверки ссылок
+	Running *bool `json:"running,omitempty"`
+}
+
+// SearchResponse defines model for SearchResponse.
+type SearchResponse struct {
+	Results *[]SearchResult `json:"results,omitempty"`
+	Size    *int32          `json:"size,omitempty"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Link   *LinkResponse   `json:"link,omitempty"`
+	Rank   *float64        `json:"rank,omitempty"`
+	Update *UpdateResponse `json:"update,omitempty"`
+}
+
+// SettingsRequest defines model for SettingsRequest.
+type SettingsRequest struct {
+	// DigestTime Время ежедневной сводки в формате ЧЧ:ММ
+	DigestTime *string `json:"digestTime,omitempty"`
+
+	// Mode immediate, hourly или daily
+	Mode *string `json:"mode,omitempty"`
+
+	// QuietHours Тихие часы в формате ЧЧ:ММ-ЧЧ:ММ, пустая строка выключает их
+	QuietHours *string `json:"quietHours,omitempty"`
+
+	// Timezone Часовой пояс IANA (Europe/Moscow) или смещение (+03:00)
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// SettingsResponse defines model for SettingsResponse.
+type SettingsResponse struct {
+	DigestTime *string    `json:"digestTime,omitempty"`
+	Mode       *string    `json:"mode,omitempty"`
+	QuietHours *string    `json:"quietHours,omitempty"`
+	TagModes   *[]TagMode `json:"tagModes,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
+}
+
 // StateRequest defines model for StateRequest.
 type StateRequest struct {
 	Filters *[]string `json:"filters,omitempty"`
 	Link    *string   `json:"link,omitempty"`
 	State   *int      `json:"state,omitempty"`
 	Tags    *[]string `json:"tags,omitempty"`
+
+	// UserId Участник чата, начавший диалог
+	UserId *int64 `json:"userId,omitempty"`
 }
 
 // StateResponse defines model for StateResponse.
@@ -52,6 +261,42 @@ type StateResponse struct {
 	Link    *string   `json:"link,omitempty"`
 	State   *int      `json:"state,omitempty"`
 	Tags    *[]string `json:"tags,omitempty"`
+
+	// UserId Участник чата, начавший диалог
+	UserId *int64 `json:"userId,omitempty"`
+}
+
+// TagMode defines model for TagMode.
+type TagMode struct {
+	Mode *string `json:"mode,omitempty"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+// TagModeRequest defines model for TagModeRequest.
+type TagModeRequest struct {
+	Mode *string `json:"mode,omitempty"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+// TagResponse defines model for TagResponse.
+type TagResponse struct {
+	Links *int64  `json:"links,omitempty"`
+	Tag   *string `json:"tag,omitempty"`
+}
+
+// UpdateResponse defines model for UpdateResponse.
+type UpdateResponse struct {
+	Author      *string    `json:"author,omitempty"`
+	AuthorUrl   *string    `json:"authorUrl,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	DetectedAt  *time.Time `json:"detectedAt,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+	Kind        *string    `json:"kind,omitempty"`
+	LinkId      *int64     `json:"linkId,omitempty"`
+	Preview     *string    `json:"preview,omitempty"`
+	Title       *string    `json:"title,omitempty"`
+	Url         *string    `json:"url,omitempty"`
 }
 
 // DeleteLinksParams defines parameters for DeleteLinks.
@@ -61,7 +306,9 @@ type DeleteLinksParams struct {
 
 // GetLinksParams defines parameters for GetLinks.
 type GetLinksParams struct {
-	TgChatId int64 `json:"Tg-Chat-Id"`
+	// Tag Вернуть только ссылки с этим тегом
+	Tag      *string `form:"tag,omitempty" json:"tag,omitempty"`
+	TgChatId int64   `json:"Tg-Chat-Id"`
 }
 
 // PostLinksParams defines parameters for PostLinks.
@@ -74,6 +321,56 @@ type PutLinksParams struct {
 	TgChatId int64 `json:"Tg-Chat-Id"`
 }
 
+// PostLinksBatchParams defines parameters for PostLinksBatch.
+type PostLinksBatchParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// PostLinksPauseParams defines parameters for PostLinksPause.
+type PostLinksPauseParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// PostLinksResumeParams defines parameters for PostLinksResume.
+type PostLinksResumeParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// GetLinksIdUpdatesParams defines parameters for GetLinksIdUpdates.
+type GetLinksIdUpdatesParams struct {
+	// Limit Размер страницы, по умолчанию 20, не больше 100
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько последних обновлений пропустить
+	Offset   *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+	TgChatId int64  `json:"Tg-Chat-Id"`
+}
+
+// GetSearchParams defines parameters for GetSearch.
+type GetSearchParams struct {
+	// Q Слова запроса, каждое ищется как префикс
+	Q string `form:"q" json:"q"`
+
+	// Limit Число результатов, по умолчанию 10, не больше 50
+	Limit    *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	TgChatId int64  `json:"Tg-Chat-Id"`
+}
+
+// GetSettingsParams defines parameters for GetSettings.
+type GetSettingsParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// PutSettingsParams defines parameters for PutSettings.
+type PutSettingsParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// PutSettingsTagsParams defines parameters for PutSettingsTags.
+type PutSettingsTagsParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
 // DeleteStatesParams defines parameters for DeleteStates.
 type DeleteStatesParams struct {
 	TgChatId int64 `json:"Tg-Chat-Id"`
@@ -94,6 +391,27 @@ type PutStatesParams struct {
 	TgChatId int64 `json:"Tg-Chat-Id"`
 }
 
+// GetTagsParams defines parameters for GetTags.
+type GetTagsParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// PutTagsTagParams defines parameters for PutTagsTag.
+type PutTagsTagParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// DeleteTagsTagLinksParams defines parameters for DeleteTagsTagLinks.
+type DeleteTagsTagLinksParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// GetAdminLinksUnhealthyParams defines parameters for GetAdminLinksUnhealthy.
+type GetAdminLinksUnhealthyParams struct {
+	// MinFailures Минимальное число ошибок проверки подряд, по умолчанию 1
+	MinFailures *int64 `form:"minFailures,omitempty" json:"minFailures,omitempty"`
+}
+
 // DeleteLinksJSONRequestBody defines body for DeleteLinks for application/json ContentType.
 type DeleteLinksJSONRequestBody = RemoveLinkRequest
 
@@ -103,8 +421,29 @@ type PostLinksJSONRequestBody = LinkRequest
 // PutLinksJSONRequestBody defines body for PutLinks for application/json ContentType.
 type PutLinksJSONRequestBody = LinkRequest
 
+// PostLinksBatchJSONRequestBody defines body for PostLinksBatch for application/json ContentType.
+type PostLinksBatchJSONRequestBody = BatchLinksRequest
+
+// PostLinksPauseJSONRequestBody defines body for PostLinksPause for application/json ContentType.
+type PostLinksPauseJSONRequestBody = PauseRequest
+
+// PostLinksResumeJSONRequestBody defines body for PostLinksResume for application/json ContentType.
+type PostLinksResumeJSONRequestBody = ResumeRequest
+
+// PutSettingsJSONRequestBody defines body for PutSettings for application/json ContentType.
+type PutSettingsJSONRequestBody = SettingsRequest
+
+// PutSettingsTagsJSONRequestBody defines body for PutSettingsTags for application/json ContentType.
+type PutSettingsTagsJSONRequestBody = TagModeRequest
+
 // PostStatesJSONRequestBody defines body for PostStates for application/json ContentType.
 type PostStatesJSONRequestBody = StateRequest
 
 // PutStatesJSONRequestBody defines body for PutStates for application/json ContentType.
 type PutStatesJSONRequestBody = StateRequest
+
+// PutTagsTagJSONRequestBody defines body for PutTagsTag for application/json ContentType.
+type PutTagsTagJSONRequestBody = RenameTagRequest
+
+// PostAdminChecksJSONRequestBody defines body for PostAdminChecks for application/json ContentType.
+type PostAdminChecksJSONRequestBody = CheckLinkRequest
@@ -0,0 +1,26 @@
+// Package admin содержит служебные обработчики scrapper для оператора: пользователи, внеплановые
+// проверки ссылок, ссылки с ошибками проверки и управление планировщиком. Все они доступны только
+// с токеном администратора.
+package admin
+
+import (
+	"net/http"
+
+	"LinkTracker/internal/infrastructure/httpapi"
+	"LinkTracker/internal/infrastructure/serviceauth"
+)
+
+// RequireToken пропускает к next только запросы с заголовком "Authorization: Bearer <token>".
+func RequireToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !serviceauth.HasBearerToken(r, token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			httpapi.SendErrorResponse(w, http.StatusUnauthorized, "401",
+				"Invalid or missing admin token", "admin token required", "UNAUTHORIZED")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
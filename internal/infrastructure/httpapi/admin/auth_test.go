@@ -0,0 +1,44 @@
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+)
+
+func Test_RequireToken(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := admin.RequireToken("secret", next)
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      int
+	}{
+		{name: "valid token", authorization: "Bearer secret", wantCode: http.StatusNoContent},
+		{name: "missing header", authorization: "", wantCode: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer secret2", wantCode: http.StatusUnauthorized},
+		{name: "wrong scheme", authorization: "Basic secret", wantCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/admin/users", http.NoBody)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type SchedulerStatusGetter interface {
+	GetSchedulerStatus(ctx context.Context) (domain.SchedulerStatus, error)
+}
+
+// GetSchedulerHandler возвращает состояние планировщика и итоги последнего прохода проверки ссылок.
+type GetSchedulerHandler struct {
+	SchedulerStatusGetter SchedulerStatusGetter
+}
+
+func (h GetSchedulerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status, err := h.SchedulerStatusGetter.GetSchedulerStatus(r.Context())
+	if err != nil {
+		sendSchedulerError(w, err, "Scheduler status not received", "SCHEDULER_STATUS_NOT_RECEIVED")
+		return
+	}
+
+	sendSchedulerStatus(w, &status)
+}
+
+func sendSchedulerStatus(w http.ResponseWriter, status *domain.SchedulerStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err := json.NewEncoder(w).Encode(dto.SchedulerStatusToSchedulerStatusResponseDTO(status))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+// sendSchedulerError отвечает 503, если планировщик не запущен, и 500 на остальные ошибки.
+func sendSchedulerError(w http.ResponseWriter, err error, description, name string) {
+	if errors.As(err, &domain.ErrSchedulerNotRunning{}) {
+		httpapi.SendErrorResponse(w, http.StatusServiceUnavailable, "503",
+			"Scheduler not running", err.Error(), "SCHEDULER_NOT_RUNNING")
+
+		return
+	}
+
+	httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500", description, err.Error(), name)
+}
@@ -0,0 +1,80 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_GetSchedulerHandler_Success(t *testing.T) {
+	ctx := context.Background()
+	lastRunAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	status := domain.SchedulerStatus{
+		LastRunAt:       lastRunAt,
+		LastRunDuration: 1500 * time.Millisecond,
+		LastRun:         domain.ScrapeStats{Checked: 10, Failed: 2},
+		NextRunAt:       lastRunAt.Add(30 * time.Minute),
+	}
+
+	statusGetter := &mocks.SchedulerStatusGetter{}
+	statusGetter.On("GetSchedulerStatus", ctx).Return(status, nil).Once()
+	handler := admin.GetSchedulerHandler{SchedulerStatusGetter: statusGetter}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/scheduler", http.NoBody))
+
+	var statusResponse scrapperdto.SchedulerStatusResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statusResponse))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, *statusResponse.Paused)
+	assert.False(t, *statusResponse.Running)
+	assert.Equal(t, lastRunAt, *statusResponse.LastRunAt)
+	assert.Equal(t, int64(1500), *statusResponse.LastRunDurationMs)
+	assert.Equal(t, int64(10), *statusResponse.Checked)
+	assert.Equal(t, int64(2), *statusResponse.Failed)
+	assert.Equal(t, status.NextRunAt, *statusResponse.NextRunAt)
+	statusGetter.AssertExpectations(t)
+}
+
+func Test_GetSchedulerHandler_NeverRun(t *testing.T) {
+	ctx := context.Background()
+
+	statusGetter := &mocks.SchedulerStatusGetter{}
+	statusGetter.On("GetSchedulerStatus", ctx).Return(domain.SchedulerStatus{}, nil).Once()
+	handler := admin.GetSchedulerHandler{SchedulerStatusGetter: statusGetter}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/scheduler", http.NoBody))
+
+	var statusResponse scrapperdto.SchedulerStatusResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statusResponse))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, statusResponse.LastRunAt)
+	assert.Nil(t, statusResponse.NextRunAt)
+}
+
+func Test_GetSchedulerHandler_NotRunning(t *testing.T) {
+	ctx := context.Background()
+
+	statusGetter := &mocks.SchedulerStatusGetter{}
+	statusGetter.On("GetSchedulerStatus", ctx).Return(domain.SchedulerStatus{}, domain.ErrSchedulerNotRunning{}).Once()
+	handler := admin.GetSchedulerHandler{SchedulerStatusGetter: statusGetter}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/scheduler", http.NoBody))
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type UnhealthyLinksGetter interface {
+	GetUnhealthyLinks(ctx context.Context, minFailures int64) ([]domain.Link, error)
+}
+
+// GetUnhealthyLinksHandler возвращает ссылки всех пользователей, проверка которых завершается ошибкой
+// не меньше minFailures раз подряд.
+type GetUnhealthyLinksHandler struct {
+	UnhealthyLinksGetter UnhealthyLinksGetter
+}
+
+func (h GetUnhealthyLinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	minFailures, err := parseMinFailures(r.URL.Query().Get("minFailures"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid minFailures", err.Error(), "INVALID_MIN_FAILURES")
+
+		return
+	}
+
+	links, err := h.UnhealthyLinksGetter.GetUnhealthyLinks(r.Context(), max(minFailures, 1))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+			"Links not received", err.Error(), "LINKS_NOT_RECEIVED")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(dto.LinksToListLinksResponseDTO(links))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+// parseMinFailures разбирает параметр minFailures; пустое значение означает значение по умолчанию.
+func parseMinFailures(raw string) (int64, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if value < 0 {
+		return 0, errors.New("minFailures must not be negative")
+	}
+
+	return value, nil
+}
@@ -0,0 +1,89 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_GetUnhealthyLinksHandler_Success(t *testing.T) {
+	ctx := context.Background()
+	checkedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	unhealthy := []domain.Link{{
+		ID:  7,
+		URL: "https://github.com/owner/repo",
+		Health: domain.LinkHealth{
+			LastCheckedAt:       checkedAt,
+			LastError:           "status not ok, status code [500]",
+			ConsecutiveFailures: 4,
+		},
+	}}
+
+	unhealthyLinksGetter := &mocks.UnhealthyLinksGetter{}
+	unhealthyLinksGetter.On("GetUnhealthyLinks", ctx, int64(3)).Return(unhealthy, nil)
+	handler := admin.GetUnhealthyLinksHandler{UnhealthyLinksGetter: unhealthyLinksGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/links/unhealthy?minFailures=3", http.NoBody)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var listLinksResponse scrapperdto.ListLinksResponse
+	err := json.Unmarshal(w.Body.Bytes(), &listLinksResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.NotNil(t, listLinksResponse.Links)
+	require.Len(t, *listLinksResponse.Links, 1)
+
+	health := (*listLinksResponse.Links)[0].Health
+	require.NotNil(t, health)
+	assert.Equal(t, int64(4), *health.ConsecutiveFailures)
+	assert.Equal(t, "status not ok, status code [500]", *health.LastError)
+	assert.Equal(t, checkedAt, *health.LastCheckedAt)
+}
+
+func Test_GetUnhealthyLinksHandler_DefaultMinFailures(t *testing.T) {
+	ctx := context.Background()
+
+	unhealthyLinksGetter := &mocks.UnhealthyLinksGetter{}
+	unhealthyLinksGetter.On("GetUnhealthyLinks", ctx, int64(1)).Return([]domain.Link{}, nil)
+	handler := admin.GetUnhealthyLinksHandler{UnhealthyLinksGetter: unhealthyLinksGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/links/unhealthy", http.NoBody)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	unhealthyLinksGetter.AssertExpectations(t)
+}
+
+func Test_GetUnhealthyLinksHandler_InvalidMinFailures(t *testing.T) {
+	ctx := context.Background()
+	handler := admin.GetUnhealthyLinksHandler{UnhealthyLinksGetter: &mocks.UnhealthyLinksGetter{}}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/links/unhealthy?minFailures=-1", http.NoBody)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_MIN_FAILURES", *responseErrorBody.ExceptionName)
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type UsersLister interface {
+	ListUsers(ctx context.Context) ([]domain.UserLinkCount, error)
+}
+
+// GetUsersHandler возвращает всех пользователей с числом отслеживаемых ими ссылок.
+type GetUsersHandler struct {
+	UsersLister UsersLister
+}
+
+func (h GetUsersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	users, err := h.UsersLister.ListUsers(r.Context())
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+			"Users not received", err.Error(), "USERS_NOT_RECEIVED")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(dto.UserLinkCountsToListAdminUsersResponseDTO(users))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
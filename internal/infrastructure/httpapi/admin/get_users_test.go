@@ -0,0 +1,55 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_GetUsersHandler_Success(t *testing.T) {
+	ctx := context.Background()
+
+	usersLister := &mocks.UsersLister{}
+	usersLister.On("ListUsers", ctx).Return([]domain.UserLinkCount{{TgID: 1, Links: 3}, {TgID: 2}}, nil).Once()
+	handler := admin.GetUsersHandler{UsersLister: usersLister}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/users", http.NoBody)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var usersResponse scrapperdto.ListAdminUsersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &usersResponse))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, usersResponse.Users)
+	require.Len(t, *usersResponse.Users, 2)
+	assert.Equal(t, int64(1), *(*usersResponse.Users)[0].TgId)
+	assert.Equal(t, int64(3), *(*usersResponse.Users)[0].Links)
+	assert.Equal(t, int32(2), *usersResponse.Size)
+	usersLister.AssertExpectations(t)
+}
+
+func Test_GetUsersHandler_Error(t *testing.T) {
+	ctx := context.Background()
+
+	usersLister := &mocks.UsersLister{}
+	usersLister.On("ListUsers", ctx).Return(nil, errors.New("connection refused")).Once()
+	handler := admin.GetUsersHandler{UsersLister: usersLister}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/users", http.NoBody))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type LinkChecker interface {
+	CheckLinkNow(ctx context.Context, rawURL string) (domain.Link, error)
+}
+
+// PostChecksHandler сразу проверяет отслеживаемую ссылку. Ошибка самой проверки не считается ошибкой
+// запроса: она возвращается с кодом 200 и outcome failed.
+type PostChecksHandler struct {
+	LinkChecker LinkChecker
+}
+
+func (h PostChecksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var checkRequest scrapperdto.CheckLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&checkRequest); err != nil || checkRequest.Link == nil {
+		description := "link is required"
+		if err != nil {
+			description = err.Error()
+		}
+
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", description, "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	link, err := h.LinkChecker.CheckLinkNow(r.Context(), *checkRequest.Link)
+
+	switch {
+	case errors.As(err, &domain.ErrWrongURL{}):
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid link", err.Error(), "INVALID_LINK")
+
+		return
+	case errors.As(err, &domain.ErrLinkNotExist{}):
+		httpapi.SendErrorResponse(w, http.StatusNotFound, "404",
+			"Link not found", err.Error(), "LINK_NOT_EXIST")
+
+		return
+	case err != nil && link.ID == 0:
+		httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+			"Link not checked", err.Error(), "LINK_NOT_CHECKED")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(dto.LinkCheckToCheckLinkResponseDTO(&link, err))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,99 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_PostChecksHandler_Outcome(t *testing.T) {
+	link := domain.Link{ID: 7, URL: "https://github.com/owner/repo"}
+
+	tests := []struct {
+		name        string
+		checkErr    error
+		wantOutcome scrapperdto.CheckLinkResponseOutcome
+		wantError   bool
+	}{
+		{name: "updated", checkErr: nil, wantOutcome: scrapperdto.Updated},
+		{name: "no updates", checkErr: domain.ErrUpdatesNotFound{}, wantOutcome: scrapperdto.NoUpdates},
+		{name: "failed", checkErr: domain.ErrStatusNotOK{StatusCode: 500}, wantOutcome: scrapperdto.Failed, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			linkChecker := &mocks.LinkChecker{}
+			linkChecker.On("CheckLinkNow", ctx, link.URL).Return(link, tt.checkErr).Once()
+			handler := admin.PostChecksHandler{LinkChecker: linkChecker}
+
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/checks",
+				strings.NewReader(`{"link":"https://github.com/owner/repo"}`))
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			var checkResponse scrapperdto.CheckLinkResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &checkResponse))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.wantOutcome, *checkResponse.Outcome)
+			assert.Equal(t, link.ID, *checkResponse.Link.Id)
+			assert.Equal(t, tt.wantError, checkResponse.Error != nil)
+			linkChecker.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_PostChecksHandler_Errors(t *testing.T) {
+	const repoURL = "https://github.com/owner/repo"
+
+	tests := []struct {
+		name     string
+		body     string
+		url      string
+		checkErr error
+		wantCode int
+	}{
+		{name: "missing link", body: `{}`, wantCode: http.StatusBadRequest},
+		{name: "invalid body", body: `{`, wantCode: http.StatusBadRequest},
+		{name: "wrong url", body: `{"link":"ftp://example.com"}`, url: "ftp://example.com", checkErr: domain.ErrWrongURL{},
+			wantCode: http.StatusBadRequest},
+		{name: "not tracked", body: `{"link":"` + repoURL + `"}`, url: repoURL, checkErr: domain.ErrLinkNotExist{},
+			wantCode: http.StatusNotFound},
+		{name: "repository error", body: `{"link":"` + repoURL + `"}`, url: repoURL, checkErr: errors.New("connection refused"),
+			wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			linkChecker := &mocks.LinkChecker{}
+			if tt.checkErr != nil {
+				linkChecker.On("CheckLinkNow", ctx, tt.url).Return(domain.Link{}, tt.checkErr).Once()
+			}
+
+			handler := admin.PostChecksHandler{LinkChecker: linkChecker}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/checks", strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			linkChecker.AssertExpectations(t)
+		})
+	}
+}
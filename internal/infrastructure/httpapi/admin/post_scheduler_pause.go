@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+)
+
+type SchedulerPauser interface {
+	PauseScheduler(ctx context.Context) (domain.SchedulerStatus, error)
+}
+
+// PostSchedulerPauseHandler приостанавливает плановые проходы проверки ссылок и возвращает состояние планировщика.
+type PostSchedulerPauseHandler struct {
+	SchedulerPauser SchedulerPauser
+}
+
+func (h PostSchedulerPauseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status, err := h.SchedulerPauser.PauseScheduler(r.Context())
+	if err != nil {
+		sendSchedulerError(w, err, "Scheduler not paused", "SCHEDULER_NOT_PAUSED")
+		return
+	}
+
+	sendSchedulerStatus(w, &status)
+}
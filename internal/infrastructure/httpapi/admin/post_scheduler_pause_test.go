@@ -0,0 +1,35 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_PostSchedulerPauseHandler_Success(t *testing.T) {
+	ctx := context.Background()
+
+	schedulerPauser := &mocks.SchedulerPauser{}
+	schedulerPauser.On("PauseScheduler", ctx).Return(domain.SchedulerStatus{Paused: true}, nil).Once()
+	handler := admin.PostSchedulerPauseHandler{SchedulerPauser: schedulerPauser}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/scheduler/pause", http.NoBody))
+
+	var statusResponse scrapperdto.SchedulerStatusResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statusResponse))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, *statusResponse.Paused)
+	schedulerPauser.AssertExpectations(t)
+}
@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+)
+
+type SchedulerResumer interface {
+	ResumeScheduler(ctx context.Context) (domain.SchedulerStatus, error)
+}
+
+// PostSchedulerResumeHandler возобновляет плановые проходы проверки ссылок и возвращает состояние планировщика.
+type PostSchedulerResumeHandler struct {
+	SchedulerResumer SchedulerResumer
+}
+
+func (h PostSchedulerResumeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status, err := h.SchedulerResumer.ResumeScheduler(r.Context())
+	if err != nil {
+		sendSchedulerError(w, err, "Scheduler not resumed", "SCHEDULER_NOT_RESUMED")
+		return
+	}
+
+	sendSchedulerStatus(w, &status)
+}
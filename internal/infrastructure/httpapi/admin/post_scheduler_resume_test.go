@@ -0,0 +1,48 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_PostSchedulerResumeHandler_Success(t *testing.T) {
+	ctx := context.Background()
+
+	schedulerResumer := &mocks.SchedulerResumer{}
+	schedulerResumer.On("ResumeScheduler", ctx).Return(domain.SchedulerStatus{}, nil).Once()
+	handler := admin.PostSchedulerResumeHandler{SchedulerResumer: schedulerResumer}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/scheduler/resume", http.NoBody))
+
+	var statusResponse scrapperdto.SchedulerStatusResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statusResponse))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, *statusResponse.Paused)
+	schedulerResumer.AssertExpectations(t)
+}
+
+func Test_PostSchedulerResumeHandler_NotRunning(t *testing.T) {
+	ctx := context.Background()
+
+	schedulerResumer := &mocks.SchedulerResumer{}
+	schedulerResumer.On("ResumeScheduler", ctx).Return(domain.SchedulerStatus{}, domain.ErrSchedulerNotRunning{}).Once()
+	handler := admin.PostSchedulerResumeHandler{SchedulerResumer: schedulerResumer}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/scheduler/resume", http.NoBody))
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type ScrapeTrigger interface {
+	TriggerScrape(ctx context.Context) error
+}
+
+// PostScrapeHandler запускает внеплановый проход проверки всех ссылок и отвечает 202, не дожидаясь его конца.
+type PostScrapeHandler struct {
+	ScrapeTrigger ScrapeTrigger
+}
+
+func (h PostScrapeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	err := h.ScrapeTrigger.TriggerScrape(r.Context())
+	if errors.As(err, &domain.ErrScrapeInProgress{}) {
+		httpapi.SendErrorResponse(w, http.StatusConflict, "409",
+			"Scrape already in progress", err.Error(), "SCRAPE_IN_PROGRESS")
+
+		return
+	}
+
+	if err != nil {
+		sendSchedulerError(w, err, "Scrape not triggered", "SCRAPE_NOT_TRIGGERED")
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,42 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/admin/mocks"
+)
+
+func Test_PostScrapeHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{name: "triggered", err: nil, wantCode: http.StatusAccepted},
+		{name: "in progress", err: domain.ErrScrapeInProgress{}, wantCode: http.StatusConflict},
+		{name: "scheduler not running", err: domain.ErrSchedulerNotRunning{}, wantCode: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scrapeTrigger := &mocks.ScrapeTrigger{}
+			scrapeTrigger.On("TriggerScrape", ctx).Return(tt.err).Once()
+			handler := admin.PostScrapeHandler{ScrapeTrigger: scrapeTrigger}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/scrape", http.NoBody))
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			scrapeTrigger.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// checkTimeout ограничивает каждую проверку готовности, чтобы зависшая зависимость не задерживала ответ.
+const checkTimeout = 2 * time.Second
+
+const (
+	StatusOK       = "ok"
+	StatusNotReady = "not_ready"
+	StatusUp       = "up"
+	StatusDown     = "down"
+)
+
+// Checker проверяет одну зависимость сервиса; nil означает, что она доступна.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc позволяет использовать функцию как Checker.
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Component — именованная зависимость, которую проверяет GetReadinessHandler.
+type Component struct {
+	Name    string
+	Checker Checker
+}
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Response struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+}
+
+// GetLivenessHandler отвечает на /healthz: процесс запущен и принимает запросы. Зависимости не проверяются,
+// чтобы перезапуск сервиса не зависел от их доступности.
+type GetLivenessHandler struct{}
+
+func (h GetLivenessHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	sendResponse(w, http.StatusOK, Response{Status: StatusOK})
+}
+
+// GetReadinessHandler отвечает на /readyz: проверяет все Components параллельно и возвращает статус каждой.
+// Если хотя бы одна зависимость недоступна, ответ 503.
+type GetReadinessHandler struct {
+	Components []Component
+}
+
+func (h GetReadinessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	statuses := make(map[string]ComponentStatus, len(h.Components))
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for _, component := range h.Components {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+			defer cancel()
+
+			status := ComponentStatus{Status: StatusUp}
+			if err := component.Checker.Check(ctx); err != nil {
+				slog.Warn("Readiness check failed", "component", component.Name, "error", err.Error())
+				status = ComponentStatus{Status: StatusDown, Error: err.Error()}
+			}
+
+			mu.Lock()
+			statuses[component.Name] = status
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	response := Response{Status: StatusOK, Components: statuses}
+	statusCode := http.StatusOK
+
+	for _, status := range statuses {
+		if status.Status == StatusDown {
+			response.Status = StatusNotReady
+			statusCode = http.StatusServiceUnavailable
+		}
+	}
+
+	sendResponse(w, statusCode, response)
+}
+
+func sendResponse(w http.ResponseWriter, statusCode int, response Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		slog.Error(err.Error())
+	}
+}
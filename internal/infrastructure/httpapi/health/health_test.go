@@ -0,0 +1,101 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/infrastructure/httpapi/health"
+	"LinkTracker/internal/infrastructure/httpapi/health/mocks"
+)
+
+func Test_GetLivenessHandler_ServeHTTP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody)
+	w := httptest.NewRecorder()
+
+	health.GetLivenessHandler{}.ServeHTTP(w, r)
+
+	var response health.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, health.StatusOK, response.Status)
+	assert.Empty(t, response.Components)
+}
+
+func Test_GetReadinessHandler_ServeHTTP_Ready(t *testing.T) {
+	postgres := &mocks.Checker{}
+	postgres.On("Check", mock.Anything).Return(nil).Once()
+
+	handler := health.GetReadinessHandler{Components: []health.Component{
+		{Name: "postgres", Checker: postgres},
+		{Name: "scheduler", Checker: health.CheckerFunc(func(context.Context) error { return nil })},
+	}}
+
+	r := httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var response health.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, health.StatusOK, response.Status)
+	assert.Equal(t, map[string]health.ComponentStatus{
+		"postgres":  {Status: health.StatusUp},
+		"scheduler": {Status: health.StatusUp},
+	}, response.Components)
+	postgres.AssertExpectations(t)
+}
+
+func Test_GetReadinessHandler_ServeHTTP_NotReady(t *testing.T) {
+	postgres := &mocks.Checker{}
+	postgres.On("Check", mock.Anything).Return(nil).Once()
+
+	bot := &mocks.Checker{}
+	bot.On("Check", mock.Anything).Return(errors.New("connection refused")).Once()
+
+	handler := health.GetReadinessHandler{Components: []health.Component{
+		{Name: "postgres", Checker: postgres},
+		{Name: "bot", Checker: bot},
+	}}
+
+	r := httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var response health.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, health.StatusNotReady, response.Status)
+	assert.Equal(t, health.ComponentStatus{Status: health.StatusUp}, response.Components["postgres"])
+	assert.Equal(t, health.ComponentStatus{Status: health.StatusDown, Error: "connection refused"}, response.Components["bot"])
+	postgres.AssertExpectations(t)
+	bot.AssertExpectations(t)
+}
+
+func Test_GetReadinessHandler_ServeHTTP_CheckTimeout(t *testing.T) {
+	handler := health.GetReadinessHandler{Components: []health.Component{
+		{Name: "telegram", Checker: health.CheckerFunc(func(ctx context.Context) error {
+			_, ok := ctx.Deadline()
+			assert.True(t, ok, "проверка должна выполняться с таймаутом")
+
+			return nil
+		})},
+	}}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
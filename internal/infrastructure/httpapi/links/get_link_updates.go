@@ -0,0 +1,90 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type LinkUpdatesGetter interface {
+	GetLinkUpdates(ctx context.Context, tgID, linkID, limit, offset int64) ([]domain.UpdateRecord, int64, error)
+}
+
+type GetLinkUpdatesHandler struct {
+	LinkUpdatesGetter LinkUpdatesGetter
+}
+
+func (h GetLinkUpdatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	linkID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid link id", err.Error(), "INVALID_LINK_ID")
+
+		return
+	}
+
+	limit, err := parsePageParam(r.URL.Query(), "limit")
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid pagination", err.Error(), "INVALID_PAGINATION")
+
+		return
+	}
+
+	offset, err := parsePageParam(r.URL.Query(), "offset")
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid pagination", err.Error(), "INVALID_PAGINATION")
+
+		return
+	}
+
+	records, total, err := h.LinkUpdatesGetter.GetLinkUpdates(r.Context(), tgID, linkID, limit, offset)
+	if err != nil {
+		sendLinkError(w, err, "Updates not received", "UPDATES_NOT_RECEIVED")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.UpdateRecordsToListUpdatesResponseDTO(records, total))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+// parsePageParam разбирает необязательный неотрицательный параметр пагинации. Отсутствующий параметр равен нулю.
+func parsePageParam(query url.Values, name string) (int64, error) {
+	raw := query.Get(name)
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if value < 0 {
+		return 0, fmt.Errorf("%s must not be negative", name)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,103 @@
+package links_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/links"
+	"LinkTracker/internal/infrastructure/httpapi/links/mocks"
+)
+
+func Test_GetLinkUpdatesHandler_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	detectedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	records := []domain.UpdateRecord{{
+		ID:          10,
+		LinkID:      7,
+		Description: "New answer",
+		Details:     domain.UpdateDetails{Kind: "Answer", Title: "How to", Author: "user"},
+		DetectedAt:  detectedAt,
+	}}
+
+	linkUpdatesGetter := &mocks.LinkUpdatesGetter{}
+	linkUpdatesGetter.On("GetLinkUpdates", ctx, tgID, int64(7), int64(5), int64(10)).Return(records, int64(11), nil)
+	handler := links.GetLinkUpdatesHandler{LinkUpdatesGetter: linkUpdatesGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/links/7/updates?limit=5&offset=10", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.SetPathValue("id", "7")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var listUpdatesResponse scrapperdto.ListUpdatesResponse
+	err := json.Unmarshal(w.Body.Bytes(), &listUpdatesResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int64(11), *listUpdatesResponse.Total)
+	require.NotNil(t, listUpdatesResponse.Updates)
+	require.Len(t, *listUpdatesResponse.Updates, 1)
+	assert.Equal(t, "Answer", *(*listUpdatesResponse.Updates)[0].Kind)
+	assert.Equal(t, detectedAt, *(*listUpdatesResponse.Updates)[0].DetectedAt)
+	assert.Nil(t, (*listUpdatesResponse.Updates)[0].CreatedAt)
+}
+
+func Test_GetLinkUpdatesHandler_LinkNotExist(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	linkUpdatesGetter := &mocks.LinkUpdatesGetter{}
+	linkUpdatesGetter.On("GetLinkUpdates", ctx, tgID, int64(7), int64(0), int64(0)).
+		Return(nil, int64(0), domain.ErrLinkNotExist{})
+	handler := links.GetLinkUpdatesHandler{LinkUpdatesGetter: linkUpdatesGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/links/7/updates", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.SetPathValue("id", "7")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "LINK_NOT_EXIST", *responseErrorBody.ExceptionName)
+}
+
+func Test_GetLinkUpdatesHandler_InvalidPagination(t *testing.T) {
+	ctx := context.Background()
+
+	linkUpdatesGetter := &mocks.LinkUpdatesGetter{}
+	handler := links.GetLinkUpdatesHandler{LinkUpdatesGetter: linkUpdatesGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/links/7/updates?limit=-1", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", "123")
+	r.SetPathValue("id", "7")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_PAGINATION", *responseErrorBody.ExceptionName)
+	linkUpdatesGetter.AssertNotCalled(t, "GetLinkUpdates")
+}
@@ -15,6 +15,7 @@ import (
 
 type LinkGetter interface {
 	GetUserLinks(ctx context.Context, tgID int64) ([]domain.Link, error)
+	GetUserLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error)
 }
 
 type GetLinksHandler struct {
@@ -30,7 +31,14 @@ func (h GetLinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	links, err := h.LinkGetter.GetUserLinks(r.Context(), tgChatID)
+	var links []domain.Link
+
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		links, err = h.LinkGetter.GetUserLinksByTag(r.Context(), tgChatID, tag)
+	} else {
+		links, err = h.LinkGetter.GetUserLinks(r.Context(), tgChatID)
+	}
+
 	if err != nil {
 		if errors.As(err, &domain.ErrUserNotExist{}) {
 			httpapi.SendErrorResponse(w, http.StatusBadRequest, "404",
@@ -123,3 +123,31 @@ func Test_GetLinksHandler_Success(t *testing.T) {
 	assert.Equal(t, link2.URL, *(*listLinksResponse.Links)[1].Url)
 	assert.Equal(t, link2.ID, *(*listLinksResponse.Links)[1].Id)
 }
+
+func Test_GetLinksHandler_FilterByTag(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	link := domain.Link{URL: "https://example/example", Tags: []string{"work"}, ID: 1}
+
+	linkGetter := &mocks.LinkGetter{}
+	linkGetter.On("GetUserLinksByTag", ctx, tgID, "work").Return([]domain.Link{link}, nil)
+	getLinksHandler := links.GetLinksHandler{LinkGetter: linkGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/links?tag=work", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	getLinksHandler.ServeHTTP(w, r)
+
+	var listLinksResponse scrapperdto.ListLinksResponse
+	err := json.Unmarshal(w.Body.Bytes(), &listLinksResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, listLinksResponse.Links)
+	require.Len(t, *listLinksResponse.Links, 1)
+	assert.Equal(t, link.URL, *(*listLinksResponse.Links)[0].Url)
+	linkGetter.AssertNotCalled(t, "GetUserLinks")
+}
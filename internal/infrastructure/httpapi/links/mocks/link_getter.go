@@ -81,6 +81,66 @@ func (_c *LinkGetter_GetUserLinks_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// GetUserLinksByTag provides a mock function with given fields: ctx, tgID, tag
+func (_m *LinkGetter) GetUserLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error) {
+	ret := _m.Called(ctx, tgID, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserLinksByTag")
+	}
+
+	var r0 []domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]domain.Link, error)); ok {
+		return rf(ctx, tgID, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []domain.Link); ok {
+		r0 = rf(ctx, tgID, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, tgID, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkGetter_GetUserLinksByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserLinksByTag'
+type LinkGetter_GetUserLinksByTag_Call struct {
+	*mock.Call
+}
+
+// GetUserLinksByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgID int64
+//   - tag string
+func (_e *LinkGetter_Expecter) GetUserLinksByTag(ctx interface{}, tgID interface{}, tag interface{}) *LinkGetter_GetUserLinksByTag_Call {
+	return &LinkGetter_GetUserLinksByTag_Call{Call: _e.mock.On("GetUserLinksByTag", ctx, tgID, tag)}
+}
+
+func (_c *LinkGetter_GetUserLinksByTag_Call) Run(run func(ctx context.Context, tgID int64, tag string)) *LinkGetter_GetUserLinksByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LinkGetter_GetUserLinksByTag_Call) Return(_a0 []domain.Link, _a1 error) *LinkGetter_GetUserLinksByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkGetter_GetUserLinksByTag_Call) RunAndReturn(run func(context.Context, int64, string) ([]domain.Link, error)) *LinkGetter_GetUserLinksByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLinkGetter creates a new instance of LinkGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLinkGetter(t interface {
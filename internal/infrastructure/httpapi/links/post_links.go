@@ -56,6 +56,12 @@ func (h PostLinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		case errors.As(err, &domain.ErrLinkAlreadyTracking{}):
 			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
 				"Links already tracking", err.Error(), "LINKS_ALREADY_EXIST")
+		case errors.As(err, &domain.ErrWrongURL{}), errors.As(err, &domain.ErrUnsupportedHost{}):
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+				"Link is not supported", err.Error(), "UNSUPPORTED_LINK")
+		case errors.As(err, &domain.ErrSourceNotFound{}):
+			httpapi.SendErrorResponse(w, http.StatusUnprocessableEntity, "422",
+				"Repository or question not found", err.Error(), "SOURCE_NOT_FOUND")
 		default:
 			httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
 				"Failed to added link", err.Error(), "ADD_LINK_FAILED")
@@ -0,0 +1,80 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type LinksBatchAdder interface {
+	AddLinks(ctx context.Context, tgID int64, links []domain.Link) ([]domain.LinkImportResult, error)
+}
+
+type PostLinksBatchHandler struct {
+	LinksBatchAdder LinksBatchAdder
+}
+
+func (h PostLinksBatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var batchRequest scrapperdto.BatchLinksRequest
+	if err = json.NewDecoder(r.Body).Decode(&batchRequest); err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", err.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	links, err := dto.BatchLinksRequestDTOToLinks(batchRequest)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Missing required fields", err.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	if len(links) > domain.MaxImportLinks {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Too many links", fmt.Sprintf("batch size %d exceeds limit %d", len(links), domain.MaxImportLinks),
+			"BATCH_TOO_LARGE")
+
+		return
+	}
+
+	results, err := h.LinksBatchAdder.AddLinks(r.Context(), tgID, links)
+	if err != nil {
+		if errors.As(err, &domain.ErrUserNotExist{}) {
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "404",
+				"User not exist", err.Error(), "USER_NOT_EXIST")
+
+			return
+		}
+
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
+			"Failed to added links", err.Error(), "ADD_LINKS_FAILED")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(dto.LinkImportResultsToBatchLinksResponseDTO(results))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,155 @@
+package links_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/links"
+	"LinkTracker/internal/infrastructure/httpapi/links/mocks"
+)
+
+func TestPostLinksBatchHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	newLinks := []domain.Link{
+		{URL: "https://example.com/first", Tags: []string{"tag"}, Filters: []string{}},
+		{URL: "https://example.com/second", Tags: []string{}, Filters: []string{"filter"}},
+	}
+	results := []domain.LinkImportResult{
+		{Link: domain.Link{URL: "https://example.com/first", Tags: []string{"tag"}, Filters: []string{}, ID: 1}},
+		{Link: newLinks[1], Error: domain.ErrLinkAlreadyTracking{}.Error()},
+	}
+	payload, err := json.Marshal(dto.LinksToBatchLinksRequestDTO(newLinks))
+	require.NoError(t, err)
+
+	linksBatchAdder := &mocks.LinksBatchAdder{}
+	linksBatchAdder.On("AddLinks", ctx, tgID, newLinks).Return(results, nil)
+	postLinksBatchHandler := links.PostLinksBatchHandler{LinksBatchAdder: linksBatchAdder}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/batch", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.Header.Set("content-type", "application/json")
+
+	w := httptest.NewRecorder()
+
+	postLinksBatchHandler.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var batchResponse scrapperdto.BatchLinksResponse
+	err = json.Unmarshal(w.Body.Bytes(), &batchResponse)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), *batchResponse.Added)
+	assert.Equal(t, int32(1), *batchResponse.Failed)
+	assert.Equal(t, results, dto.BatchLinksResponseDTOToLinkImportResults(batchResponse))
+	linksBatchAdder.AssertExpectations(t)
+}
+
+func TestPostLinksBatchHandler_ServeHTTP_InvalidTgID(t *testing.T) {
+	ctx := context.Background()
+	linksBatchAdder := &mocks.LinksBatchAdder{}
+	postLinksBatchHandler := links.PostLinksBatchHandler{LinksBatchAdder: linksBatchAdder}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/batch", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", "invalidTgID")
+
+	w := httptest.NewRecorder()
+
+	postLinksBatchHandler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_TG_ID", *responseErrorBody.ExceptionName)
+}
+
+func TestPostLinksBatchHandler_ServeHTTP_MissingLink(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	payload := []byte(`{"links":[{"link":"https://example.com/first"},{"tags":["tag"]}]}`)
+
+	linksBatchAdder := &mocks.LinksBatchAdder{}
+	postLinksBatchHandler := links.PostLinksBatchHandler{LinksBatchAdder: linksBatchAdder}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/batch", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksBatchHandler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "Missing required fields", *responseErrorBody.Description)
+	linksBatchAdder.AssertNotCalled(t, "AddLinks")
+}
+
+func TestPostLinksBatchHandler_ServeHTTP_AddLinksError(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	newLinks := []domain.Link{{URL: "https://example.com/first", Tags: []string{}, Filters: []string{}}}
+	payload, err := json.Marshal(dto.LinksToBatchLinksRequestDTO(newLinks))
+	require.NoError(t, err)
+
+	linksBatchAdder := &mocks.LinksBatchAdder{}
+	linksBatchAdder.On("AddLinks", ctx, tgID, newLinks).Return(nil, errors.New("some error"))
+	postLinksBatchHandler := links.PostLinksBatchHandler{LinksBatchAdder: linksBatchAdder}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/batch", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksBatchHandler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "ADD_LINKS_FAILED", *responseErrorBody.ExceptionName)
+	linksBatchAdder.AssertExpectations(t)
+}
+
+func TestPostLinksBatchHandler_ServeHTTP_TooManyLinks(t *testing.T) {
+	ctx := context.Background()
+
+	newLinks := make([]domain.Link, domain.MaxImportLinks+1)
+	for i := range newLinks {
+		newLinks[i] = domain.Link{URL: "https://github.com/owner/repo" + strconv.Itoa(i), Tags: []string{}, Filters: []string{}}
+	}
+
+	payload, err := json.Marshal(dto.LinksToBatchLinksRequestDTO(newLinks))
+	require.NoError(t, err)
+
+	linksBatchAdder := &mocks.LinksBatchAdder{}
+	postLinksBatchHandler := links.PostLinksBatchHandler{LinksBatchAdder: linksBatchAdder}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/batch", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", "123")
+
+	w := httptest.NewRecorder()
+
+	postLinksBatchHandler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &responseErrorBody))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "BATCH_TOO_LARGE", *responseErrorBody.ExceptionName)
+	linksBatchAdder.AssertNotCalled(t, "AddLinks")
+}
@@ -0,0 +1,75 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type LinksPauser interface {
+	PauseLinks(ctx context.Context, tgID int64, selector domain.LinkSelector, until time.Time) ([]domain.Link, error)
+}
+
+type PostLinksPauseHandler struct {
+	LinksPauser LinksPauser
+}
+
+func (h PostLinksPauseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var pauseRequest scrapperdto.PauseRequest
+	if err = json.NewDecoder(r.Body).Decode(&pauseRequest); err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", err.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	selector, until := dto.PauseRequestDTOToPause(pauseRequest)
+	if !until.IsZero() && !until.After(time.Now()) {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid pause end", "until must be in the future", "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	links, err := h.LinksPauser.PauseLinks(r.Context(), tgID, selector, until)
+	if err != nil {
+		sendLinkError(w, err, "Failed to pause links", "PAUSE_LINKS_FAILED")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.LinksToListLinksResponseDTO(links))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+// sendLinkError отвечает 404, если ссылка не найдена, и 500 на остальные ошибки.
+func sendLinkError(w http.ResponseWriter, err error, description, name string) {
+	if errors.As(err, &domain.ErrLinkNotExist{}) {
+		httpapi.SendErrorResponse(w, http.StatusNotFound, "404",
+			"Link not found", err.Error(), "LINK_NOT_EXIST")
+
+		return
+	}
+
+	httpapi.SendErrorResponse(w, http.StatusBadRequest, "500", description, err.Error(), name)
+}
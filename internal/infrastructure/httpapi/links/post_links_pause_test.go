@@ -0,0 +1,100 @@
+package links_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/links"
+	"LinkTracker/internal/infrastructure/httpapi/links/mocks"
+)
+
+func TestPostLinksPauseHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	selector := domain.LinkSelector{Tag: "work"}
+	until := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	paused := []domain.Link{{
+		ID: 1, URL: "https://example.com/first", Tags: []string{"work"}, Filters: []string{},
+		Paused: true, PausedUntil: until,
+	}}
+	payload, err := json.Marshal(dto.PauseToPauseRequestDTO(selector, until))
+	require.NoError(t, err)
+
+	linksPauser := &mocks.LinksPauser{}
+	linksPauser.On("PauseLinks", ctx, tgID, selector, until).Return(paused, nil)
+	postLinksPauseHandler := links.PostLinksPauseHandler{LinksPauser: linksPauser}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/pause", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksPauseHandler.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var listLinksResponse scrapperdto.ListLinksResponse
+	err = json.Unmarshal(w.Body.Bytes(), &listLinksResponse)
+	require.NoError(t, err)
+	assert.Equal(t, paused, dto.ListLinksResponseDTOToLinks(listLinksResponse))
+	linksPauser.AssertExpectations(t)
+}
+
+func TestPostLinksPauseHandler_ServeHTTP_UntilInPast(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	payload, err := json.Marshal(dto.PauseToPauseRequestDTO(domain.LinkSelector{}, time.Now().Add(-time.Hour)))
+	require.NoError(t, err)
+
+	linksPauser := &mocks.LinksPauser{}
+	postLinksPauseHandler := links.PostLinksPauseHandler{LinksPauser: linksPauser}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/pause", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksPauseHandler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	linksPauser.AssertNotCalled(t, "PauseLinks")
+}
+
+func TestPostLinksPauseHandler_ServeHTTP_NotFound(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	selector := domain.LinkSelector{ID: 42}
+	payload, err := json.Marshal(dto.PauseToPauseRequestDTO(selector, time.Time{}))
+	require.NoError(t, err)
+
+	linksPauser := &mocks.LinksPauser{}
+	linksPauser.On("PauseLinks", ctx, tgID, selector, time.Time{}).Return(nil, domain.ErrLinkNotExist{})
+	postLinksPauseHandler := links.PostLinksPauseHandler{LinksPauser: linksPauser}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/pause", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksPauseHandler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "LINK_NOT_EXIST", *responseErrorBody.ExceptionName)
+	linksPauser.AssertExpectations(t)
+}
@@ -0,0 +1,53 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type LinksResumer interface {
+	ResumeLinks(ctx context.Context, tgID int64, selector domain.LinkSelector) ([]domain.Link, error)
+}
+
+type PostLinksResumeHandler struct {
+	LinksResumer LinksResumer
+}
+
+func (h PostLinksResumeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var resumeRequest scrapperdto.ResumeRequest
+	if err = json.NewDecoder(r.Body).Decode(&resumeRequest); err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", err.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	links, err := h.LinksResumer.ResumeLinks(r.Context(), tgID, dto.ResumeRequestDTOToLinkSelector(resumeRequest))
+	if err != nil {
+		sendLinkError(w, err, "Failed to resume links", "RESUME_LINKS_FAILED")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.LinksToListLinksResponseDTO(links))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,73 @@
+package links_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/links"
+	"LinkTracker/internal/infrastructure/httpapi/links/mocks"
+)
+
+func TestPostLinksResumeHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+	selector := domain.LinkSelector{URL: "https://example.com/first"}
+	resumed := []domain.Link{{ID: 1, URL: "https://example.com/first", Tags: []string{}, Filters: []string{}}}
+	payload, err := json.Marshal(dto.LinkSelectorToResumeRequestDTO(selector))
+	require.NoError(t, err)
+
+	linksResumer := &mocks.LinksResumer{}
+	linksResumer.On("ResumeLinks", ctx, tgID, selector).Return(resumed, nil)
+	postLinksResumeHandler := links.PostLinksResumeHandler{LinksResumer: linksResumer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/resume", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksResumeHandler.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var listLinksResponse scrapperdto.ListLinksResponse
+	err = json.Unmarshal(w.Body.Bytes(), &listLinksResponse)
+	require.NoError(t, err)
+	assert.Equal(t, resumed, dto.ListLinksResponseDTOToLinks(listLinksResponse))
+	linksResumer.AssertExpectations(t)
+}
+
+func TestPostLinksResumeHandler_ServeHTTP_Error(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	linksResumer := &mocks.LinksResumer{}
+	linksResumer.On("ResumeLinks", ctx, tgID, domain.LinkSelector{}).Return(nil, errors.New("some error"))
+	postLinksResumeHandler := links.PostLinksResumeHandler{LinksResumer: linksResumer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links/resume", bytes.NewReader([]byte(`{}`)))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	postLinksResumeHandler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "RESUME_LINKS_FAILED", *responseErrorBody.ExceptionName)
+	linksResumer.AssertExpectations(t)
+}
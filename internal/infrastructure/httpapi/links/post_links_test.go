@@ -201,6 +201,53 @@ func Test_PostLinkHandler_ServeHTTP_AddLinkError_ErrLinkAlreadyTracking(t *testi
 	assert.Equal(t, "LINKS_ALREADY_EXIST", *responseErrorBody.ExceptionName)
 }
 
+func Test_PostLinkHandler_ServeHTTP_AddLinkError_Validation(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expectedCode  int
+		expectedName  string
+		expectedDescr string
+	}{
+		{name: "Unsupported host", err: domain.ErrUnsupportedHost{}, expectedCode: http.StatusBadRequest,
+			expectedName: "UNSUPPORTED_LINK", expectedDescr: "Link is not supported"},
+		{name: "Wrong URL", err: domain.ErrWrongURL{}, expectedCode: http.StatusBadRequest,
+			expectedName: "UNSUPPORTED_LINK", expectedDescr: "Link is not supported"},
+		{name: "Source not found", err: domain.ErrSourceNotFound{}, expectedCode: http.StatusUnprocessableEntity,
+			expectedName: "SOURCE_NOT_FOUND", expectedDescr: "Repository or question not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tgID := int64(123)
+			link := domain.Link{URL: "https://github.com/owner/repo", Tags: []string{}, Filters: []string{}}
+			payload, err := json.Marshal(dto.LinkToLinkRequestDTO(&link))
+			require.NoError(t, err)
+
+			linkAdder := &mocks.LinkAdder{}
+			linkAdder.On("AddLink", ctx, tgID, &link).Return(domain.Link{}, tt.err)
+			postLinksHandler := links.PostLinksHandler{LinkAdder: linkAdder}
+
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/links", bytes.NewReader(payload))
+			r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+			r.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			postLinksHandler.ServeHTTP(w, r)
+
+			var responseErrorBody scrapperdto.ApiErrorResponse
+			err = json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, strconv.Itoa(tt.expectedCode), *responseErrorBody.Code)
+			assert.Equal(t, tt.expectedDescr, *responseErrorBody.Description)
+			assert.Equal(t, tt.expectedName, *responseErrorBody.ExceptionName)
+		})
+	}
+}
+
 func Test_PostLinkHandler_ServeHTTP_AddLinkError(t *testing.T) {
 	ctx := context.Background()
 	tgID := int64(123)
@@ -0,0 +1,54 @@
+// Package publicapi открывает часть API scrapper внешним клиентам. Клиент предъявляет персональный токен,
+// выпущенный командой /token в боте, и работает от имени пользователя, которому этот токен принадлежит.
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/httpapi"
+	"LinkTracker/internal/infrastructure/serviceauth"
+)
+
+type TokenAuthenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (int64, error)
+}
+
+// RequireToken находит владельца токена из заголовка "Authorization: Bearer <token>" и передаёт запрос next
+// с его tg_id в заголовке Tg-Chat-Id. Переданный клиентом Tg-Chat-Id заменяется, поэтому чужие данные недоступны.
+func RequireToken(authenticator TokenAuthenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := serviceauth.BearerToken(r)
+		if !found {
+			sendUnauthorized(w, "api token required")
+			return
+		}
+
+		tgID, err := authenticator.AuthenticateToken(r.Context(), token)
+		if errors.As(err, &domain.ErrInvalidToken{}) {
+			sendUnauthorized(w, err.Error())
+			return
+		}
+
+		if err != nil {
+			httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+				"Token not verified", err.Error(), "TOKEN_NOT_VERIFIED")
+
+			return
+		}
+
+		r = r.Clone(r.Context())
+		r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func sendUnauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	httpapi.SendErrorResponse(w, http.StatusUnauthorized, "401",
+		"Invalid or missing api token", msg, "UNAUTHORIZED")
+}
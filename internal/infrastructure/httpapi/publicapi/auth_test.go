@@ -0,0 +1,64 @@
+package publicapi_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/httpapi/publicapi"
+	"LinkTracker/internal/infrastructure/httpapi/publicapi/mocks"
+)
+
+func Test_RequireToken(t *testing.T) {
+	authenticator := &mocks.TokenAuthenticator{}
+	authenticator.On("AuthenticateToken", mock.Anything, "lt_valid").Return(int64(42), nil)
+	authenticator.On("AuthenticateToken", mock.Anything, "lt_revoked").Return(int64(0), domain.ErrInvalidToken{})
+	authenticator.On("AuthenticateToken", mock.Anything, "lt_broken").Return(int64(0), errors.New("db down"))
+
+	var gotTgChatID string
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTgChatID = r.Header.Get("Tg-Chat-Id")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := publicapi.RequireToken(authenticator, next)
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      int
+		wantTgChatID  string
+	}{
+		{name: "valid token", authorization: "Bearer lt_valid", wantCode: http.StatusNoContent, wantTgChatID: "42"},
+		{name: "missing header", wantCode: http.StatusUnauthorized},
+		{name: "empty token", authorization: "Bearer ", wantCode: http.StatusUnauthorized},
+		{name: "revoked token", authorization: "Bearer lt_revoked", wantCode: http.StatusUnauthorized},
+		{name: "storage error", authorization: "Bearer lt_broken", wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTgChatID = ""
+
+			r := httptest.NewRequest(http.MethodGet, "/links", http.NoBody)
+			// Клиент не может выдать себя за другого пользователя
+			r.Header.Set("Tg-Chat-Id", "1")
+
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.Equal(t, tt.wantTgChatID, gotTgChatID)
+		})
+	}
+}
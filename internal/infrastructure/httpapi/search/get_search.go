@@ -0,0 +1,67 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type LinkSearcher interface {
+	SearchLinks(ctx context.Context, tgID int64, query string, limit int64) ([]domain.SearchResult, error)
+}
+
+type GetSearchHandler struct {
+	LinkSearcher LinkSearcher
+}
+
+func (h GetSearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var limit int64
+
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		limit, err = strconv.ParseInt(rawLimit, 10, 64)
+		if err != nil || limit < 0 {
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+				"Invalid limit", "limit must be a non-negative integer", "INVALID_LIMIT")
+
+			return
+		}
+	}
+
+	results, err := h.LinkSearcher.SearchLinks(r.Context(), tgID, r.URL.Query().Get("q"), limit)
+	if err != nil {
+		if errors.As(err, &domain.ErrInvalidSearchQuery{}) {
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+				"Invalid search query", err.Error(), "INVALID_SEARCH_QUERY")
+
+			return
+		}
+
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
+			"Search failed", err.Error(), "SEARCH_FAILED")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.SearchResultsToSearchResponseDTO(results))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,98 @@
+package search_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/search"
+	"LinkTracker/internal/infrastructure/httpapi/search/mocks"
+)
+
+func Test_GetSearchHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	results := []domain.SearchResult{
+		{
+			Link: domain.Link{ID: 1, URL: "https://github.com/golang/go", Tags: []string{"go"}},
+			Update: &domain.UpdateRecord{
+				ID: 5, LinkID: 1, Description: "New issue",
+				Details: domain.UpdateDetails{Kind: "Issue", Title: "Memory leak"}, DetectedAt: time.Now().UTC(),
+			},
+			Rank: 0.5,
+		},
+		{Link: domain.Link{ID: 2, URL: "https://github.com/golang/tools"}, Rank: 0.1},
+	}
+
+	linkSearcher := &mocks.LinkSearcher{}
+	linkSearcher.On("SearchLinks", ctx, tgID, "golang leak", int64(5)).Return(results, nil)
+	handler := search.GetSearchHandler{LinkSearcher: linkSearcher}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/search?q=golang+leak&limit=5", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var searchResponse scrapperdto.SearchResponse
+	err := json.Unmarshal(w.Body.Bytes(), &searchResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int32(2), *searchResponse.Size)
+	require.NotNil(t, searchResponse.Results)
+	assert.Equal(t, "Memory leak", *(*searchResponse.Results)[0].Update.Title)
+	assert.Equal(t, "https://github.com/golang/tools", *(*searchResponse.Results)[1].Link.Url)
+	assert.Nil(t, (*searchResponse.Results)[1].Update)
+}
+
+func Test_GetSearchHandler_ServeHTTP_InvalidQuery(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	linkSearcher := &mocks.LinkSearcher{}
+	linkSearcher.On("SearchLinks", ctx, tgID, "!!!", int64(0)).Return(nil, domain.ErrInvalidSearchQuery{})
+	handler := search.GetSearchHandler{LinkSearcher: linkSearcher}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/search?q=!!!", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var apiErrorResponse scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &apiErrorResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_SEARCH_QUERY", *apiErrorResponse.ExceptionName)
+}
+
+func Test_GetSearchHandler_ServeHTTP_InvalidLimit(t *testing.T) {
+	ctx := context.Background()
+
+	linkSearcher := &mocks.LinkSearcher{}
+	handler := search.GetSearchHandler{LinkSearcher: linkSearcher}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/search?q=go&limit=-1", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", "123")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	linkSearcher.AssertNotCalled(t, "SearchLinks")
+}
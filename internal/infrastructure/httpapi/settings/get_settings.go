@@ -0,0 +1,46 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type SettingsGetter interface {
+	GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error)
+}
+
+type GetSettingsHandler struct {
+	SettingsGetter SettingsGetter
+}
+
+func (h GetSettingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	settings, err := h.SettingsGetter.GetSettings(r.Context(), tgID)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+			"Failed to get settings", err.Error(), "GET_SETTINGS_FAILED")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(dto.DeliverySettingsToSettingsResponseDTO(&settings))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
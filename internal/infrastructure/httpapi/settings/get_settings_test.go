@@ -0,0 +1,75 @@
+package settings_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/settings"
+	"LinkTracker/internal/infrastructure/httpapi/settings/mocks"
+)
+
+func Test_GetSettingsHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	deliverySettings := domain.DeliverySettings{
+		Mode:       domain.DeliveryDaily,
+		DigestTime: 21*60 + 30,
+		Timezone:   "Europe/Moscow",
+		TagModes:   map[string]domain.DeliveryMode{"work": domain.DeliveryImmediate},
+	}
+
+	settingsGetter := &mocks.SettingsGetter{}
+	settingsGetter.On("GetSettings", ctx, tgID).Return(deliverySettings, nil)
+	handler := settings.GetSettingsHandler{SettingsGetter: settingsGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/settings", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var response scrapperdto.SettingsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "daily", *response.Mode)
+	assert.Equal(t, "21:30", *response.DigestTime)
+	assert.Equal(t, "Europe/Moscow", *response.Timezone)
+	require.Len(t, *response.TagModes, 1)
+	assert.Equal(t, "work", *(*response.TagModes)[0].Tag)
+	assert.Equal(t, "immediate", *(*response.TagModes)[0].Mode)
+}
+
+func Test_GetSettingsHandler_ServeHTTP_Error(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	settingsGetter := &mocks.SettingsGetter{}
+	settingsGetter.On("GetSettings", ctx, tgID).Return(domain.DeliverySettings{}, errors.New("some error"))
+	handler := settings.GetSettingsHandler{SettingsGetter: settingsGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/settings", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "GET_SETTINGS_FAILED", *responseErrorBody.ExceptionName)
+}
@@ -0,0 +1,69 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type SettingsUpdater interface {
+	UpdateSettings(ctx context.Context, tgID int64, update *domain.DeliverySettingsUpdate) (domain.DeliverySettings, error)
+}
+
+type PutSettingsHandler struct {
+	SettingsUpdater SettingsUpdater
+}
+
+func (h PutSettingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var settingsRequest scrapperdto.SettingsRequest
+	if err = json.NewDecoder(r.Body).Decode(&settingsRequest); err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", err.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	update, err := dto.SettingsRequestDTOToDeliverySettingsUpdate(settingsRequest)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid settings", err.Error(), "INVALID_SETTINGS")
+
+		return
+	}
+
+	settings, err := h.SettingsUpdater.UpdateSettings(r.Context(), tgID, &update)
+	if err != nil {
+		if errors.As(err, &domain.ErrInvalidSettings{}) {
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+				"Invalid settings", err.Error(), "INVALID_SETTINGS")
+		} else {
+			httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+				"Failed to update settings", err.Error(), "UPDATE_SETTINGS_FAILED")
+		}
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(dto.DeliverySettingsToSettingsResponseDTO(&settings))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,131 @@
+package settings_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/settings"
+	"LinkTracker/internal/infrastructure/httpapi/settings/mocks"
+)
+
+func Test_PutSettingsHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	mode, digestTime := "daily", "08:15"
+	payload, _ := json.Marshal(scrapperdto.SettingsRequest{Mode: &mode, DigestTime: &digestTime})
+
+	expectedMode, expectedTime := domain.DeliveryDaily, 8*60+15
+	updated := domain.DefaultDeliverySettings()
+	updated.Mode, updated.DigestTime = expectedMode, expectedTime
+
+	settingsUpdater := &mocks.SettingsUpdater{}
+	settingsUpdater.On("UpdateSettings", ctx, tgID,
+		&domain.DeliverySettingsUpdate{Mode: &expectedMode, DigestTime: &expectedTime}).Return(updated, nil)
+	handler := settings.PutSettingsHandler{SettingsUpdater: settingsUpdater}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var response scrapperdto.SettingsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "daily", *response.Mode)
+	assert.Equal(t, "08:15", *response.DigestTime)
+	settingsUpdater.AssertExpectations(t)
+}
+
+func Test_PutSettingsHandler_ServeHTTP_InvalidDigestTime(t *testing.T) {
+	ctx := context.Background()
+
+	digestTime := "25:99"
+	payload, _ := json.Marshal(scrapperdto.SettingsRequest{DigestTime: &digestTime})
+
+	settingsUpdater := &mocks.SettingsUpdater{}
+	handler := settings.PutSettingsHandler{SettingsUpdater: settingsUpdater}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", "123")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_SETTINGS", *responseErrorBody.ExceptionName)
+	settingsUpdater.AssertNotCalled(t, "UpdateSettings")
+}
+
+func Test_PutSettingsHandler_ServeHTTP_InvalidTimezone(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	timezone := "Mars/Olympus"
+	payload, _ := json.Marshal(scrapperdto.SettingsRequest{Timezone: &timezone})
+
+	settingsUpdater := &mocks.SettingsUpdater{}
+	settingsUpdater.On("UpdateSettings", ctx, tgID, &domain.DeliverySettingsUpdate{Timezone: &timezone}).
+		Return(domain.DeliverySettings{}, domain.ErrInvalidSettings{Reason: "unknown timezone"})
+	handler := settings.PutSettingsHandler{SettingsUpdater: settingsUpdater}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_SETTINGS", *responseErrorBody.ExceptionName)
+}
+
+func Test_PutSettingsHandler_ServeHTTP_QuietHours(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	quietHours := "23:00-08:00"
+	payload, _ := json.Marshal(scrapperdto.SettingsRequest{QuietHours: &quietHours})
+
+	quiet := domain.QuietHours{Start: 23 * 60, End: 8 * 60}
+	updated := domain.DefaultDeliverySettings()
+	updated.Quiet = quiet
+
+	settingsUpdater := &mocks.SettingsUpdater{}
+	settingsUpdater.On("UpdateSettings", ctx, tgID, &domain.DeliverySettingsUpdate{Quiet: &quiet}).Return(updated, nil)
+	handler := settings.PutSettingsHandler{SettingsUpdater: settingsUpdater}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var response scrapperdto.SettingsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "23:00-08:00", *response.QuietHours)
+	settingsUpdater.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type TagModeSetter interface {
+	SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error
+}
+
+type PutTagSettingsHandler struct {
+	TagModeSetter TagModeSetter
+}
+
+func (h PutTagSettingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var tagModeRequest scrapperdto.TagModeRequest
+	if err = json.NewDecoder(r.Body).Decode(&tagModeRequest); err != nil || tagModeRequest.Tag == nil {
+		description := "missing tag"
+		if err != nil {
+			description = err.Error()
+		}
+
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", description, "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	var mode domain.DeliveryMode
+	if tagModeRequest.Mode != nil {
+		mode = domain.DeliveryMode(*tagModeRequest.Mode)
+	}
+
+	err = h.TagModeSetter.SetTagMode(r.Context(), tgID, *tagModeRequest.Tag, mode)
+	if err != nil {
+		if errors.As(err, &domain.ErrInvalidSettings{}) {
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+				"Invalid settings", err.Error(), "INVALID_SETTINGS")
+		} else {
+			httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
+				"Failed to set tag mode", err.Error(), "SET_TAG_MODE_FAILED")
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,83 @@
+package settings_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/settings"
+	"LinkTracker/internal/infrastructure/httpapi/settings/mocks"
+)
+
+func Test_PutTagSettingsHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	tag, mode := "work", "hourly"
+	payload, _ := json.Marshal(scrapperdto.TagModeRequest{Tag: &tag, Mode: &mode})
+
+	tagModeSetter := &mocks.TagModeSetter{}
+	tagModeSetter.On("SetTagMode", ctx, tgID, tag, domain.DeliveryHourly).Return(nil)
+	handler := settings.PutTagSettingsHandler{TagModeSetter: tagModeSetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings/tags", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	tagModeSetter.AssertExpectations(t)
+}
+
+func Test_PutTagSettingsHandler_ServeHTTP_ResetMode(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	tag := "work"
+	payload, _ := json.Marshal(scrapperdto.TagModeRequest{Tag: &tag})
+
+	tagModeSetter := &mocks.TagModeSetter{}
+	tagModeSetter.On("SetTagMode", ctx, tgID, tag, domain.DeliveryMode("")).Return(nil)
+	handler := settings.PutTagSettingsHandler{TagModeSetter: tagModeSetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings/tags", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	tagModeSetter.AssertExpectations(t)
+}
+
+func Test_PutTagSettingsHandler_ServeHTTP_MissingTag(t *testing.T) {
+	ctx := context.Background()
+
+	tagModeSetter := &mocks.TagModeSetter{}
+	handler := settings.PutTagSettingsHandler{TagModeSetter: tagModeSetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/settings/tags", bytes.NewReader([]byte("{}")))
+	r.Header.Set("Tg-Chat-Id", "123")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_REQUEST_BODY", *responseErrorBody.ExceptionName)
+	tagModeSetter.AssertNotCalled(t, "SetTagMode")
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type StateCreator interface {
-	CreateState(ctx context.Context, tgID int64, state int) error
+	CreateState(ctx context.Context, tgID, userID int64, state int) error
 }
 
 type PostStatesHandler struct {
@@ -40,7 +40,13 @@ func (h PostStatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.StateCreator.CreateState(r.Context(), tgID, *stateRequest.State)
+	// Без userId диалог не привязан к участнику, так работают личные чаты
+	var userID int64
+	if stateRequest.UserId != nil {
+		userID = *stateRequest.UserId
+	}
+
+	err = h.StateCreator.CreateState(r.Context(), tgID, userID, *stateRequest.State)
 	if err != nil {
 		httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
 			"Failed to create state", err.Error(), "CREATE_STATE_FAILED")
@@ -98,7 +98,7 @@ func Test_PostStatesHandler_ServeHTTP_CreateStateError(t *testing.T) {
 	require.NoError(t, err)
 
 	stateCreator := &mocks.StateCreator{}
-	stateCreator.On("CreateState", ctx, tgID, state).Return(errors.New("some error"))
+	stateCreator.On("CreateState", ctx, tgID, int64(0), state).Return(errors.New("some error"))
 	postStatesHandler := states.PostStatesHandler{StateCreator: stateCreator}
 
 	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/states", bytes.NewBuffer(payload))
@@ -119,14 +119,15 @@ func Test_PostStatesHandler_ServeHTTP_CreateStateError(t *testing.T) {
 
 func Test_PostStatesHandler_ServeHTTP_Success(t *testing.T) {
 	ctx := context.Background()
-	tgID := int64(123)
+	tgID := int64(-100123)
+	userID := int64(456)
 	state := 1
-	stateRequest := scrapperdto.StateRequest{State: &state}
+	stateRequest := scrapperdto.StateRequest{State: &state, UserId: &userID}
 	payload, err := json.Marshal(stateRequest)
 	require.NoError(t, err)
 
 	stateCreator := &mocks.StateCreator{}
-	stateCreator.On("CreateState", ctx, tgID, state).Return(nil)
+	stateCreator.On("CreateState", ctx, tgID, userID, state).Return(nil)
 	postStatesHandler := states.PostStatesHandler{StateCreator: stateCreator}
 
 	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/states", bytes.NewBuffer(payload))
@@ -137,4 +138,5 @@ func Test_PostStatesHandler_ServeHTTP_Success(t *testing.T) {
 	postStatesHandler.ServeHTTP(w, r)
 
 	assert.Equal(t, http.StatusOK, w.Code)
+	stateCreator.AssertExpectations(t)
 }
@@ -12,7 +12,7 @@ import (
 )
 
 type StateGetter interface {
-	GetState(ctx context.Context, tgID int64) (int, domain.Link, error)
+	GetState(ctx context.Context, tgID int64) (int, int64, domain.Link, error)
 }
 
 type GetStatesHandler struct {
@@ -28,7 +28,7 @@ func (h GetStatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	state, link, err := h.StateGetter.GetState(r.Context(), tgID)
+	state, userID, link, err := h.StateGetter.GetState(r.Context(), tgID)
 	if err != nil {
 		httpapi.SendErrorResponse(w, http.StatusInternalServerError, "500",
 			"Failed to get state", err.Error(), "GET_STATE_FAILED")
@@ -41,6 +41,7 @@ func (h GetStatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	responseData.Link = &link.URL
 	responseData.Tags = &link.Tags
 	responseData.Filters = &link.Filters
+	responseData.UserId = &userID
 
 	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
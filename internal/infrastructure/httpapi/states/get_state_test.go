@@ -46,7 +46,7 @@ func Test_GetStatesHandler_ServeHTTP_GetStateError(t *testing.T) {
 	tgID := int64(123)
 
 	stateGetter := &mocks.StateGetter{}
-	stateGetter.On("GetState", ctx, tgID).Return(0, domain.Link{}, errors.New("some error"))
+	stateGetter.On("GetState", ctx, tgID).Return(0, int64(0), domain.Link{}, errors.New("some error"))
 	getStatesHandler := states.GetStatesHandler{StateGetter: stateGetter}
 
 	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/states", http.NoBody)
@@ -69,11 +69,12 @@ func Test_GetStatesHandler_ServeHTTP_Success(t *testing.T) {
 	ctx := context.Background()
 	tgID := int64(123)
 	state := 1
+	userID := int64(456)
 	link := "https://example.com/example"
 	tags := []string{"tag1", "tag2"}
 	filters := []string{"filter1", "filter2"}
 	stateGetter := &mocks.StateGetter{}
-	stateGetter.On("GetState", ctx, tgID).Return(state, domain.Link{URL: link, Tags: tags, Filters: filters}, nil)
+	stateGetter.On("GetState", ctx, tgID).Return(state, userID, domain.Link{URL: link, Tags: tags, Filters: filters}, nil)
 	getStatesHandler := states.GetStatesHandler{StateGetter: stateGetter}
 
 	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/states", http.NoBody)
@@ -91,4 +92,5 @@ func Test_GetStatesHandler_ServeHTTP_Success(t *testing.T) {
 	assert.Equal(t, link, *stateResponse.Link)
 	assert.Equal(t, tags, *stateResponse.Tags)
 	assert.Equal(t, filters, *stateResponse.Filters)
+	assert.Equal(t, userID, *stateResponse.UserId)
 }
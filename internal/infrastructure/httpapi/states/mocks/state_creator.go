@@ -21,17 +21,17 @@ func (_m *StateCreator) EXPECT() *StateCreator_Expecter {
 	return &StateCreator_Expecter{mock: &_m.Mock}
 }
 
-// CreateState provides a mock function with given fields: ctx, tgID, state
-func (_m *StateCreator) CreateState(ctx context.Context, tgID int64, state int) error {
-	ret := _m.Called(ctx, tgID, state)
+// CreateState provides a mock function with given fields: ctx, tgID, userID, state
+func (_m *StateCreator) CreateState(ctx context.Context, tgID int64, userID int64, state int) error {
+	ret := _m.Called(ctx, tgID, userID, state)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
-		r0 = rf(ctx, tgID, state)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) error); ok {
+		r0 = rf(ctx, tgID, userID, state)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,14 +47,15 @@ type StateCreator_CreateState_Call struct {
 // CreateState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tgID int64
+//   - userID int64
 //   - state int
-func (_e *StateCreator_Expecter) CreateState(ctx interface{}, tgID interface{}, state interface{}) *StateCreator_CreateState_Call {
-	return &StateCreator_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, state)}
+func (_e *StateCreator_Expecter) CreateState(ctx interface{}, tgID interface{}, userID interface{}, state interface{}) *StateCreator_CreateState_Call {
+	return &StateCreator_CreateState_Call{Call: _e.mock.On("CreateState", ctx, tgID, userID, state)}
 }
 
-func (_c *StateCreator_CreateState_Call) Run(run func(ctx context.Context, tgID int64, state int)) *StateCreator_CreateState_Call {
+func (_c *StateCreator_CreateState_Call) Run(run func(ctx context.Context, tgID int64, userID int64, state int)) *StateCreator_CreateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
 	})
 	return _c
 }
@@ -64,7 +65,7 @@ func (_c *StateCreator_CreateState_Call) Return(_a0 error) *StateCreator_CreateS
 	return _c
 }
 
-func (_c *StateCreator_CreateState_Call) RunAndReturn(run func(context.Context, int64, int) error) *StateCreator_CreateState_Call {
+func (_c *StateCreator_CreateState_Call) RunAndReturn(run func(context.Context, int64, int64, int) error) *StateCreator_CreateState_Call {
 	_c.Call.Return(run)
 	return _c
 }
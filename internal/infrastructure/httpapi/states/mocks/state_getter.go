@@ -23,7 +23,7 @@ func (_m *StateGetter) EXPECT() *StateGetter_Expecter {
 }
 
 // GetState provides a mock function with given fields: ctx, tgID
-func (_m *StateGetter) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
+func (_m *StateGetter) GetState(ctx context.Context, tgID int64) (int, int64, domain.Link, error) {
 	ret := _m.Called(ctx, tgID)
 
 	if len(ret) == 0 {
@@ -31,9 +31,10 @@ func (_m *StateGetter) GetState(ctx context.Context, tgID int64) (int, domain.Li
 	}
 
 	var r0 int
-	var r1 domain.Link
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, domain.Link, error)); ok {
+	var r1 int64
+	var r2 domain.Link
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, int64, domain.Link, error)); ok {
 		return rf(ctx, tgID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
@@ -42,19 +43,25 @@ func (_m *StateGetter) GetState(ctx context.Context, tgID int64) (int, domain.Li
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) domain.Link); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) int64); ok {
 		r1 = rf(ctx, tgID)
 	} else {
-		r1 = ret.Get(1).(domain.Link)
+		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, int64) domain.Link); ok {
 		r2 = rf(ctx, tgID)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(domain.Link)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(3).(func(context.Context, int64) error); ok {
+		r3 = rf(ctx, tgID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
 // StateGetter_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
@@ -76,12 +83,12 @@ func (_c *StateGetter_GetState_Call) Run(run func(ctx context.Context, tgID int6
 	return _c
 }
 
-func (_c *StateGetter_GetState_Call) Return(_a0 int, _a1 domain.Link, _a2 error) *StateGetter_GetState_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *StateGetter_GetState_Call) Return(_a0 int, _a1 int64, _a2 domain.Link, _a3 error) *StateGetter_GetState_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
 	return _c
 }
 
-func (_c *StateGetter_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, domain.Link, error)) *StateGetter_GetState_Call {
+func (_c *StateGetter_GetState_Call) RunAndReturn(run func(context.Context, int64) (int, int64, domain.Link, error)) *StateGetter_GetState_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,54 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type TagLinksDeleter interface {
+	DeleteLinksByTag(ctx context.Context, tgID int64, tag string) ([]domain.Link, error)
+}
+
+type DeleteTagLinksHandler struct {
+	TagLinksDeleter TagLinksDeleter
+}
+
+func (h DeleteTagLinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	deletedLinks, err := h.TagLinksDeleter.DeleteLinksByTag(r.Context(), tgID, r.PathValue("tag"))
+	if err != nil {
+		if errors.As(err, &domain.ErrTagNotExist{}) {
+			httpapi.SendErrorResponse(w, http.StatusNotFound, "404",
+				"Tag not found", err.Error(), "TAG_NOT_EXIST")
+
+			return
+		}
+
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
+			"Failed to delete links", err.Error(), "DELETE_LINKS_FAILED")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.LinksToListLinksResponseDTO(deletedLinks))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
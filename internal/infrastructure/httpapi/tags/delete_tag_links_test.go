@@ -0,0 +1,68 @@
+package tags_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/tags"
+	"LinkTracker/internal/infrastructure/httpapi/tags/mocks"
+)
+
+func Test_DeleteTagLinksHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	deleted := []domain.Link{{ID: 1, URL: "https://github.com/a/b", Tags: []string{"work"}}}
+
+	tagLinksDeleter := &mocks.TagLinksDeleter{}
+	tagLinksDeleter.On("DeleteLinksByTag", ctx, tgID, "work").Return(deleted, nil)
+	handler := tags.DeleteTagLinksHandler{TagLinksDeleter: tagLinksDeleter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodDelete, "/tags/work/links", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.SetPathValue("tag", "work")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var listLinksResponse scrapperdto.ListLinksResponse
+	err := json.Unmarshal(w.Body.Bytes(), &listLinksResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, listLinksResponse.Links)
+	assert.Equal(t, deleted[0].URL, *(*listLinksResponse.Links)[0].Url)
+}
+
+func Test_DeleteTagLinksHandler_ServeHTTP_TagNotExist(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	tagLinksDeleter := &mocks.TagLinksDeleter{}
+	tagLinksDeleter.On("DeleteLinksByTag", ctx, tgID, "work").Return(nil, domain.ErrTagNotExist{Tag: "work"})
+	handler := tags.DeleteTagLinksHandler{TagLinksDeleter: tagLinksDeleter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodDelete, "/tags/work/links", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.SetPathValue("tag", "work")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "TAG_NOT_EXIST", *responseErrorBody.ExceptionName)
+}
@@ -0,0 +1,46 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type TagsGetter interface {
+	GetUserTags(ctx context.Context, tgID int64) ([]domain.TagCount, error)
+}
+
+type GetTagsHandler struct {
+	TagsGetter TagsGetter
+}
+
+func (h GetTagsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	userTags, err := h.TagsGetter.GetUserTags(r.Context(), tgID)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
+			"Tags not received", err.Error(), "TAGS_NOT_RECEIVED")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.TagCountsToListTagsResponseDTO(userTags))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
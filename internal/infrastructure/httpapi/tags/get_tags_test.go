@@ -0,0 +1,69 @@
+package tags_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/tags"
+	"LinkTracker/internal/infrastructure/httpapi/tags/mocks"
+)
+
+func Test_GetTagsHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	userTags := []domain.TagCount{{Tag: "go", Links: 1}, {Tag: "work", Links: 3}}
+
+	tagsGetter := &mocks.TagsGetter{}
+	tagsGetter.On("GetUserTags", ctx, tgID).Return(userTags, nil)
+	handler := tags.GetTagsHandler{TagsGetter: tagsGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/tags", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var listTagsResponse scrapperdto.ListTagsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &listTagsResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int32(2), *listTagsResponse.Size)
+	require.NotNil(t, listTagsResponse.Tags)
+	assert.Equal(t, "work", *(*listTagsResponse.Tags)[1].Tag)
+	assert.Equal(t, int64(3), *(*listTagsResponse.Tags)[1].Links)
+}
+
+func Test_GetTagsHandler_ServeHTTP_Error(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	tagsGetter := &mocks.TagsGetter{}
+	tagsGetter.On("GetUserTags", ctx, tgID).Return(nil, errors.New("db error"))
+	handler := tags.GetTagsHandler{TagsGetter: tagsGetter}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/tags", http.NoBody)
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "TAGS_NOT_RECEIVED", *responseErrorBody.ExceptionName)
+}
@@ -0,0 +1,72 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type TagRenamer interface {
+	RenameTag(ctx context.Context, tgID int64, oldTag, newTag string) (int64, error)
+}
+
+type PutTagHandler struct {
+	TagRenamer TagRenamer
+}
+
+func (h PutTagHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tgID, err := httpapi.GetTgIDFromString(r.Header.Get("Tg-Chat-Id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	var renameTagRequest scrapperdto.RenameTagRequest
+	if err = json.NewDecoder(r.Body).Decode(&renameTagRequest); err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", err.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	if renameTagRequest.Name == nil || *renameTagRequest.Name == "" {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Missing required fields", domain.ErrNoRequiredAttribute{Attribute: "name"}.Error(), "INVALID_REQUEST_BODY")
+
+		return
+	}
+
+	renamed, err := h.TagRenamer.RenameTag(r.Context(), tgID, r.PathValue("tag"), *renameTagRequest.Name)
+	if err != nil {
+		switch {
+		case errors.As(err, &domain.ErrTagNotExist{}):
+			httpapi.SendErrorResponse(w, http.StatusNotFound, "404",
+				"Tag not found", err.Error(), "TAG_NOT_EXIST")
+		case errors.As(err, &domain.ErrInvalidTag{}):
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+				"Invalid tag", err.Error(), "INVALID_TAG")
+		default:
+			httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
+				"Failed to rename tag", err.Error(), "RENAME_TAG_FAILED")
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(dto.TagCountToTagResponseDTO(domain.TagCount{Tag: *renameTagRequest.Name, Links: renamed}))
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,96 @@
+package tags_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/domain"
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/tags"
+	"LinkTracker/internal/infrastructure/httpapi/tags/mocks"
+)
+
+func Test_PutTagHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	name := "job"
+	payload, _ := json.Marshal(scrapperdto.RenameTagRequest{Name: &name})
+
+	tagRenamer := &mocks.TagRenamer{}
+	tagRenamer.On("RenameTag", ctx, tgID, "work", name).Return(int64(2), nil)
+	handler := tags.PutTagHandler{TagRenamer: tagRenamer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/tags/work", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.SetPathValue("tag", "work")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var tagResponse scrapperdto.TagResponse
+	err := json.Unmarshal(w.Body.Bytes(), &tagResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, name, *tagResponse.Tag)
+	assert.Equal(t, int64(2), *tagResponse.Links)
+	tagRenamer.AssertExpectations(t)
+}
+
+func Test_PutTagHandler_ServeHTTP_TagNotExist(t *testing.T) {
+	ctx := context.Background()
+	tgID := int64(123)
+
+	name := "job"
+	payload, _ := json.Marshal(scrapperdto.RenameTagRequest{Name: &name})
+
+	tagRenamer := &mocks.TagRenamer{}
+	tagRenamer.On("RenameTag", ctx, tgID, "work", name).Return(int64(0), domain.ErrTagNotExist{Tag: "work"})
+	handler := tags.PutTagHandler{TagRenamer: tagRenamer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/tags/work", bytes.NewReader(payload))
+	r.Header.Set("Tg-Chat-Id", strconv.FormatInt(tgID, 10))
+	r.SetPathValue("tag", "work")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "TAG_NOT_EXIST", *responseErrorBody.ExceptionName)
+}
+
+func Test_PutTagHandler_ServeHTTP_MissingName(t *testing.T) {
+	ctx := context.Background()
+
+	tagRenamer := &mocks.TagRenamer{}
+	handler := tags.PutTagHandler{TagRenamer: tagRenamer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/tags/work", bytes.NewReader([]byte("{}")))
+	r.Header.Set("Tg-Chat-Id", "123")
+	r.SetPathValue("tag", "work")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseErrorBody scrapperdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseErrorBody)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_REQUEST_BODY", *responseErrorBody.ExceptionName)
+	tagRenamer.AssertNotCalled(t, "RenameTag")
+}
@@ -0,0 +1,46 @@
+package tgchat
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type TokenIssuer interface {
+	IssueToken(ctx context.Context, tgID int64) (string, error)
+}
+
+// PostTokenHandler выпускает чату новый персональный токен публичного API, заменяя прежний.
+type PostTokenHandler struct {
+	TokenIssuer TokenIssuer
+}
+
+func (h PostTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	chatID, err := httpapi.GetTgIDFromString(r.PathValue("id"))
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing tgID", err.Error(), "INVALID_TG_ID")
+
+		return
+	}
+
+	token, err := h.TokenIssuer.IssueToken(r.Context(), chatID)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "500",
+			"Failed to issue token", err.Error(), "ISSUE_TOKEN_FAILED")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(scrapperdto.ApiTokenResponse{Token: &token})
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
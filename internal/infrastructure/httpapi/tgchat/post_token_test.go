@@ -0,0 +1,76 @@
+package tgchat_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scrapperdto "LinkTracker/internal/infrastructure/dto/dto_scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/tgchat"
+	"LinkTracker/internal/infrastructure/httpapi/tgchat/mocks"
+)
+
+func Test_PostTokenHandler_ServeHTTP_Success(t *testing.T) {
+	ctx := context.Background()
+	tokenIssuer := &mocks.TokenIssuer{}
+	tokenIssuer.On("IssueToken", ctx, int64(123)).Return("lt_token", nil).Once()
+	handler := tgchat.PostTokenHandler{TokenIssuer: tokenIssuer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/tg-chat/123/token", http.NoBody)
+	r.SetPathValue("id", "123")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var response scrapperdto.ApiTokenResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.NotNil(t, response.Token)
+	assert.Equal(t, "lt_token", *response.Token)
+	tokenIssuer.AssertExpectations(t)
+}
+
+func Test_PostTokenHandler_ServeHTTP_InvalidChatID(t *testing.T) {
+	ctx := context.Background()
+	handler := tgchat.PostTokenHandler{TokenIssuer: &mocks.TokenIssuer{}}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/tg-chat/abc/token", http.NoBody)
+	r.SetPathValue("id", "abc")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var apiErrorBody scrapperdto.ApiErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErrorBody))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_TG_ID", *apiErrorBody.ExceptionName)
+}
+
+func Test_PostTokenHandler_ServeHTTP_IssueError(t *testing.T) {
+	ctx := context.Background()
+	tokenIssuer := &mocks.TokenIssuer{}
+	tokenIssuer.On("IssueToken", ctx, int64(123)).Return("", errors.New("db down")).Once()
+	handler := tgchat.PostTokenHandler{TokenIssuer: tokenIssuer}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/tg-chat/123/token", http.NoBody)
+	r.SetPathValue("id", "123")
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var apiErrorBody scrapperdto.ApiErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErrorBody))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "500", *apiErrorBody.Code)
+	assert.Equal(t, "ISSUE_TOKEN_FAILED", *apiErrorBody.ExceptionName)
+}
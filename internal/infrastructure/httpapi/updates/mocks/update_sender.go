@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	domain "LinkTracker/internal/domain"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -21,9 +22,9 @@ func (_m *UpdateSender) EXPECT() *UpdateSender_Expecter {
 	return &UpdateSender_Expecter{mock: &_m.Mock}
 }
 
-// UpdateSend provides a mock function with given fields: ctx, tgIDs, url, description
-func (_m *UpdateSender) UpdateSend(ctx context.Context, tgIDs []int64, url string, description string) {
-	_m.Called(ctx, tgIDs, url, description)
+// UpdateSend provides a mock function with given fields: ctx, update
+func (_m *UpdateSender) UpdateSend(ctx context.Context, update *domain.LinkUpdate) {
+	_m.Called(ctx, update)
 }
 
 // UpdateSender_UpdateSend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSend'
@@ -33,16 +34,14 @@ type UpdateSender_UpdateSend_Call struct {
 
 // UpdateSend is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tgIDs []int64
-//   - url string
-//   - description string
-func (_e *UpdateSender_Expecter) UpdateSend(ctx interface{}, tgIDs interface{}, url interface{}, description interface{}) *UpdateSender_UpdateSend_Call {
-	return &UpdateSender_UpdateSend_Call{Call: _e.mock.On("UpdateSend", ctx, tgIDs, url, description)}
+//   - update *domain.LinkUpdate
+func (_e *UpdateSender_Expecter) UpdateSend(ctx interface{}, update interface{}) *UpdateSender_UpdateSend_Call {
+	return &UpdateSender_UpdateSend_Call{Call: _e.mock.On("UpdateSend", ctx, update)}
 }
 
-func (_c *UpdateSender_UpdateSend_Call) Run(run func(ctx context.Context, tgIDs []int64, url string, description string)) *UpdateSender_UpdateSend_Call {
+func (_c *UpdateSender_UpdateSend_Call) Run(run func(ctx context.Context, update *domain.LinkUpdate)) *UpdateSender_UpdateSend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]int64), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(*domain.LinkUpdate))
 	})
 	return _c
 }
@@ -52,7 +51,7 @@ func (_c *UpdateSender_UpdateSend_Call) Return() *UpdateSender_UpdateSend_Call {
 	return _c
 }
 
-func (_c *UpdateSender_UpdateSend_Call) RunAndReturn(run func(context.Context, []int64, string, string)) *UpdateSender_UpdateSend_Call {
+func (_c *UpdateSender_UpdateSend_Call) RunAndReturn(run func(context.Context, *domain.LinkUpdate)) *UpdateSender_UpdateSend_Call {
 	_c.Call.Return(run)
 	return _c
 }
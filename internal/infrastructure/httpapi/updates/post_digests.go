@@ -0,0 +1,42 @@
+package updates
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
+	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+type DigestSender interface {
+	DigestSend(ctx context.Context, digest *domain.Digest)
+}
+
+type PostDigestsHandler struct {
+	DigestSender DigestSender
+}
+
+func (h PostDigestsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var requestBody botdto.Digest
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"Invalid or missing request body", err.Error(), "INVALID_REQUEST_BODY")
+		return
+	}
+
+	digest, err := dto.DigestDTOToDigest(requestBody)
+	if err != nil {
+		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
+			"\"Updates\" is missing or invalid", err.Error(),
+			"MISSING_REQUIRED_FIELDS")
+
+		return
+	}
+
+	h.DigestSender.DigestSend(r.Context(), &digest)
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,67 @@
+package updates_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"LinkTracker/internal/domain"
+	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
+	"LinkTracker/internal/infrastructure/httpapi/updates"
+	"LinkTracker/internal/infrastructure/httpapi/updates/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PostDigestsHandler_Success(t *testing.T) {
+	ctx := context.Background()
+	url := "https://example.com/update"
+	description := "Было обновление : https://example.com/update"
+	mode := botdto.Daily
+	requestBody := botdto.Digest{
+		TgChatId: 12345,
+		Mode:     &mode,
+		Updates:  []botdto.LinkUpdate{{Url: &url, Description: &description}},
+	}
+	payload, _ := json.Marshal(requestBody)
+
+	bot := &mocks.DigestSender{}
+	handler := updates.PostDigestsHandler{DigestSender: bot}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/digests", bytes.NewReader(payload))
+	w := httptest.NewRecorder()
+
+	bot.On("DigestSend", ctx, &domain.Digest{
+		TgID: 12345,
+		Mode: domain.DeliveryDaily,
+		Updates: []domain.LinkUpdate{
+			{Link: domain.Link{URL: url}, TgIDs: []int64{12345}, Description: description},
+		},
+	})
+
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	bot.AssertExpectations(t)
+}
+
+func Test_PostDigestsHandler_EmptyUpdates(t *testing.T) {
+	digestSender := &mocks.DigestSender{}
+	handler := updates.PostDigestsHandler{DigestSender: digestSender}
+	payload, _ := json.Marshal(botdto.Digest{TgChatId: 12345})
+
+	r := httptest.NewRequest(http.MethodPost, "/digests", bytes.NewReader(payload))
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	var responseData botdto.ApiErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &responseData)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "MISSING_REQUIRED_FIELDS", *responseData.ExceptionName)
+	digestSender.AssertNotCalled(t, "DigestSend")
+}
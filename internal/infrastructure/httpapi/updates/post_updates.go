@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/dto"
 	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
 	"LinkTracker/internal/infrastructure/httpapi"
 )
 
 type UpdateSender interface {
-	UpdateSend(ctx context.Context, tgIDs []int64, url string, description string)
+	UpdateSend(ctx context.Context, update *domain.LinkUpdate)
 }
 
 type PostUpdatesHandler struct {
@@ -25,22 +27,16 @@ func (h PostUpdatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if requestBody.TgChatIds == nil || requestBody.Url == nil {
+	update, err := dto.LinkUpdateDTOToLinkUpdate(requestBody)
+	if err != nil {
 		httpapi.SendErrorResponse(w, http.StatusBadRequest, "400",
-			"\"TgChatIds\" or \"Url\" is missing", "requestBody.TgChatIds == nil || requestBody.Url == nil",
+			"\"TgChatIds\" or \"Url\" is missing", err.Error(),
 			"MISSING_REQUIRED_FIELDS")
 
 		return
 	}
 
-	if requestBody.Description == nil {
-		requestBody.Description = strPtr("")
-	}
+	h.UpdateSender.UpdateSend(r.Context(), &update)
 
-	h.UpdateSender.UpdateSend(r.Context(), *requestBody.TgChatIds, *requestBody.Url, *requestBody.Description)
 	w.WriteHeader(http.StatusOK)
 }
-
-func strPtr(s string) *string {
-	return &s
-}
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"LinkTracker/internal/domain"
 	botdto "LinkTracker/internal/infrastructure/dto/dto_bot"
 	"LinkTracker/internal/infrastructure/httpapi/updates"
 	"LinkTracker/internal/infrastructure/httpapi/updates/mocks"
@@ -33,7 +34,7 @@ func Test_PostUpdatesHandler_Success(t *testing.T) {
 	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/updates", bytes.NewReader(payload))
 	w := httptest.NewRecorder()
 
-	bot.On("UpdateSend", ctx, tgIDs, url, description)
+	bot.On("UpdateSend", ctx, &domain.LinkUpdate{Link: domain.Link{URL: url}, TgIDs: tgIDs, Description: description})
 
 	handler.ServeHTTP(w, r)
 
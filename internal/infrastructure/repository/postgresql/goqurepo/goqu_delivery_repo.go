@@ -0,0 +1,325 @@
+package goqurepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// DeliveryRepoGoqu хранит настройки доставки уведомлений и отложенные до сводки обновления.
+type DeliveryRepoGoqu struct {
+	pool *pgxpool.Pool
+	db   *goqu.Database
+}
+
+func NewDeliveryRepoGoqu(pool *pgxpool.Pool) *DeliveryRepoGoqu {
+	sqlDB := stdlib.OpenDBFromPool(pool)
+	db := goqu.New("postgres", sqlDB)
+
+	return &DeliveryRepoGoqu{
+		pool: pool,
+		db:   db,
+	}
+}
+
+// GetSettings возвращает настройки доставки пользователя. Если пользователь их не менял,
+// возвращаются настройки по умолчанию.
+func (r *DeliveryRepoGoqu) GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error) {
+	settings := domain.DefaultDeliverySettings()
+
+	ds := r.db.From("delivery_settings").
+		Select("mode", "digest_time", "timezone", "quiet_start", "quiet_end").
+		Where(goqu.Ex{"tg_id": tgID})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	var mode string
+
+	err = r.pool.QueryRow(ctx, sql, args...).Scan(&mode, &settings.DigestTime, &settings.Timezone,
+		&settings.Quiet.Start, &settings.Quiet.End)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.DeliverySettings{}, err
+	}
+
+	if err == nil {
+		settings.Mode = domain.DeliveryMode(mode)
+	}
+
+	sql, args, err = r.db.From("tag_delivery_modes").Select("tag", "mode").Where(goqu.Ex{"tg_id": tgID}).ToSQL()
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag, tagMode string
+		if err := rows.Scan(&tag, &tagMode); err != nil {
+			return domain.DeliverySettings{}, err
+		}
+
+		settings.TagModes[tag] = domain.DeliveryMode(tagMode)
+	}
+
+	return settings, rows.Err()
+}
+
+func (r *DeliveryRepoGoqu) SaveSettings(ctx context.Context, tgID int64, settings *domain.DeliverySettings) error {
+	record := goqu.Record{
+		"mode":        string(settings.Mode),
+		"digest_time": settings.DigestTime,
+		"timezone":    settings.Timezone,
+		"quiet_start": settings.Quiet.Start,
+		"quiet_end":   settings.Quiet.End,
+	}
+
+	row := goqu.Record{"tg_id": tgID}
+	for column, value := range record {
+		row[column] = value
+	}
+
+	ds := r.db.Insert("delivery_settings").
+		Rows(row).
+		OnConflict(goqu.DoUpdate("tg_id", record))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+func (r *DeliveryRepoGoqu) SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error {
+	ds := r.db.Insert("tag_delivery_modes").
+		Rows(goqu.Record{"tg_id": tgID, "tag": tag, "mode": string(mode)}).
+		OnConflict(goqu.DoUpdate("tg_id, tag", goqu.Record{"mode": string(mode)}))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+func (r *DeliveryRepoGoqu) DeleteTagMode(ctx context.Context, tgID int64, tag string) error {
+	ds := r.db.Delete("tag_delivery_modes").Where(goqu.Ex{"tg_id": tgID, "tag": tag})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+// GetDeliveryTargets возвращает, как доставлять обновления ссылки каждому отслеживающему её пользователю.
+// Режимы тегов ссылки важнее общего режима пользователя; из нескольких тегов выбирается самый срочный режим.
+func (r *DeliveryRepoGoqu) GetDeliveryTargets(ctx context.Context, linkID int64) (map[int64]domain.DeliveryTarget, error) {
+	tagMode := r.db.From(goqu.T("tag_delivery_modes").As("tm")).
+		Join(goqu.T("track_tags").As("tt"), goqu.On(
+			goqu.I("tt.tg_id").Eq(goqu.I("tm.tg_id")),
+			goqu.I("tt.tag").Eq(goqu.I("tm.tag")),
+		)).
+		Select("tm.mode").
+		Where(
+			goqu.I("tm.tg_id").Eq(goqu.I("t.tg_id")),
+			goqu.I("tt.url_id").Eq(goqu.I("t.url_id")),
+		).
+		Order(goqu.L("CASE tm.mode WHEN 'immediate' THEN 0 WHEN 'hourly' THEN 1 ELSE 2 END").Asc()).
+		Limit(1)
+
+	ds := r.db.From(goqu.T("tracks").As("t")).
+		LeftJoin(goqu.T("delivery_settings").As("s"), goqu.On(goqu.I("s.tg_id").Eq(goqu.I("t.tg_id")))).
+		Select(
+			"t.tg_id",
+			tagMode,
+			goqu.COALESCE(goqu.I("s.mode"), string(domain.DeliveryImmediate)),
+			goqu.COALESCE(goqu.I("s.timezone"), domain.DefaultTimezone),
+			goqu.COALESCE(goqu.I("s.quiet_start"), 0),
+			goqu.COALESCE(goqu.I("s.quiet_end"), 0),
+		).
+		Where(goqu.Ex{"t.url_id": linkID})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	targets := make(map[int64]domain.DeliveryTarget)
+
+	for rows.Next() {
+		var (
+			tgID     int64
+			tagMode  pgtype.Text
+			userMode string
+			target   domain.DeliveryTarget
+		)
+
+		err := rows.Scan(&tgID, &tagMode, &userMode, &target.Timezone, &target.Quiet.Start, &target.Quiet.End)
+		if err != nil {
+			return nil, err
+		}
+
+		target.Mode = domain.DeliveryMode(userMode)
+		if tagMode.Valid {
+			target.Mode = domain.DeliveryMode(tagMode.String)
+			target.Urgent = target.Mode == domain.DeliveryImmediate
+		}
+
+		targets[tgID] = target
+	}
+
+	return targets, rows.Err()
+}
+
+func (r *DeliveryRepoGoqu) AddPendingUpdate(ctx context.Context, tgID int64, mode domain.DeliveryMode,
+	update *domain.LinkUpdate) error {
+	details := &update.Details
+
+	var eventAt any
+	if !details.CreatedAt.IsZero() {
+		eventAt = details.CreatedAt.UTC()
+	}
+
+	ds := r.db.Insert("pending_updates").Rows(goqu.Record{
+		"tg_id":       tgID,
+		"url_id":      update.Link.ID,
+		"url":         update.Link.URL,
+		"mode":        string(mode),
+		"description": update.Description,
+		"kind":        details.Kind,
+		"title":       details.Title,
+		"item_url":    details.URL,
+		"author":      details.Author,
+		"author_url":  details.AuthorURL,
+		"event_at":    eventAt,
+		"preview":     details.Preview,
+		"created_at":  time.Now().UTC(),
+	})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+func (r *DeliveryRepoGoqu) GetPendingUsers(ctx context.Context) ([]int64, error) {
+	sql, args, err := r.db.From("pending_updates").Select("tg_id").Distinct().ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tgIDs []int64
+
+	for rows.Next() {
+		var tgID int64
+		if err := rows.Scan(&tgID); err != nil {
+			return nil, err
+		}
+
+		tgIDs = append(tgIDs, tgID)
+	}
+
+	return tgIDs, rows.Err()
+}
+
+// GetPendingUpdates возвращает отложенные в режиме mode обновления, накопленные до createdBefore.
+func (r *DeliveryRepoGoqu) GetPendingUpdates(ctx context.Context, tgID int64, mode domain.DeliveryMode,
+	createdBefore time.Time) ([]domain.PendingUpdate, error) {
+	ds := r.db.From("pending_updates").
+		Select("id", "url_id", "url", "description", "kind", "title", "item_url", "author", "author_url",
+			"event_at", "preview").
+		Where(goqu.Ex{"tg_id": tgID, "mode": string(mode)}, goqu.C("created_at").Lt(createdBefore)).
+		Order(goqu.C("id").Asc())
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var updates []domain.PendingUpdate
+
+	for rows.Next() {
+		var (
+			pending domain.PendingUpdate
+			eventAt pgtype.Timestamp
+		)
+
+		update := &pending.Update
+		details := &update.Details
+
+		err := rows.Scan(&pending.ID, &update.Link.ID, &update.Link.URL, &update.Description,
+			&details.Kind, &details.Title, &details.URL, &details.Author, &details.AuthorURL, &eventAt, &details.Preview)
+		if err != nil {
+			return nil, err
+		}
+
+		if eventAt.Valid {
+			details.CreatedAt = eventAt.Time
+		}
+
+		updates = append(updates, pending)
+	}
+
+	return updates, rows.Err()
+}
+
+func (r *DeliveryRepoGoqu) DeletePendingUpdates(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sql, args, err := r.db.Delete("pending_updates").Where(goqu.Ex{"id": ids}).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"LinkTracker/internal/domain"
 
@@ -21,6 +22,9 @@ type LinkRepoGoqu struct {
 	db   *goqu.Database
 }
 
+// searchDecayDays — число дней без активности, за которое результат поиска теряет половину релевантности.
+const searchDecayDays = 30
+
 // NewLinkRepoGoqu создаёт новый репозиторий.
 func NewLinkRepoGoqu(pool *pgxpool.Pool) *LinkRepoGoqu {
 	sqlDB := stdlib.OpenDBFromPool(pool)
@@ -33,8 +37,9 @@ func NewLinkRepoGoqu(pool *pgxpool.Pool) *LinkRepoGoqu {
 }
 
 // GetUsersByLink возвращает идентификаторы пользователей, отслеживающих заданную ссылку.
+// Пользователи, поставившие ссылку на паузу, не возвращаются.
 func (r *LinkRepoGoqu) GetUsersByLink(ctx context.Context, linkID int64) ([]int64, error) {
-	ds := r.db.From("tracks").Select("tg_id").Where(goqu.Ex{"url_id": linkID})
+	ds := r.db.From("tracks").Select("tg_id").Where(goqu.Ex{"url_id": linkID}, activeTracks(time.Now().UTC()))
 
 	sql, args, err := ds.ToSQL()
 	if err != nil {
@@ -63,11 +68,22 @@ func (r *LinkRepoGoqu) GetUsersByLink(ctx context.Context, linkID int64) ([]int6
 
 // GetUserLinks возвращает все ссылки пользователя с их связанными фильтрами и тегами.
 func (r *LinkRepoGoqu) GetUserLinks(ctx context.Context, id int64) ([]domain.Link, error) {
-	// Формируем запрос: SELECT t.url_id, u.url, t.filters, t.tags FROM tracks t JOIN urls u ON t.url_id = u.id WHERE t.tg_id = ?
+	// Формируем запрос: SELECT t.url_id, u.url, <фильтры>, <теги> FROM tracks t JOIN urls u ON t.url_id = u.id WHERE t.tg_id = ?
+	return r.queryUserLinks(ctx, goqu.Ex{"tracks.tg_id": id})
+}
+
+// GetUserLinksByTag возвращает ссылки пользователя, у которых есть заданный тег.
+func (r *LinkRepoGoqu) GetUserLinksByTag(ctx context.Context, id int64, tag string) ([]domain.Link, error) {
+	return r.queryUserLinks(ctx, goqu.Ex{"tracks.tg_id": id}, r.hasTag(tag))
+}
+
+func (r *LinkRepoGoqu) queryUserLinks(ctx context.Context, conditions ...goqu.Expression) ([]domain.Link, error) {
 	ds := r.db.From("tracks").
 		Join(goqu.I("urls"), goqu.On(goqu.Ex{"tracks.url_id": goqu.I("urls.id")})).
-		Select("tracks.url_id", "urls.url", "tracks.filters", "tracks.tags").
-		Where(goqu.Ex{"tracks.tg_id": id})
+		Select("tracks.url_id", "urls.url", r.trackFilters(), r.trackTags(), "tracks.active", "tracks.muted_until",
+			"urls.last_update", "urls.title", "urls.description", "urls.stars", "urls.answers", "urls.status",
+			"urls.last_checked_at", "urls.last_error", "urls.consecutive_failures").
+		Where(conditions...)
 
 	sql, args, err := ds.ToSQL()
 	if err != nil {
@@ -82,19 +98,220 @@ func (r *LinkRepoGoqu) GetUserLinks(ctx context.Context, id int64) ([]domain.Lin
 
 	var links []domain.Link
 
+	now := time.Now().UTC()
+
 	for rows.Next() {
-		var link domain.Link
-		// При условии, что pgx может корректно сканировать TEXT ARRAY в []string
-		if err := rows.Scan(&link.ID, &link.URL, &link.Filters, &link.Tags); err != nil {
+		var (
+			link          domain.Link
+			active        bool
+			mutedUntil    *time.Time
+			lastCheckedAt *time.Time
+		)
+
+		targets := append([]any{&link.ID, &link.URL, &link.Filters, &link.Tags, &active, &mutedUntil, &link.LastUpdated},
+			metadataScanTargets(&link.Metadata)...)
+		if err := rows.Scan(append(targets, healthScanTargets(&link.Health, &lastCheckedAt)...)...); err != nil {
 			return nil, err
 		}
 
+		setLinkPause(&link, active, mutedUntil, now)
+		setLinkCheckedAt(&link, lastCheckedAt)
 		links = append(links, link)
 	}
 
 	return links, rows.Err()
 }
 
+// SearchUserLinks ищет ссылки пользователя, у которых слова запроса встречаются в адресе, тегах или
+// сохранённых обновлениях. Каждое слово ищется как префикс. Релевантность делится на давность последней
+// активности, так что за searchDecayDays без событий результат теряет половину веса.
+func (r *LinkRepoGoqu) SearchUserLinks(ctx context.Context, id int64, terms []string, limit int64) ([]domain.SearchResult, error) {
+	now := time.Now().UTC()
+
+	tagMatch := r.db.From("track_tags").
+		Select(goqu.MAX(goqu.L("ts_rank(to_tsvector('simple', track_tags.tag), q.query)")).As("tag_rank")).
+		Where(
+			goqu.Ex{"track_tags.tg_id": goqu.I("tracks.tg_id"), "track_tags.url_id": goqu.I("tracks.url_id")},
+			goqu.L("to_tsvector('simple', track_tags.tag) @@ q.query"),
+		)
+
+	updateMatch := r.db.From("updates").
+		Select("updates.id", "updates.description", "updates.kind", "updates.title", "updates.item_url", "updates.author",
+			"updates.author_url", "updates.event_at", "updates.preview", "updates.detected_at",
+			goqu.L("ts_rank(updates.search_vector, q.query)").As("update_rank")).
+		Where(goqu.Ex{"updates.url_id": goqu.I("tracks.url_id")}, goqu.L("updates.search_vector @@ q.query")).
+		Order(goqu.C("update_rank").Desc(), goqu.I("updates.id").Desc()).
+		Limit(1)
+
+	score := goqu.L(`((ts_rank(urls.search_vector, q.query) + COALESCE(tg.tag_rank, 0) + COALESCE(upd.update_rank, 0))
+		/ (1 + GREATEST(EXTRACT(EPOCH FROM ?::timestamp - COALESCE(upd.detected_at, urls.last_update)), 0)
+			/ 86400 / ?))::float8`, now, searchDecayDays)
+
+	ds := r.db.From("tracks").
+		With("q", r.db.Select(goqu.L("to_tsquery('simple', ?)", prefixTSQuery(terms)).As("query"))).
+		Join(goqu.I("urls"), goqu.On(goqu.Ex{"tracks.url_id": goqu.I("urls.id")})).
+		CrossJoin(goqu.T("q")).
+		LeftJoin(goqu.Lateral(tagMatch).As("tg"), goqu.On(goqu.L("TRUE"))).
+		LeftJoin(goqu.Lateral(updateMatch).As("upd"), goqu.On(goqu.L("TRUE"))).
+		Select("tracks.url_id", "urls.url", r.trackFilters(), r.trackTags(), "tracks.active", "tracks.muted_until",
+			score.As("score"), "upd.id",
+			goqu.COALESCE(goqu.I("upd.description"), ""), goqu.COALESCE(goqu.I("upd.kind"), ""),
+			goqu.COALESCE(goqu.I("upd.title"), ""), goqu.COALESCE(goqu.I("upd.item_url"), ""),
+			goqu.COALESCE(goqu.I("upd.author"), ""), goqu.COALESCE(goqu.I("upd.author_url"), ""),
+			"upd.event_at", goqu.COALESCE(goqu.I("upd.preview"), ""), "upd.detected_at").
+		Where(
+			goqu.Ex{"tracks.tg_id": id},
+			goqu.Or(
+				goqu.L("urls.search_vector @@ q.query"),
+				goqu.I("tg.tag_rank").IsNotNull(),
+				goqu.I("upd.id").IsNotNull(),
+			),
+		).
+		Order(goqu.C("score").Desc(), goqu.I("tracks.url_id").Desc()).
+		Limit(uint(limit)) //nolint // integer overflow conversion int64 -> uint (gosec) limit is validated by caller
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.SearchResult
+
+	for rows.Next() {
+		var (
+			result     domain.SearchResult
+			record     domain.UpdateRecord
+			active     bool
+			mutedUntil *time.Time
+			updateID   *int64
+			eventAt    pgtype.Timestamp
+			detectedAt pgtype.Timestamp
+		)
+
+		details := &record.Details
+
+		err := rows.Scan(&result.Link.ID, &result.Link.URL, &result.Link.Filters, &result.Link.Tags, &active, &mutedUntil,
+			&result.Rank, &updateID, &record.Description, &details.Kind, &details.Title, &details.URL, &details.Author,
+			&details.AuthorURL, &eventAt, &details.Preview, &detectedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		setLinkPause(&result.Link, active, mutedUntil, now)
+
+		if updateID != nil {
+			record.ID, record.LinkID = *updateID, result.Link.ID
+			details.CreatedAt, record.DetectedAt = eventAt.Time, detectedAt.Time
+			result.Update = &record
+		}
+
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+// GetUserTags возвращает теги пользователя с количеством ссылок под каждым, отсортированные по имени.
+func (r *LinkRepoGoqu) GetUserTags(ctx context.Context, id int64) ([]domain.TagCount, error) {
+	ds := r.db.From("track_tags").
+		Select("tag", goqu.COUNT("*")).
+		Where(goqu.Ex{"tg_id": id}).
+		GroupBy("tag").
+		Order(goqu.C("tag").Asc())
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []domain.TagCount
+
+	for rows.Next() {
+		var tag domain.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Links); err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// RenameTag переименовывает тег во всех ссылках пользователя и возвращает число изменённых ссылок.
+// Если у ссылки уже есть новый тег, старый просто удаляется, чтобы не появился дубликат.
+func (r *LinkRepoGoqu) RenameTag(ctx context.Context, id int64, oldTag, newTag string) (renamed int64, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback(ctx))
+		}
+	}()
+
+	newTagExists := r.db.From(goqu.T("track_tags").As("n")).
+		Select(goqu.L("1")).
+		Where(goqu.Ex{"n.tg_id": goqu.I("track_tags.tg_id"), "n.url_id": goqu.I("track_tags.url_id"), "n.tag": newTag})
+
+	dsDeleteDuplicates := r.db.Delete("track_tags").
+		Where(goqu.Ex{"tg_id": id, "tag": oldTag}, goqu.L("? <> ?", oldTag, newTag), goqu.L("EXISTS ?", newTagExists))
+
+	deleted, err := r.execInTx(ctx, tx, dsDeleteDuplicates)
+	if err != nil {
+		return 0, err
+	}
+
+	dsRename := r.db.Update("track_tags").
+		Set(goqu.Record{"tag": newTag}).
+		Where(goqu.Ex{"tg_id": id, "tag": oldTag})
+
+	updated, err := r.execInTx(ctx, tx, dsRename)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return deleted + updated, nil
+}
+
+// sqlBuilder — построитель запроса goqu любого вида.
+type sqlBuilder interface {
+	ToSQL() (string, []any, error)
+}
+
+// execInTx выполняет запрос в транзакции и возвращает число затронутых строк.
+func (r *LinkRepoGoqu) execInTx(ctx context.Context, tx pgx.Tx, ds sqlBuilder) (int64, error) {
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
 // AddLink добавляет новую ссылку, вставляя её в таблицу urls и создавая трек в таблице tracks.
 func (r *LinkRepoGoqu) AddLink(ctx context.Context, id int64, link *domain.Link) (domain.Link, error) {
 	tx, err := r.pool.Begin(ctx)
@@ -108,10 +325,12 @@ func (r *LinkRepoGoqu) AddLink(ctx context.Context, id int64, link *domain.Link)
 		}
 	}()
 
-	// Вставляем запись в таблицу urls с возвратом id
+	// Вставляем запись в таблицу urls с возвратом id. Ссылку, которую уже отслеживает другой пользователь,
+	// повторно не создаём
 	dsInsertURL := r.db.Insert("urls").
 		Cols("url", "last_update").
 		Vals(goqu.Vals{link.URL, time.Now().UTC()}).
+		OnConflict(goqu.DoUpdate("url", goqu.Record{"url": goqu.I("excluded.url")})).
 		Returning("id")
 
 	sqlURL, argsURL, err := dsInsertURL.ToSQL()
@@ -126,8 +345,8 @@ func (r *LinkRepoGoqu) AddLink(ctx context.Context, id int64, link *domain.Link)
 
 	// Вставляем трек для пользователя и созданного url
 	dsInsertTrack := r.db.Insert("tracks").
-		Cols("tg_id", "url_id", "filters", "tags").
-		Vals(goqu.Vals{id, urlID, stringArrayToPostgres(link.Filters), stringArrayToPostgres(link.Tags)})
+		Cols("tg_id", "url_id").
+		Vals(goqu.Vals{id, urlID})
 
 	sqlTrack, argsTrack, err := dsInsertTrack.ToSQL()
 	if err != nil {
@@ -138,6 +357,10 @@ func (r *LinkRepoGoqu) AddLink(ctx context.Context, id int64, link *domain.Link)
 		return domain.Link{}, err
 	}
 
+	if err = r.insertTrackTagsAndFilters(ctx, tx, id, urlID, link); err != nil {
+		return domain.Link{}, err
+	}
+
 	link.ID = urlID
 
 	return *link, tx.Commit(ctx)
@@ -177,7 +400,7 @@ func (r *LinkRepoGoqu) DeleteLink(ctx context.Context, id int64, link *domain.Li
 }
 func (r *LinkRepoGoqu) selectLinkInfo(ctx context.Context, tx pgx.Tx, id int64, link *domain.Link) (*domain.Link, error) {
 	ds := r.db.From("urls").Join(goqu.I("tracks"), goqu.On(goqu.Ex{"urls.id": goqu.I("tracks.url_id")})).
-		Select("urls.id", "urls.last_update", "tracks.filters", "tracks.tags").
+		Select("urls.id", "urls.last_update", r.trackFilters(), r.trackTags()).
 		Where(goqu.Ex{"urls.url": link.URL, "tracks.tg_id": id})
 
 	sql, args, err := ds.ToSQL()
@@ -278,10 +501,16 @@ func (r *LinkRepoGoqu) GetAllLinks(ctx context.Context) ([]domain.Link, error) {
 }
 
 // GetLinksAfter возвращает записи из таблицы urls с last_update > заданного значения.
+// Ссылки, которые все подписчики поставили на паузу, и ссылки на удалённые источники не проверяются.
 func (r *LinkRepoGoqu) GetLinksAfter(ctx context.Context, lastUpdate time.Time, limit int64) ([]domain.Link, error) {
+	activeSubscribers := r.db.From("tracks").
+		Select(goqu.L("1")).
+		Where(goqu.Ex{"tracks.url_id": goqu.I("urls.id")}, activeTracks(time.Now().UTC()))
+
 	ds := r.db.From("urls").
-		Select("id", "url", "last_update").
-		Where(goqu.C("last_update").Gt(lastUpdate)).
+		Select("id", "url", "last_update", "title", "description", "stars", "answers", "status").
+		Where(goqu.C("last_update").Gt(lastUpdate), goqu.C("status").Neq(string(domain.SourceDeleted)),
+			goqu.L("EXISTS ?", activeSubscribers)).
 		Order(goqu.C("last_update").Asc()).
 		Limit(uint(limit)) //nolint // integer overflow conversion int64 -> uint (gosec) it is impossible
 
@@ -300,7 +529,7 @@ func (r *LinkRepoGoqu) GetLinksAfter(ctx context.Context, lastUpdate time.Time,
 
 	for rows.Next() {
 		var link domain.Link
-		if err := rows.Scan(&link.ID, &link.URL, &link.LastUpdated); err != nil {
+		if err := rows.Scan(append([]any{&link.ID, &link.URL, &link.LastUpdated}, metadataScanTargets(&link.Metadata)...)...); err != nil {
 			return nil, err
 		}
 
@@ -338,26 +567,90 @@ func (r *LinkRepoGoqu) UpdateLink(ctx context.Context, tgID int64, link *domain.
 		return err
 	}
 
-	// Обновляем трек с новыми тегами и фильтрами
-	dsUpdate := r.db.Update("tracks").
-		Set(goqu.Record{
-			"tags":    stringArrayToPostgres(link.Tags),
-			"filters": stringArrayToPostgres(link.Filters),
-		}).
-		Where(goqu.Ex{"tg_id": tgID, "url_id": urlID})
+	// Заменяем теги и фильтры трека новыми
+	for _, table := range []string{"track_tags", "track_filters"} {
+		dsDelete := r.db.Delete(table).Where(goqu.Ex{"tg_id": tgID, "url_id": urlID})
 
-	sqlUpdate, argsUpdate, err := dsUpdate.ToSQL()
-	if err != nil {
-		return err
+		if _, err = r.execInTx(ctx, tx, dsDelete); err != nil {
+			return err
+		}
 	}
 
-	if _, err = tx.Exec(ctx, sqlUpdate, argsUpdate...); err != nil {
+	if err = r.insertTrackTagsAndFilters(ctx, tx, tgID, urlID, link); err != nil {
 		return err
 	}
 
 	return tx.Commit(ctx)
 }
 
+// insertTrackTagsAndFilters сохраняет теги и фильтры трека, запоминая их порядок. Повторяющиеся теги
+// сохраняются один раз.
+func (r *LinkRepoGoqu) insertTrackTagsAndFilters(ctx context.Context, tx pgx.Tx, tgID, urlID int64, link *domain.Link) error {
+	if len(link.Tags) > 0 {
+		rows := make([][]any, 0, len(link.Tags))
+		for i, tag := range link.Tags {
+			rows = append(rows, goqu.Vals{tgID, urlID, tag, i + 1})
+		}
+
+		ds := r.db.Insert("track_tags").
+			Cols("tg_id", "url_id", "tag", "position").
+			Vals(rows...).
+			OnConflict(goqu.DoNothing())
+
+		if _, err := r.execInTx(ctx, tx, ds); err != nil {
+			return err
+		}
+	}
+
+	if len(link.Filters) > 0 {
+		rows := make([][]any, 0, len(link.Filters))
+		for i, filter := range link.Filters {
+			rows = append(rows, goqu.Vals{tgID, urlID, filter, i + 1})
+		}
+
+		ds := r.db.Insert("track_filters").
+			Cols("tg_id", "url_id", "filter", "position").
+			Vals(rows...)
+
+		if _, err := r.execInTx(ctx, tx, ds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PauseLinks приостанавливает уведомления по ссылкам пользователя до until. Нулевое until
+// ставит бессрочную паузу.
+func (r *LinkRepoGoqu) PauseLinks(ctx context.Context, tgID int64, linkIDs []int64, until time.Time) error {
+	record := goqu.Record{"active": false, "muted_until": nil}
+	if !until.IsZero() {
+		record = goqu.Record{"active": true, "muted_until": until}
+	}
+
+	return r.updateTracks(ctx, tgID, linkIDs, record)
+}
+
+// ResumeLinks снимает паузу со ссылок пользователя.
+func (r *LinkRepoGoqu) ResumeLinks(ctx context.Context, tgID int64, linkIDs []int64) error {
+	return r.updateTracks(ctx, tgID, linkIDs, goqu.Record{"active": true, "muted_until": nil})
+}
+
+func (r *LinkRepoGoqu) updateTracks(ctx context.Context, tgID int64, linkIDs []int64, record goqu.Record) error {
+	ds := r.db.Update("tracks").
+		Set(record).
+		Where(goqu.Ex{"tg_id": tgID, "url_id": linkIDs})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
 // UpdateTimeLink обновляет время последнего обновления для url.
 func (r *LinkRepoGoqu) UpdateTimeLink(ctx context.Context, lastUpdate time.Time, id int64) error {
 	ds := r.db.Update("urls").
@@ -374,6 +667,403 @@ func (r *LinkRepoGoqu) UpdateTimeLink(ctx context.Context, lastUpdate time.Time,
 	return err
 }
 
-func stringArrayToPostgres(arr []string) string {
-	return "{" + strings.Join(arr, ",") + "}"
+// UpdateLinkMetadata сохраняет метаданные источника ссылки.
+func (r *LinkRepoGoqu) UpdateLinkMetadata(ctx context.Context, id int64, metadata *domain.LinkMetadata) error {
+	ds := r.db.Update("urls").
+		Set(goqu.Record{
+			"title":       metadata.Title,
+			"description": metadata.Description,
+			"stars":       metadata.Stars,
+			"answers":     metadata.Answers,
+			"status":      string(metadata.Status),
+		}).
+		Where(goqu.Ex{"id": id})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+// RecordLinkCheck сохраняет результат проверки ссылки и возвращает число ошибок подряд. Пустой checkErr
+// означает успешную проверку и сбрасывает счётчик.
+func (r *LinkRepoGoqu) RecordLinkCheck(ctx context.Context, id int64, checkedAt time.Time, checkErr string) (int64, error) {
+	failures := goqu.L("consecutive_failures + 1")
+	if checkErr == "" {
+		failures = goqu.L("0")
+	}
+
+	ds := r.db.Update("urls").
+		Set(goqu.Record{
+			"last_checked_at":      checkedAt,
+			"last_error":           checkErr,
+			"consecutive_failures": failures,
+		}).
+		Where(goqu.Ex{"id": id}).
+		Returning("consecutive_failures")
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+
+	err = r.pool.QueryRow(ctx, sql, args...).Scan(&count)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrLinkNotExist{}
+	}
+
+	return count, err
+}
+
+// GetUnhealthyLinks возвращает ссылки, проверка которых завершилась ошибкой не меньше minFailures раз подряд,
+// начиная с самых долго не работающих.
+func (r *LinkRepoGoqu) GetUnhealthyLinks(ctx context.Context, minFailures int64) ([]domain.Link, error) {
+	ds := r.db.From("urls").
+		Select("id", "url", "last_update", "title", "description", "stars", "answers", "status",
+			"last_checked_at", "last_error", "consecutive_failures").
+		Where(goqu.C("consecutive_failures").Gte(max(minFailures, 1))).
+		Order(goqu.C("consecutive_failures").Desc(), goqu.C("id").Asc())
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []domain.Link
+
+	for rows.Next() {
+		var (
+			link          domain.Link
+			lastCheckedAt *time.Time
+		)
+
+		targets := append([]any{&link.ID, &link.URL, &link.LastUpdated}, metadataScanTargets(&link.Metadata)...)
+		if err := rows.Scan(append(targets, healthScanTargets(&link.Health, &lastCheckedAt)...)...); err != nil {
+			return nil, err
+		}
+
+		setLinkCheckedAt(&link, lastCheckedAt)
+		links = append(links, link)
+	}
+
+	return links, rows.Err()
+}
+
+// GetLinkByURL возвращает ссылку по каноническому адресу вместе с метаданными и результатом проверок.
+func (r *LinkRepoGoqu) GetLinkByURL(ctx context.Context, url string) (domain.Link, error) {
+	ds := r.db.From("urls").
+		Select("id", "url", "last_update", "title", "description", "stars", "answers", "status",
+			"last_checked_at", "last_error", "consecutive_failures").
+		Where(goqu.Ex{"url": url})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return domain.Link{}, err
+	}
+
+	var (
+		link          domain.Link
+		lastCheckedAt *time.Time
+	)
+
+	targets := append([]any{&link.ID, &link.URL, &link.LastUpdated}, metadataScanTargets(&link.Metadata)...)
+
+	err = r.pool.QueryRow(ctx, sql, args...).Scan(append(targets, healthScanTargets(&link.Health, &lastCheckedAt)...)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Link{}, domain.ErrLinkNotExist{}
+	}
+
+	if err != nil {
+		return domain.Link{}, err
+	}
+
+	setLinkCheckedAt(&link, lastCheckedAt)
+
+	return link, nil
+}
+
+// GetTrackingStats возвращает число пользователей, отслеживающих хотя бы одну ссылку, и число отслеживаемых ссылок.
+func (r *LinkRepoGoqu) GetTrackingStats(ctx context.Context) (domain.TrackingStats, error) {
+	ds := r.db.From("tracks").
+		Select(goqu.COUNT(goqu.DISTINCT("tg_id")), goqu.COUNT(goqu.DISTINCT("url_id")))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return domain.TrackingStats{}, err
+	}
+
+	var stats domain.TrackingStats
+
+	err = r.pool.QueryRow(ctx, sql, args...).Scan(&stats.Users, &stats.Links)
+
+	return stats, err
+}
+
+// MoveLink меняет адрес ссылки linkID на newURL и возвращает идентификатор, под которым ссылка хранится
+// дальше. Если newURL уже отслеживается, ссылки объединяются: подписчики, теги, фильтры, история и
+// отложенные обновления старой ссылки переходят к существующей, а старая запись удаляется. Трек
+// пользователя, который уже подписан на newURL, остаётся без изменений.
+func (r *LinkRepoGoqu) MoveLink(ctx context.Context, id int64, newURL string) (targetID int64, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback(ctx))
+		}
+	}()
+
+	sqlTarget, argsTarget, err := r.db.From("urls").Select("id").Where(goqu.Ex{"url": newURL}).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.QueryRow(ctx, sqlTarget, argsTarget...).Scan(&targetID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		_, err = r.execInTx(ctx, tx, r.db.Update("urls").Set(goqu.Record{"url": newURL}).Where(goqu.Ex{"id": id}))
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err = r.execInTx(ctx, tx, r.movePendingUpdates(id, id, newURL)); err != nil {
+			return 0, err
+		}
+
+		return id, tx.Commit(ctx)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err = r.moveTracks(ctx, tx, id, targetID); err != nil {
+		return 0, err
+	}
+
+	// История и отложенные обновления переносятся до удаления старой записи
+	for _, ds := range []sqlBuilder{
+		r.db.Update("updates").Set(goqu.Record{"url_id": targetID}).Where(goqu.Ex{"url_id": id}),
+		r.movePendingUpdates(id, targetID, newURL),
+		r.db.Delete("tracks").Where(goqu.Ex{"url_id": id}),
+		r.db.Delete("urls").Where(goqu.Ex{"id": id}),
+	} {
+		if _, err = r.execInTx(ctx, tx, ds); err != nil {
+			return 0, err
+		}
+	}
+
+	return targetID, tx.Commit(ctx)
+}
+
+// moveTracks копирует треки ссылки id вместе с тегами и фильтрами на ссылку targetID для пользователей,
+// ещё не подписанных на targetID. Сами треки ссылки id не удаляются.
+func (r *LinkRepoGoqu) moveTracks(ctx context.Context, tx pgx.Tx, id, targetID int64) error {
+	movedUsers, err := r.usersNotTracking(ctx, tx, id, targetID)
+	if err != nil {
+		return err
+	}
+
+	// Трек копируется раньше тегов и фильтров, которые на него ссылаются
+	trackTables := []struct {
+		table string
+		cols  []string
+	}{
+		{table: "tracks", cols: []string{"tg_id", "active", "muted_until"}},
+		{table: "track_tags", cols: []string{"tg_id", "tag", "position"}},
+		{table: "track_filters", cols: []string{"tg_id", "position", "filter"}},
+	}
+
+	// Пустой список пользователей goqu превращает в некорректное IN ()
+	if len(movedUsers) > 0 {
+		for _, t := range trackTables {
+			err = r.copyTrackRows(ctx, tx, t.table, t.cols, goqu.Ex{"url_id": id, "tg_id": movedUsers}, targetID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// movePendingUpdates переносит отложенные обновления ссылки id на ссылку targetID с адресом url.
+func (r *LinkRepoGoqu) movePendingUpdates(id, targetID int64, url string) *goqu.UpdateDataset {
+	return r.db.Update("pending_updates").
+		Set(goqu.Record{"url_id": targetID, "url": url}).
+		Where(goqu.Ex{"url_id": id})
+}
+
+// usersNotTracking возвращает подписчиков ссылки id, которые не подписаны на ссылку targetID.
+func (r *LinkRepoGoqu) usersNotTracking(ctx context.Context, tx pgx.Tx, id, targetID int64) ([]int64, error) {
+	targetTracked := r.db.From(goqu.T("tracks").As("n")).
+		Select(goqu.L("1")).
+		Where(goqu.Ex{"n.tg_id": goqu.I("tracks.tg_id"), "n.url_id": targetID})
+
+	ds := r.db.From("tracks").
+		Select("tg_id").
+		Where(goqu.Ex{"url_id": id}, goqu.L("NOT EXISTS ?", targetTracked))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tgIDs []int64
+
+	for rows.Next() {
+		var tgID int64
+		if err := rows.Scan(&tgID); err != nil {
+			return nil, err
+		}
+
+		tgIDs = append(tgIDs, tgID)
+	}
+
+	return tgIDs, rows.Err()
+}
+
+// copyTrackRows копирует выбранные строки таблицы трека, заменяя в копиях url_id на targetID.
+func (r *LinkRepoGoqu) copyTrackRows(ctx context.Context, tx pgx.Tx, table string, cols []string, where goqu.Ex,
+	targetID int64) error {
+	selectCols := make([]any, len(cols))
+	for i, col := range cols {
+		selectCols[i] = col
+	}
+
+	sql, args, err := r.db.From(table).Select(selectCols...).Where(where).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	var records []any
+
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		record := goqu.Record{"url_id": targetID}
+		for i, col := range cols {
+			record[col] = values[i]
+		}
+
+		records = append(records, record)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil || len(records) == 0 {
+		return err
+	}
+
+	_, err = r.execInTx(ctx, tx, r.db.Insert(table).Rows(records...))
+
+	return err
+}
+
+// activeTracks отбирает треки, уведомления по которым на момент now не приостановлены.
+func activeTracks(now time.Time) goqu.Expression {
+	return goqu.And(
+		goqu.I("tracks.active").IsTrue(),
+		goqu.Or(goqu.I("tracks.muted_until").IsNull(), goqu.I("tracks.muted_until").Lte(now)),
+	)
+}
+
+// hasTag отбирает треки, у которых есть заданный тег.
+func (r *LinkRepoGoqu) hasTag(tag string) goqu.Expression {
+	tagged := r.db.From("track_tags").
+		Select(goqu.L("1")).
+		Where(goqu.Ex{
+			"track_tags.tg_id":  goqu.I("tracks.tg_id"),
+			"track_tags.url_id": goqu.I("tracks.url_id"),
+			"track_tags.tag":    tag,
+		})
+
+	return goqu.L("EXISTS ?", tagged)
+}
+
+// trackTags собирает теги трека в массив в порядке добавления.
+func (r *LinkRepoGoqu) trackTags() goqu.Expression {
+	return r.trackValues("track_tags", "tag")
+}
+
+// trackFilters собирает фильтры трека в массив в порядке добавления.
+func (r *LinkRepoGoqu) trackFilters() goqu.Expression {
+	return r.trackValues("track_filters", "filter")
+}
+
+func (r *LinkRepoGoqu) trackValues(table, column string) goqu.Expression {
+	values := r.db.From(table).
+		Select(goqu.L("array_agg(? ORDER BY ?)", goqu.I(table+"."+column), goqu.I(table+".position"))).
+		Where(goqu.Ex{table + ".tg_id": goqu.I("tracks.tg_id"), table + ".url_id": goqu.I("tracks.url_id")})
+
+	return goqu.COALESCE(values, goqu.L("'{}'"))
+}
+
+// setLinkPause переводит поля active и muted_until трека в состояние паузы ссылки на момент now.
+func setLinkPause(link *domain.Link, active bool, mutedUntil *time.Time, now time.Time) {
+	switch {
+	case !active:
+		link.Paused = true
+	case mutedUntil != nil && mutedUntil.After(now):
+		link.Paused = true
+		link.PausedUntil = *mutedUntil
+	}
+}
+
+// prefixTSQuery собирает tsquery, в котором каждое слово ищется как префикс и все слова обязательны.
+// Слова запроса состоят только из букв и цифр, поэтому экранирование не требуется.
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+
+	return strings.Join(parts, " & ")
+}
+
+// metadataScanTargets возвращает поля метаданных для Scan в порядке столбцов title, description, stars,
+// answers, status.
+func metadataScanTargets(metadata *domain.LinkMetadata) []any {
+	return []any{&metadata.Title, &metadata.Description, &metadata.Stars, &metadata.Answers, (*string)(&metadata.Status)}
+}
+
+// healthScanTargets возвращает поля результата проверок для Scan в порядке столбцов last_checked_at,
+// last_error, consecutive_failures. Время последней проверки может быть NULL и читается в lastCheckedAt.
+func healthScanTargets(health *domain.LinkHealth, lastCheckedAt **time.Time) []any {
+	return []any{lastCheckedAt, &health.LastError, &health.ConsecutiveFailures}
+}
+
+// setLinkCheckedAt переносит время последней проверки в ссылку, если ссылка уже проверялась.
+func setLinkCheckedAt(link *domain.Link, lastCheckedAt *time.Time) {
+	if lastCheckedAt != nil {
+		link.Health.LastCheckedAt = *lastCheckedAt
+	}
 }
@@ -2,6 +2,8 @@ package goqurepo
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/stdlib"
 
@@ -27,10 +29,18 @@ func NewStateRepoGoqu(pool *pgxpool.Pool) *StateRepoGoqu {
 	}
 }
 
-func (r *StateRepoGoqu) CreateState(ctx context.Context, tgID int64, state int) error {
+// CreateState начинает диалог участника userID в чате tgID, заменяя незавершённый.
+func (r *StateRepoGoqu) CreateState(ctx context.Context, tgID, userID int64, state int, updatedAt time.Time) error {
 	ds := r.db.Insert("states").
-		Rows(goqu.Record{"tg_id": tgID, "state": state}).
-		OnConflict(goqu.DoUpdate("tg_id", goqu.Record{"state": state}))
+		Rows(goqu.Record{"tg_id": tgID, "user_id": userID, "state": state, "updated_at": updatedAt}).
+		OnConflict(goqu.DoUpdate("tg_id", goqu.Record{
+			"user_id":    userID,
+			"state":      state,
+			"url":        nil,
+			"tags":       nil,
+			"filters":    nil,
+			"updated_at": updatedAt,
+		}))
 
 	sql, args, err := ds.ToSQL()
 	if err != nil {
@@ -55,23 +65,27 @@ func (r *StateRepoGoqu) DeleteState(ctx context.Context, tgID int64) error {
 	return err
 }
 
-func (r *StateRepoGoqu) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
-	ds := r.db.From("states").Select("state", "url", "tags", "filters").Where(goqu.Ex{"tg_id": tgID})
+// GetState возвращает шаг диалога в чате tgID, начавшего его участника и собранные данные ссылки.
+func (r *StateRepoGoqu) GetState(ctx context.Context, tgID int64, updatedAfter time.Time) (int, int64, domain.Link, error) {
+	ds := r.db.From("states").
+		Select("state", "user_id", "url", "tags", "filters").
+		Where(goqu.Ex{"tg_id": tgID}, goqu.C("updated_at").Gt(updatedAfter))
 
 	sql, args, err := ds.ToSQL()
 	if err != nil {
-		return -1, domain.Link{}, err
+		return -1, 0, domain.Link{}, err
 	}
 
 	var (
 		state   int
+		userID  int64
 		url     pgtype.Text
 		tags    pgtype.Array[string]
 		filters pgtype.Array[string]
 	)
 
-	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&state, &url, &tags, &filters); err != nil {
-		return -1, domain.Link{}, err
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&state, &userID, &url, &tags, &filters); err != nil {
+		return -1, 0, domain.Link{}, err
 	}
 
 	link := domain.Link{
@@ -91,16 +105,19 @@ func (r *StateRepoGoqu) GetState(ctx context.Context, tgID int64) (int, domain.L
 		link.Filters = filters.Elements
 	}
 
-	return state, link, nil
+	return state, userID, link, nil
 }
 
-func (r *StateRepoGoqu) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error {
+// UpdateState сохраняет следующий шаг диалога. updated_at обновляется, чтобы срок жизни состояния
+// отсчитывался от последнего действия пользователя.
+func (r *StateRepoGoqu) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link, updatedAt time.Time) error {
 	ds := r.db.Update("states").
 		Set(goqu.Record{
-			"state":   state,
-			"url":     link.URL,
-			"tags":    stringArrayToPostgres(link.Tags),
-			"filters": stringArrayToPostgres(link.Filters),
+			"state":      state,
+			"url":        link.URL,
+			"tags":       textArray(link.Tags),
+			"filters":    textArray(link.Filters),
+			"updated_at": updatedAt,
 		}).
 		Where(goqu.Ex{"tg_id": tgID})
 
@@ -113,3 +130,34 @@ func (r *StateRepoGoqu) UpdateState(ctx context.Context, tgID int64, state int,
 
 	return err
 }
+
+func (r *StateRepoGoqu) DeleteExpiredStates(ctx context.Context, updatedBefore time.Time) (int64, error) {
+	ds := r.db.Delete("states").Where(goqu.C("updated_at").Lte(updatedBefore))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// textArray собирает массив TEXT из отдельных экранированных значений, поэтому запятые, кавычки
+// и фигурные скобки внутри тегов и фильтров не ломают литерал.
+func textArray(values []string) goqu.Expression {
+	if len(values) == 0 {
+		return goqu.L("'{}'::text[]")
+	}
+
+	args := make([]any, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+
+	return goqu.L("ARRAY["+strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")+"]::text[]", args...)
+}
@@ -0,0 +1,138 @@
+package goqurepo
+
+import (
+	"context"
+	"time"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// UpdateRepoGoqu хранит историю обнаруженных обновлений ссылок.
+type UpdateRepoGoqu struct {
+	pool *pgxpool.Pool
+	db   *goqu.Database
+}
+
+func NewUpdateRepoGoqu(pool *pgxpool.Pool) *UpdateRepoGoqu {
+	sqlDB := stdlib.OpenDBFromPool(pool)
+	db := goqu.New("postgres", sqlDB)
+
+	return &UpdateRepoGoqu{
+		pool: pool,
+		db:   db,
+	}
+}
+
+// AddUpdate сохраняет обнаруженное обновление ссылки в историю.
+func (r *UpdateRepoGoqu) AddUpdate(ctx context.Context, update *domain.LinkUpdate) error {
+	details := &update.Details
+
+	var eventAt any
+	if !details.CreatedAt.IsZero() {
+		eventAt = details.CreatedAt.UTC()
+	}
+
+	ds := r.db.Insert("updates").Rows(goqu.Record{
+		"url_id":      update.Link.ID,
+		"description": update.Description,
+		"kind":        details.Kind,
+		"title":       details.Title,
+		"item_url":    details.URL,
+		"author":      details.Author,
+		"author_url":  details.AuthorURL,
+		"event_at":    eventAt,
+		"preview":     details.Preview,
+		"detected_at": time.Now().UTC(),
+	})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+// GetLinkUpdates возвращает страницу истории обновлений ссылки от новых к старым и общее число записей.
+func (r *UpdateRepoGoqu) GetLinkUpdates(ctx context.Context, linkID, limit, offset int64) ([]domain.UpdateRecord, int64, error) {
+	dsCount := r.db.From("updates").Select(goqu.COUNT("*")).Where(goqu.Ex{"url_id": linkID})
+
+	sqlCount, argsCount, err := dsCount.ToSQL()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, sqlCount, argsCount...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	ds := r.db.From("updates").
+		Select("id", "url_id", "description", "kind", "title", "item_url", "author", "author_url",
+			"event_at", "preview", "detected_at").
+		Where(goqu.Ex{"url_id": linkID}).
+		Order(goqu.C("id").Desc()).
+		Limit(uint(limit)).  //nolint // integer overflow conversion int64 -> uint (gosec) limit is validated by caller
+		Offset(uint(offset)) //nolint // integer overflow conversion int64 -> uint (gosec) offset is validated by caller
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var records []domain.UpdateRecord
+
+	for rows.Next() {
+		var (
+			record  domain.UpdateRecord
+			eventAt pgtype.Timestamp
+		)
+
+		details := &record.Details
+
+		err := rows.Scan(&record.ID, &record.LinkID, &record.Description, &details.Kind, &details.Title, &details.URL,
+			&details.Author, &details.AuthorURL, &eventAt, &details.Preview, &record.DetectedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if eventAt.Valid {
+			details.CreatedAt = eventAt.Time
+		}
+
+		records = append(records, record)
+	}
+
+	return records, total, rows.Err()
+}
+
+// DeleteUpdatesBefore удаляет из истории обновления, обнаруженные не позже detectedBefore,
+// и возвращает число удалённых записей.
+func (r *UpdateRepoGoqu) DeleteUpdatesBefore(ctx context.Context, detectedBefore time.Time) (int64, error) {
+	ds := r.db.Delete("updates").Where(goqu.C("detected_at").Lte(detectedBefore.UTC()))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -2,6 +2,10 @@ package goqurepo
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"LinkTracker/internal/domain"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/jackc/pgx/v5"
@@ -90,3 +94,73 @@ func (r *UserRepoGoqu) GetAllUsers(ctx context.Context) ([]int64, error) {
 
 	return tgIDs, nil
 }
+
+// GetUsersLinkCounts возвращает всех пользователей с числом отслеживаемых ими ссылок, включая тех, у кого ссылок нет.
+func (r *UserRepoGoqu) GetUsersLinkCounts(ctx context.Context) ([]domain.UserLinkCount, error) {
+	ds := r.db.From(goqu.T("users").As("u")).
+		LeftJoin(goqu.T("tracks").As("t"), goqu.On(goqu.I("t.tg_id").Eq(goqu.I("u.tg_id")))).
+		Select(goqu.I("u.tg_id"), goqu.COUNT(goqu.I("t.url_id"))).
+		GroupBy(goqu.I("u.tg_id")).
+		Order(goqu.I("u.tg_id").Asc())
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []domain.UserLinkCount
+
+	for rows.Next() {
+		var user domain.UserLinkCount
+		if err := rows.Scan(&user.TgID, &user.Links); err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// SaveTokenHash сохраняет хеш персонального токена пользователя, заменяя выпущенный ранее.
+func (r *UserRepoGoqu) SaveTokenHash(ctx context.Context, tgID int64, tokenHash string) error {
+	createdAt := time.Now().UTC()
+
+	ds := r.db.Insert("api_tokens").
+		Rows(goqu.Record{"tg_id": tgID, "token_hash": tokenHash, "created_at": createdAt}).
+		OnConflict(goqu.DoUpdate("tg_id", goqu.Record{"token_hash": tokenHash, "created_at": createdAt}))
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+
+	return err
+}
+
+// GetUserByTokenHash возвращает tg_id владельца токена с хешем tokenHash или ErrInvalidToken, если такого токена нет.
+func (r *UserRepoGoqu) GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	ds := r.db.From("api_tokens").Select("tg_id").Where(goqu.Ex{"token_hash": tokenHash})
+
+	sql, args, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	var tgID int64
+
+	err = r.pool.QueryRow(ctx, sql, args...).Scan(&tgID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrInvalidToken{}
+	}
+
+	return tgID, err
+}
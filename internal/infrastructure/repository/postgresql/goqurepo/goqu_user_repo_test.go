@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"LinkTracker/internal/domain"
 	"LinkTracker/internal/infrastructure/repository/postgresql"
 	"LinkTracker/internal/infrastructure/repository/postgresql/goqurepo"
 
@@ -55,6 +56,50 @@ func Test_UserRepo(t *testing.T) {
 		assert.NotContains(t, users, testUserID, "Пользователь не был удалён")
 	})
 
+	t.Run("GetUsersLinkCounts", func(t *testing.T) {
+		// Пользователь с двумя ссылками и пользователь без ссылок
+		withLinks, withoutLinks := int64(10003), int64(10004)
+		require.NoError(t, userRepo.CreateUser(ctx, withLinks))
+		require.NoError(t, userRepo.CreateUser(ctx, withoutLinks))
+
+		linkRepo := goqurepo.NewLinkRepoGoqu(pool)
+		_, err := linkRepo.AddLink(ctx, withLinks, &domain.Link{URL: "http://example.com/first"})
+		require.NoError(t, err)
+		_, err = linkRepo.AddLink(ctx, withLinks, &domain.Link{URL: "http://example.com/second"})
+		require.NoError(t, err)
+
+		users, err := userRepo.GetUsersLinkCounts(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, users, domain.UserLinkCount{TgID: withLinks, Links: 2})
+		assert.Contains(t, users, domain.UserLinkCount{TgID: withoutLinks, Links: 0})
+	})
+
+	t.Run("SaveTokenHash and GetUserByTokenHash", func(t *testing.T) {
+		testUserID := int64(10005)
+		require.NoError(t, userRepo.CreateUser(ctx, testUserID))
+		require.NoError(t, userRepo.SaveTokenHash(ctx, testUserID, "first-hash"))
+
+		tgID, err := userRepo.GetUserByTokenHash(ctx, "first-hash")
+		require.NoError(t, err)
+		assert.Equal(t, testUserID, tgID)
+
+		// Повторный выпуск заменяет прежний токен
+		require.NoError(t, userRepo.SaveTokenHash(ctx, testUserID, "second-hash"))
+
+		_, err = userRepo.GetUserByTokenHash(ctx, "first-hash")
+		assert.ErrorAs(t, err, &domain.ErrInvalidToken{})
+
+		tgID, err = userRepo.GetUserByTokenHash(ctx, "second-hash")
+		require.NoError(t, err)
+		assert.Equal(t, testUserID, tgID)
+
+		// Токены удаляются вместе с пользователем
+		require.NoError(t, userRepo.DeleteUser(ctx, testUserID))
+
+		_, err = userRepo.GetUserByTokenHash(ctx, "second-hash")
+		assert.ErrorAs(t, err, &domain.ErrInvalidToken{})
+	})
+
 	t.Run("DeleteNonExistentUser", func(t *testing.T) {
 		// Пытаемся удалить несуществующего пользователя
 		nonExistentUserID := int64(99999)
@@ -14,6 +14,8 @@ func NewPool(ctx context.Context, connString string) (*pgxpool.Pool, error) {
 		return nil, err
 	}
 
+	config.ConnConfig.Tracer = queryTracer{}
+
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		slog.Error("failed create pool", "error", err.Error())
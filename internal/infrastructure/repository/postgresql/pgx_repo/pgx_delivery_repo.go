@@ -0,0 +1,221 @@
+package pgxrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DeliveryRepoPgx struct {
+	pool *pgxpool.Pool
+}
+
+func NewDeliveryRepoPgx(pool *pgxpool.Pool) *DeliveryRepoPgx {
+	return &DeliveryRepoPgx{pool: pool}
+}
+
+// GetSettings возвращает настройки доставки пользователя. Если пользователь их не менял,
+// возвращаются настройки по умолчанию.
+func (r *DeliveryRepoPgx) GetSettings(ctx context.Context, tgID int64) (domain.DeliverySettings, error) {
+	settings := domain.DefaultDeliverySettings()
+
+	sqlSelect := "SELECT mode, digest_time, timezone, quiet_start, quiet_end FROM delivery_settings WHERE tg_id = $1"
+
+	var mode string
+
+	err := r.pool.QueryRow(ctx, sqlSelect, tgID).Scan(&mode, &settings.DigestTime, &settings.Timezone,
+		&settings.Quiet.Start, &settings.Quiet.End)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.DeliverySettings{}, err
+	}
+
+	if err == nil {
+		settings.Mode = domain.DeliveryMode(mode)
+	}
+
+	rows, err := r.pool.Query(ctx, "SELECT tag, mode FROM tag_delivery_modes WHERE tg_id = $1", tgID)
+	if err != nil {
+		return domain.DeliverySettings{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag, tagMode string
+		if err := rows.Scan(&tag, &tagMode); err != nil {
+			return domain.DeliverySettings{}, err
+		}
+
+		settings.TagModes[tag] = domain.DeliveryMode(tagMode)
+	}
+
+	return settings, rows.Err()
+}
+
+func (r *DeliveryRepoPgx) SaveSettings(ctx context.Context, tgID int64, settings *domain.DeliverySettings) error {
+	sql := `INSERT INTO delivery_settings (tg_id, mode, digest_time, timezone, quiet_start, quiet_end)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT(tg_id) DO UPDATE SET mode = $2, digest_time = $3, timezone = $4, quiet_start = $5, quiet_end = $6`
+
+	_, err := r.pool.Exec(ctx, sql, tgID, string(settings.Mode), settings.DigestTime, settings.Timezone,
+		settings.Quiet.Start, settings.Quiet.End)
+
+	return err
+}
+
+func (r *DeliveryRepoPgx) SetTagMode(ctx context.Context, tgID int64, tag string, mode domain.DeliveryMode) error {
+	sql := `INSERT INTO tag_delivery_modes (tg_id, tag, mode) VALUES ($1, $2, $3)
+		ON CONFLICT(tg_id, tag) DO UPDATE SET mode = $3`
+
+	_, err := r.pool.Exec(ctx, sql, tgID, tag, string(mode))
+
+	return err
+}
+
+func (r *DeliveryRepoPgx) DeleteTagMode(ctx context.Context, tgID int64, tag string) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM tag_delivery_modes WHERE tg_id = $1 AND tag = $2", tgID, tag)
+
+	return err
+}
+
+// GetDeliveryTargets возвращает, как доставлять обновления ссылки каждому отслеживающему её пользователю.
+// Режимы тегов ссылки важнее общего режима пользователя; из нескольких тегов выбирается самый срочный режим.
+func (r *DeliveryRepoPgx) GetDeliveryTargets(ctx context.Context, linkID int64) (map[int64]domain.DeliveryTarget, error) {
+	sql := `SELECT t.tg_id,
+			(SELECT tm.mode FROM tag_delivery_modes tm
+				JOIN track_tags tt ON tt.tg_id = tm.tg_id AND tt.tag = tm.tag
+				WHERE tm.tg_id = t.tg_id AND tt.url_id = t.url_id
+				ORDER BY CASE tm.mode WHEN 'immediate' THEN 0 WHEN 'hourly' THEN 1 ELSE 2 END
+				LIMIT 1),
+			COALESCE(s.mode, 'immediate'), COALESCE(s.timezone, 'UTC'),
+			COALESCE(s.quiet_start, 0), COALESCE(s.quiet_end, 0)
+		FROM tracks t LEFT JOIN delivery_settings s ON s.tg_id = t.tg_id
+		WHERE t.url_id = $1`
+
+	rows, err := r.pool.Query(ctx, sql, linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDeliveryTargets(rows)
+}
+
+func scanDeliveryTargets(rows pgx.Rows) (map[int64]domain.DeliveryTarget, error) {
+	targets := make(map[int64]domain.DeliveryTarget)
+
+	for rows.Next() {
+		var (
+			tgID     int64
+			tagMode  pgtype.Text
+			userMode string
+			target   domain.DeliveryTarget
+		)
+
+		err := rows.Scan(&tgID, &tagMode, &userMode, &target.Timezone, &target.Quiet.Start, &target.Quiet.End)
+		if err != nil {
+			return nil, err
+		}
+
+		target.Mode = domain.DeliveryMode(userMode)
+		if tagMode.Valid {
+			target.Mode = domain.DeliveryMode(tagMode.String)
+			target.Urgent = target.Mode == domain.DeliveryImmediate
+		}
+
+		targets[tgID] = target
+	}
+
+	return targets, rows.Err()
+}
+
+func (r *DeliveryRepoPgx) AddPendingUpdate(ctx context.Context, tgID int64, mode domain.DeliveryMode,
+	update *domain.LinkUpdate) error {
+	sql := `INSERT INTO pending_updates
+		(tg_id, url_id, url, mode, description, kind, title, item_url, author, author_url, event_at, preview, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	details := &update.Details
+
+	var eventAt pgtype.Timestamp
+	if !details.CreatedAt.IsZero() {
+		eventAt = pgtype.Timestamp{Time: details.CreatedAt.UTC(), Valid: true}
+	}
+
+	_, err := r.pool.Exec(ctx, sql, tgID, update.Link.ID, update.Link.URL, string(mode), update.Description,
+		details.Kind, details.Title, details.URL, details.Author, details.AuthorURL, eventAt, details.Preview,
+		time.Now().UTC())
+
+	return err
+}
+
+func (r *DeliveryRepoPgx) GetPendingUsers(ctx context.Context) ([]int64, error) {
+	rows, err := r.pool.Query(ctx, "SELECT DISTINCT tg_id FROM pending_updates")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tgIDs []int64
+
+	for rows.Next() {
+		var tgID int64
+		if err := rows.Scan(&tgID); err != nil {
+			return nil, err
+		}
+
+		tgIDs = append(tgIDs, tgID)
+	}
+
+	return tgIDs, rows.Err()
+}
+
+// GetPendingUpdates возвращает отложенные в режиме mode обновления, накопленные до createdBefore.
+func (r *DeliveryRepoPgx) GetPendingUpdates(ctx context.Context, tgID int64, mode domain.DeliveryMode,
+	createdBefore time.Time) ([]domain.PendingUpdate, error) {
+	sql := `SELECT id, url_id, url, description, kind, title, item_url, author, author_url, event_at, preview
+		FROM pending_updates WHERE tg_id = $1 AND mode = $2 AND created_at < $3 ORDER BY id`
+
+	rows, err := r.pool.Query(ctx, sql, tgID, string(mode), createdBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var updates []domain.PendingUpdate
+
+	for rows.Next() {
+		var (
+			pending domain.PendingUpdate
+			eventAt pgtype.Timestamp
+		)
+
+		update := &pending.Update
+		details := &update.Details
+
+		err := rows.Scan(&pending.ID, &update.Link.ID, &update.Link.URL, &update.Description,
+			&details.Kind, &details.Title, &details.URL, &details.Author, &details.AuthorURL, &eventAt, &details.Preview)
+		if err != nil {
+			return nil, err
+		}
+
+		if eventAt.Valid {
+			details.CreatedAt = eventAt.Time
+		}
+
+		updates = append(updates, pending)
+	}
+
+	return updates, rows.Err()
+}
+
+func (r *DeliveryRepoPgx) DeletePendingUpdates(ctx context.Context, ids []int64) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM pending_updates WHERE id = ANY($1)", ids)
+
+	return err
+}
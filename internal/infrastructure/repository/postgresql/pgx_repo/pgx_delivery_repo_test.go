@@ -0,0 +1,120 @@
+package pgxrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"LinkTracker/internal/infrastructure/repository/postgresql"
+	pgxrepo "LinkTracker/internal/infrastructure/repository/postgresql/pgx_repo"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeliveryRepo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	pool, cleanup, err := postgresql.RunPostgresAndMigrateTestContainers(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	userRepo := pgxrepo.NewUserRepo(pool)
+	linkRepo := pgxrepo.NewLinkRepo(pool)
+	deliveryRepo := pgxrepo.NewDeliveryRepoPgx(pool)
+
+	const tgID int64 = 77777
+
+	require.NoError(t, userRepo.CreateUser(ctx, tgID))
+
+	link, err := linkRepo.AddLink(ctx, tgID, &domain.Link{
+		URL:     "https://github.com/owner/repo",
+		Tags:    []string{"work", "news"},
+		Filters: []string{},
+	})
+	require.NoError(t, err)
+
+	t.Run("GetSettings returns defaults", func(t *testing.T) {
+		settings, err := deliveryRepo.GetSettings(ctx, tgID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultDeliverySettings(), settings)
+	})
+
+	t.Run("SaveSettings and SetTagMode", func(t *testing.T) {
+		settings := domain.DeliverySettings{
+			Mode:       domain.DeliveryDaily,
+			DigestTime: 20 * 60,
+			Timezone:   "Europe/Moscow",
+			Quiet:      domain.QuietHours{Start: 23 * 60, End: 8 * 60},
+		}
+		require.NoError(t, deliveryRepo.SaveSettings(ctx, tgID, &settings))
+		require.NoError(t, deliveryRepo.SetTagMode(ctx, tgID, "news", domain.DeliveryHourly))
+
+		saved, err := deliveryRepo.GetSettings(ctx, tgID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeliveryDaily, saved.Mode)
+		assert.Equal(t, 20*60, saved.DigestTime)
+		assert.Equal(t, "Europe/Moscow", saved.Timezone)
+		assert.Equal(t, settings.Quiet, saved.Quiet)
+		assert.Equal(t, map[string]domain.DeliveryMode{"news": domain.DeliveryHourly}, saved.TagModes)
+	})
+
+	t.Run("GetDeliveryTargets prefers the most urgent tag mode", func(t *testing.T) {
+		targets, err := deliveryRepo.GetDeliveryTargets(ctx, link.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeliveryHourly, targets[tgID].Mode, "Режим тега важнее общего режима")
+		assert.False(t, targets[tgID].Urgent)
+
+		require.NoError(t, deliveryRepo.SetTagMode(ctx, tgID, "work", domain.DeliveryImmediate))
+
+		targets, err = deliveryRepo.GetDeliveryTargets(ctx, link.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeliveryImmediate, targets[tgID].Mode)
+		assert.True(t, targets[tgID].Urgent, "Режим immediate, заданный тегом, считается срочным")
+
+		require.NoError(t, deliveryRepo.DeleteTagMode(ctx, tgID, "work"))
+		require.NoError(t, deliveryRepo.DeleteTagMode(ctx, tgID, "news"))
+
+		targets, err = deliveryRepo.GetDeliveryTargets(ctx, link.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeliveryDaily, targets[tgID].Mode, "Без режимов тегов используется общий режим")
+		assert.Equal(t, domain.QuietHours{Start: 23 * 60, End: 8 * 60}, targets[tgID].Quiet)
+		assert.Equal(t, "Europe/Moscow", targets[tgID].Timezone)
+	})
+
+	t.Run("Pending updates", func(t *testing.T) {
+		update := domain.LinkUpdate{
+			Link:        link,
+			Description: "description",
+			Details: domain.UpdateDetails{
+				Kind:      "Issue",
+				Title:     "Title",
+				CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		}
+		require.NoError(t, deliveryRepo.AddPendingUpdate(ctx, tgID, domain.DeliveryDaily, &update))
+
+		tgIDs, err := deliveryRepo.GetPendingUsers(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{tgID}, tgIDs)
+
+		pending, err := deliveryRepo.GetPendingUpdates(ctx, tgID, domain.DeliveryHourly, time.Now().UTC().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, pending, "Обновления другого режима не возвращаются")
+
+		pending, err = deliveryRepo.GetPendingUpdates(ctx, tgID, domain.DeliveryDaily, time.Now().UTC().Add(time.Minute))
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, link.URL, pending[0].Update.Link.URL)
+		assert.Equal(t, update.Details, pending[0].Update.Details)
+
+		require.NoError(t, deliveryRepo.DeletePendingUpdates(ctx, []int64{pending[0].ID}))
+
+		tgIDs, err = deliveryRepo.GetPendingUsers(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, tgIDs)
+	})
+}
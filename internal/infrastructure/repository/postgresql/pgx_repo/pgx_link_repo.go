@@ -3,11 +3,13 @@ package pgxrepo
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"LinkTracker/internal/domain"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -19,10 +21,30 @@ func NewLinkRepo(pool *pgxpool.Pool) *LinkRepoPgx {
 	return &LinkRepoPgx{pool: pool}
 }
 
+// searchDecayDays — число дней без активности, за которое результат поиска теряет половину релевантности.
+const searchDecayDays = 30
+
+// activeTrackCondition отбирает треки, уведомления по которым сейчас не приостановлены.
+const activeTrackCondition = "t.active AND (t.muted_until IS NULL OR t.muted_until <= $2)"
+
+// trackFiltersColumn и trackTagsColumn собирают фильтры и теги трека t в массивы в порядке добавления.
+const (
+	trackFiltersColumn = `COALESCE((SELECT array_agg(tf.filter ORDER BY tf.position) FROM track_filters tf
+		WHERE tf.tg_id = t.tg_id AND tf.url_id = t.url_id), '{}')`
+	trackTagsColumn = `COALESCE((SELECT array_agg(tt.tag ORDER BY tt.position) FROM track_tags tt
+		WHERE tt.tg_id = t.tg_id AND tt.url_id = t.url_id), '{}')`
+)
+
+// linkMetadataColumns перечисляет метаданные источника в порядке полей, которые заполняет metadataScanTargets.
+const linkMetadataColumns = "u.title, u.description, u.stars, u.answers, u.status"
+
+// linkHealthColumns перечисляет результат последних проверок в порядке полей, которые заполняет healthScanTargets.
+const linkHealthColumns = "u.last_checked_at, u.last_error, u.consecutive_failures"
+
 func (r *LinkRepoPgx) GetUsersByLink(ctx context.Context, linkID int64) ([]int64, error) {
-	sql := "SELECT tg_id FROM tracks  WHERE url_id = $1"
+	sql := "SELECT t.tg_id FROM tracks t WHERE t.url_id = $1 AND " + activeTrackCondition
 
-	rows, err := r.pool.Query(ctx, sql, linkID)
+	rows, err := r.pool.Query(ctx, sql, linkID, time.Now().UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -47,13 +69,32 @@ func (r *LinkRepoPgx) GetUsersByLink(ctx context.Context, linkID int64) ([]int64
 
 func (r *LinkRepoPgx) GetUserLinks(ctx context.Context, id int64) ([]domain.Link, error) {
 	sql := `
-        SELECT t.url_id, u.url, t.filters, t.tags
+        SELECT t.url_id, u.url, ` + trackFiltersColumn + `, ` + trackTagsColumn + `, t.active, t.muted_until, u.last_update,
+               ` + linkMetadataColumns + `, ` + linkHealthColumns + `
         FROM tracks t
         JOIN urls u ON t.url_id = u.id
         WHERE t.tg_id = $1
     `
 
-	rows, err := r.pool.Query(ctx, sql, id)
+	return r.queryUserLinks(ctx, sql, id)
+}
+
+// GetUserLinksByTag возвращает ссылки пользователя, у которых есть заданный тег.
+func (r *LinkRepoPgx) GetUserLinksByTag(ctx context.Context, id int64, tag string) ([]domain.Link, error) {
+	sql := `
+        SELECT t.url_id, u.url, ` + trackFiltersColumn + `, ` + trackTagsColumn + `, t.active, t.muted_until, u.last_update,
+               ` + linkMetadataColumns + `, ` + linkHealthColumns + `
+        FROM tracks t
+        JOIN urls u ON t.url_id = u.id
+        WHERE t.tg_id = $1
+          AND EXISTS (SELECT 1 FROM track_tags tt WHERE tt.tg_id = t.tg_id AND tt.url_id = t.url_id AND tt.tag = $2)
+    `
+
+	return r.queryUserLinks(ctx, sql, id, tag)
+}
+
+func (r *LinkRepoPgx) queryUserLinks(ctx context.Context, sql string, args ...any) ([]domain.Link, error) {
+	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,20 +102,182 @@ func (r *LinkRepoPgx) GetUserLinks(ctx context.Context, id int64) ([]domain.Link
 
 	var links []domain.Link
 
+	now := time.Now().UTC()
+
 	for rows.Next() {
-		var link domain.Link
+		var (
+			link          domain.Link
+			active        bool
+			mutedUntil    *time.Time
+			lastCheckedAt *time.Time
+		)
 
-		err = rows.Scan(&link.ID, &link.URL, &link.Filters, &link.Tags)
+		targets := append([]any{&link.ID, &link.URL, &link.Filters, &link.Tags, &active, &mutedUntil, &link.LastUpdated},
+			metadataScanTargets(&link.Metadata)...)
+
+		err = rows.Scan(append(targets, healthScanTargets(&link.Health, &lastCheckedAt)...)...)
 		if err != nil {
 			return nil, err
 		}
 
+		setLinkPause(&link, active, mutedUntil, now)
+		setLinkCheckedAt(&link, lastCheckedAt)
 		links = append(links, link)
 	}
 
 	return links, rows.Err()
 }
 
+// GetUserTags возвращает теги пользователя с количеством ссылок под каждым, отсортированные по имени.
+func (r *LinkRepoPgx) GetUserTags(ctx context.Context, id int64) ([]domain.TagCount, error) {
+	sql := `
+        SELECT tag, COUNT(*)
+        FROM track_tags
+        WHERE tg_id = $1
+        GROUP BY tag
+        ORDER BY tag
+    `
+
+	rows, err := r.pool.Query(ctx, sql, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []domain.TagCount
+
+	for rows.Next() {
+		var tag domain.TagCount
+
+		err = rows.Scan(&tag.Tag, &tag.Links)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// SearchUserLinks ищет ссылки пользователя, у которых слова запроса встречаются в адресе, тегах или
+// сохранённых обновлениях. Каждое слово ищется как префикс. Релевантность делится на давность последней
+// активности, так что за searchDecayDays без событий результат теряет половину веса.
+func (r *LinkRepoPgx) SearchUserLinks(ctx context.Context, id int64, terms []string, limit int64) ([]domain.SearchResult, error) {
+	sql := `
+        WITH q AS (SELECT to_tsquery('simple', $2) AS query)
+        SELECT t.url_id, u.url, ` + trackFiltersColumn + `, ` + trackTagsColumn + `, t.active, t.muted_until,
+               ((ts_rank(u.search_vector, q.query) + COALESCE(tg.tag_rank, 0) + COALESCE(upd.update_rank, 0))
+                   / (1 + GREATEST(EXTRACT(EPOCH FROM $3 - COALESCE(upd.detected_at, u.last_update)), 0)
+                       / 86400 / $4))::float8 AS score,
+               upd.id, COALESCE(upd.description, ''), COALESCE(upd.kind, ''), COALESCE(upd.title, ''),
+               COALESCE(upd.item_url, ''), COALESCE(upd.author, ''), COALESCE(upd.author_url, ''),
+               upd.event_at, COALESCE(upd.preview, ''), upd.detected_at
+        FROM tracks t
+        JOIN urls u ON u.id = t.url_id
+        CROSS JOIN q
+        LEFT JOIN LATERAL (
+            SELECT MAX(ts_rank(to_tsvector('simple', tt.tag), q.query)) AS tag_rank
+            FROM track_tags tt
+            WHERE tt.tg_id = t.tg_id AND tt.url_id = t.url_id AND to_tsvector('simple', tt.tag) @@ q.query
+        ) tg ON TRUE
+        LEFT JOIN LATERAL (
+            SELECT ud.id, ud.description, ud.kind, ud.title, ud.item_url, ud.author, ud.author_url,
+                   ud.event_at, ud.preview, ud.detected_at, ts_rank(ud.search_vector, q.query) AS update_rank
+            FROM updates ud
+            WHERE ud.url_id = t.url_id AND ud.search_vector @@ q.query
+            ORDER BY update_rank DESC, ud.id DESC
+            LIMIT 1
+        ) upd ON TRUE
+        WHERE t.tg_id = $1
+          AND (u.search_vector @@ q.query OR tg.tag_rank IS NOT NULL OR upd.id IS NOT NULL)
+        ORDER BY score DESC, t.url_id DESC
+        LIMIT $5
+    `
+
+	now := time.Now().UTC()
+
+	rows, err := r.pool.Query(ctx, sql, id, prefixTSQuery(terms), now, searchDecayDays, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.SearchResult
+
+	for rows.Next() {
+		var (
+			result     domain.SearchResult
+			record     domain.UpdateRecord
+			active     bool
+			mutedUntil *time.Time
+			updateID   *int64
+			eventAt    pgtype.Timestamp
+			detectedAt pgtype.Timestamp
+		)
+
+		details := &record.Details
+
+		err = rows.Scan(&result.Link.ID, &result.Link.URL, &result.Link.Filters, &result.Link.Tags, &active, &mutedUntil,
+			&result.Rank, &updateID, &record.Description, &details.Kind, &details.Title, &details.URL, &details.Author,
+			&details.AuthorURL, &eventAt, &details.Preview, &detectedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		setLinkPause(&result.Link, active, mutedUntil, now)
+
+		if updateID != nil {
+			record.ID, record.LinkID = *updateID, result.Link.ID
+			details.CreatedAt, record.DetectedAt = eventAt.Time, detectedAt.Time
+			result.Update = &record
+		}
+
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+// RenameTag переименовывает тег во всех ссылках пользователя и возвращает число изменённых ссылок.
+// Если у ссылки уже есть новый тег, старый просто удаляется, чтобы не появился дубликат.
+func (r *LinkRepoPgx) RenameTag(ctx context.Context, id int64, oldTag, newTag string) (renamed int64, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback(ctx))
+		}
+	}()
+
+	sqlDeleteDuplicates := `
+        DELETE FROM track_tags o
+        WHERE o.tg_id = $1 AND o.tag = $2 AND $2 <> $3
+          AND EXISTS (SELECT 1 FROM track_tags n WHERE n.tg_id = o.tg_id AND n.url_id = o.url_id AND n.tag = $3)
+    `
+
+	deleted, err := tx.Exec(ctx, sqlDeleteDuplicates, id, oldTag, newTag)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlRename := "UPDATE track_tags SET tag = $3 WHERE tg_id = $1 AND tag = $2"
+
+	updated, err := tx.Exec(ctx, sqlRename, id, oldTag, newTag)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return deleted.RowsAffected() + updated.RowsAffected(), nil
+}
+
 func (r *LinkRepoPgx) AddLink(ctx context.Context, id int64, link *domain.Link) (domain.Link, error) {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
@@ -89,16 +292,26 @@ func (r *LinkRepoPgx) AddLink(ctx context.Context, id int64, link *domain.Link)
 
 	var urlID int64
 
-	sqlInsertLink := "INSERT INTO urls(url, last_update) VALUES($1, $2) RETURNING id"
+	// Ссылку, которую уже отслеживает другой пользователь, повторно не создаём
+	sqlInsertLink := `
+        INSERT INTO urls(url, last_update) VALUES($1, $2)
+        ON CONFLICT (url) DO UPDATE SET url = EXCLUDED.url
+        RETURNING id
+    `
 
 	err = tx.QueryRow(ctx, sqlInsertLink, link.URL, time.Now().UTC()).Scan(&urlID)
 	if err != nil {
 		return domain.Link{}, err
 	}
 
-	sqlInsertTrack := "INSERT INTO tracks(tg_id, url_id, filters, tags) VALUES($1, $2, $3, $4)"
+	sqlInsertTrack := "INSERT INTO tracks(tg_id, url_id) VALUES($1, $2)"
+
+	_, err = tx.Exec(ctx, sqlInsertTrack, id, urlID)
+	if err != nil {
+		return domain.Link{}, err
+	}
 
-	_, err = tx.Exec(ctx, sqlInsertTrack, id, urlID, link.Filters, link.Tags)
+	err = insertTrackTagsAndFilters(ctx, tx, id, urlID, link)
 	if err != nil {
 		return domain.Link{}, err
 	}
@@ -128,7 +341,7 @@ func (r *LinkRepoPgx) DeleteLink(ctx context.Context, id int64, link *domain.Lin
 	)
 
 	sqlSelectUrlsAndTracks := `
-		SELECT u.id, u.last_update, t.filters, t.tags 
+		SELECT u.id, u.last_update, ` + trackFiltersColumn + `, ` + trackTagsColumn + `
 		FROM urls u JOIN tracks t ON u.id = t.url_id 
 		WHERE u.url = $1 AND t.tg_id = $2
 	`
@@ -204,16 +417,46 @@ func (r *LinkRepoPgx) GetAllLinks(ctx context.Context) ([]domain.Link, error) {
 	return links, rows.Err()
 }
 
+// GetLinkByURL возвращает ссылку по каноническому адресу вместе с метаданными и результатом проверок.
+func (r *LinkRepoPgx) GetLinkByURL(ctx context.Context, url string) (domain.Link, error) {
+	sql := `
+		SELECT u.id, u.url, u.last_update, ` + linkMetadataColumns + `, ` + linkHealthColumns + `
+		FROM urls u
+		WHERE u.url = $1
+	`
+
+	var (
+		link          domain.Link
+		lastCheckedAt *time.Time
+	)
+
+	targets := append([]any{&link.ID, &link.URL, &link.LastUpdated}, metadataScanTargets(&link.Metadata)...)
+
+	err := r.pool.QueryRow(ctx, sql, url).Scan(append(targets, healthScanTargets(&link.Health, &lastCheckedAt)...)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Link{}, domain.ErrLinkNotExist{}
+	}
+
+	if err != nil {
+		return domain.Link{}, err
+	}
+
+	setLinkCheckedAt(&link, lastCheckedAt)
+
+	return link, nil
+}
+
 func (r *LinkRepoPgx) GetLinksAfter(ctx context.Context, lastUpdate time.Time, limit int64) ([]domain.Link, error) {
 	sql := `
-		SELECT id, url, last_update
-		FROM urls
-		WHERE last_update > $1
-		ORDER BY last_update
-		LIMIT $2
+		SELECT u.id, u.url, u.last_update, ` + linkMetadataColumns + `
+		FROM urls u
+		WHERE u.last_update > $1 AND u.status <> $4
+		  AND EXISTS (SELECT 1 FROM tracks t WHERE t.url_id = u.id AND ` + activeTrackCondition + `)
+		ORDER BY u.last_update
+		LIMIT $3
 	`
 
-	rows, err := r.pool.Query(ctx, sql, lastUpdate, limit)
+	rows, err := r.pool.Query(ctx, sql, lastUpdate, time.Now().UTC(), limit, string(domain.SourceDeleted))
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +467,7 @@ func (r *LinkRepoPgx) GetLinksAfter(ctx context.Context, lastUpdate time.Time, l
 
 	for rows.Next() {
 		var link domain.Link
-		if err := rows.Scan(&link.ID, &link.URL, &link.LastUpdated); err != nil {
+		if err := rows.Scan(append([]any{&link.ID, &link.URL, &link.LastUpdated}, metadataScanTargets(&link.Metadata)...)...); err != nil {
 			return nil, err
 		}
 
@@ -255,9 +498,21 @@ func (r *LinkRepoPgx) UpdateLink(ctx context.Context, tgID int64, link *domain.L
 		return err
 	}
 
-	sql := "UPDATE tracks SET tags = $1, filters = $2 WHERE tg_id = $3 AND url_id = $4"
+	sqlDeleteTags := "DELETE FROM track_tags WHERE tg_id = $1 AND url_id = $2"
+
+	_, err = tx.Exec(ctx, sqlDeleteTags, tgID, urlID)
+	if err != nil {
+		return err
+	}
+
+	sqlDeleteFilters := "DELETE FROM track_filters WHERE tg_id = $1 AND url_id = $2"
+
+	_, err = tx.Exec(ctx, sqlDeleteFilters, tgID, urlID)
+	if err != nil {
+		return err
+	}
 
-	_, err = tx.Exec(ctx, sql, link.Tags, link.Filters, tgID, urlID)
+	err = insertTrackTagsAndFilters(ctx, tx, tgID, urlID, link)
 	if err != nil {
 		return err
 	}
@@ -265,9 +520,293 @@ func (r *LinkRepoPgx) UpdateLink(ctx context.Context, tgID int64, link *domain.L
 	return tx.Commit(ctx)
 }
 
+// insertTrackTagsAndFilters сохраняет теги и фильтры трека, запоминая их порядок. Повторяющиеся теги
+// сохраняются один раз.
+func insertTrackTagsAndFilters(ctx context.Context, tx pgx.Tx, tgID, urlID int64, link *domain.Link) error {
+	sqlInsertTags := `
+        INSERT INTO track_tags(tg_id, url_id, tag, position)
+        SELECT $1, $2, tag, position FROM unnest($3::text[]) WITH ORDINALITY AS tags(tag, position)
+        ON CONFLICT DO NOTHING
+    `
+
+	_, err := tx.Exec(ctx, sqlInsertTags, tgID, urlID, link.Tags)
+	if err != nil {
+		return err
+	}
+
+	sqlInsertFilters := `
+        INSERT INTO track_filters(tg_id, url_id, filter, position)
+        SELECT $1, $2, filter, position FROM unnest($3::text[]) WITH ORDINALITY AS filters(filter, position)
+    `
+
+	_, err = tx.Exec(ctx, sqlInsertFilters, tgID, urlID, link.Filters)
+
+	return err
+}
+
+// PauseLinks приостанавливает уведомления по ссылкам пользователя до until. Нулевое until
+// ставит бессрочную паузу.
+func (r *LinkRepoPgx) PauseLinks(ctx context.Context, tgID int64, linkIDs []int64, until time.Time) error {
+	sql := "UPDATE tracks SET active = $1, muted_until = $2 WHERE tg_id = $3 AND url_id = ANY($4)"
+
+	var mutedUntil *time.Time
+	if !until.IsZero() {
+		mutedUntil = &until
+	}
+
+	_, err := r.pool.Exec(ctx, sql, mutedUntil != nil, mutedUntil, tgID, linkIDs)
+
+	return err
+}
+
+// ResumeLinks снимает паузу со ссылок пользователя.
+func (r *LinkRepoPgx) ResumeLinks(ctx context.Context, tgID int64, linkIDs []int64) error {
+	sql := "UPDATE tracks SET active = TRUE, muted_until = NULL WHERE tg_id = $1 AND url_id = ANY($2)"
+	_, err := r.pool.Exec(ctx, sql, tgID, linkIDs)
+
+	return err
+}
+
 func (r *LinkRepoPgx) UpdateTimeLink(ctx context.Context, lastUpdate time.Time, id int64) error {
 	sql := "UPDATE urls SET last_update = $1 WHERE id = $2"
 	_, err := r.pool.Exec(ctx, sql, lastUpdate, id)
 
 	return err
 }
+
+// UpdateLinkMetadata сохраняет метаданные источника ссылки.
+func (r *LinkRepoPgx) UpdateLinkMetadata(ctx context.Context, id int64, metadata *domain.LinkMetadata) error {
+	sql := "UPDATE urls SET title = $1, description = $2, stars = $3, answers = $4, status = $5 WHERE id = $6"
+	_, err := r.pool.Exec(ctx, sql, metadata.Title, metadata.Description, metadata.Stars, metadata.Answers,
+		string(metadata.Status), id)
+
+	return err
+}
+
+// RecordLinkCheck сохраняет результат проверки ссылки и возвращает число ошибок подряд. Пустой checkErr
+// означает успешную проверку и сбрасывает счётчик.
+func (r *LinkRepoPgx) RecordLinkCheck(ctx context.Context, id int64, checkedAt time.Time, checkErr string) (int64, error) {
+	sql := `
+		UPDATE urls
+		SET last_checked_at      = $1,
+		    last_error           = $2,
+		    consecutive_failures = CASE WHEN $2 = '' THEN 0 ELSE consecutive_failures + 1 END
+		WHERE id = $3
+		RETURNING consecutive_failures
+	`
+
+	var failures int64
+
+	err := r.pool.QueryRow(ctx, sql, checkedAt, checkErr, id).Scan(&failures)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrLinkNotExist{}
+	}
+
+	return failures, err
+}
+
+// GetUnhealthyLinks возвращает ссылки, проверка которых завершилась ошибкой не меньше minFailures раз подряд,
+// начиная с самых долго не работающих.
+func (r *LinkRepoPgx) GetUnhealthyLinks(ctx context.Context, minFailures int64) ([]domain.Link, error) {
+	sql := `
+		SELECT u.id, u.url, u.last_update, ` + linkMetadataColumns + `, ` + linkHealthColumns + `
+		FROM urls u
+		WHERE u.consecutive_failures >= $1
+		ORDER BY u.consecutive_failures DESC, u.id
+	`
+
+	rows, err := r.pool.Query(ctx, sql, max(minFailures, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var links []domain.Link
+
+	for rows.Next() {
+		var (
+			link          domain.Link
+			lastCheckedAt *time.Time
+		)
+
+		targets := append([]any{&link.ID, &link.URL, &link.LastUpdated}, metadataScanTargets(&link.Metadata)...)
+		if err := rows.Scan(append(targets, healthScanTargets(&link.Health, &lastCheckedAt)...)...); err != nil {
+			return nil, err
+		}
+
+		setLinkCheckedAt(&link, lastCheckedAt)
+		links = append(links, link)
+	}
+
+	return links, rows.Err()
+}
+
+// GetTrackingStats возвращает число пользователей, отслеживающих хотя бы одну ссылку, и число отслеживаемых ссылок.
+func (r *LinkRepoPgx) GetTrackingStats(ctx context.Context) (domain.TrackingStats, error) {
+	sql := "SELECT COUNT(DISTINCT tg_id), COUNT(DISTINCT url_id) FROM tracks"
+
+	var stats domain.TrackingStats
+
+	err := r.pool.QueryRow(ctx, sql).Scan(&stats.Users, &stats.Links)
+
+	return stats, err
+}
+
+// MoveLink меняет адрес ссылки linkID на newURL и возвращает идентификатор, под которым ссылка хранится
+// дальше. Если newURL уже отслеживается, ссылки объединяются: подписчики, теги, фильтры, история и
+// отложенные обновления старой ссылки переходят к существующей, а старая запись удаляется. Трек
+// пользователя, который уже подписан на newURL, остаётся без изменений.
+func (r *LinkRepoPgx) MoveLink(ctx context.Context, id int64, newURL string) (targetID int64, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback(ctx))
+		}
+	}()
+
+	sqlMovePending := "UPDATE pending_updates SET url_id = $1, url = $2 WHERE url_id = $3"
+
+	err = tx.QueryRow(ctx, "SELECT id FROM urls WHERE url = $1", newURL).Scan(&targetID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		_, err = tx.Exec(ctx, "UPDATE urls SET url = $1 WHERE id = $2", newURL, id)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = tx.Exec(ctx, sqlMovePending, id, newURL, id)
+		if err != nil {
+			return 0, err
+		}
+
+		return id, tx.Commit(ctx)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err = moveTracks(ctx, tx, id, targetID); err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE updates SET url_id = $1 WHERE url_id = $2", targetID, id)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, sqlMovePending, targetID, newURL, id)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM tracks WHERE url_id = $1", id)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM urls WHERE id = $1", id)
+	if err != nil {
+		return 0, err
+	}
+
+	return targetID, tx.Commit(ctx)
+}
+
+// moveTracks копирует треки ссылки id вместе с тегами и фильтрами на ссылку targetID для пользователей,
+// ещё не подписанных на targetID. Сами треки ссылки id не удаляются.
+func moveTracks(ctx context.Context, tx pgx.Tx, id, targetID int64) error {
+	sqlMoveTracks := `
+        INSERT INTO tracks(tg_id, url_id, active, muted_until)
+        SELECT tg_id, $1, active, muted_until FROM tracks WHERE url_id = $2
+        ON CONFLICT DO NOTHING
+        RETURNING tg_id
+    `
+
+	rows, err := tx.Query(ctx, sqlMoveTracks, targetID, id)
+	if err != nil {
+		return err
+	}
+
+	var movedUsers []int64
+
+	for rows.Next() {
+		var tgID int64
+
+		if err = rows.Scan(&tgID); err != nil {
+			rows.Close()
+			return err
+		}
+
+		movedUsers = append(movedUsers, tgID)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	sqlMoveTags := `
+        INSERT INTO track_tags(tg_id, url_id, tag, position)
+        SELECT tg_id, $1, tag, position FROM track_tags WHERE url_id = $2 AND tg_id = ANY($3)
+    `
+
+	_, err = tx.Exec(ctx, sqlMoveTags, targetID, id, movedUsers)
+	if err != nil {
+		return err
+	}
+
+	sqlMoveFilters := `
+        INSERT INTO track_filters(tg_id, url_id, position, filter)
+        SELECT tg_id, $1, position, filter FROM track_filters WHERE url_id = $2 AND tg_id = ANY($3)
+    `
+
+	_, err = tx.Exec(ctx, sqlMoveFilters, targetID, id, movedUsers)
+
+	return err
+}
+
+// metadataScanTargets возвращает поля метаданных для Scan в порядке linkMetadataColumns.
+func metadataScanTargets(metadata *domain.LinkMetadata) []any {
+	return []any{&metadata.Title, &metadata.Description, &metadata.Stars, &metadata.Answers, (*string)(&metadata.Status)}
+}
+
+// healthScanTargets возвращает поля результата проверок для Scan в порядке linkHealthColumns. Время
+// последней проверки может быть NULL и читается в lastCheckedAt, см. setLinkCheckedAt.
+func healthScanTargets(health *domain.LinkHealth, lastCheckedAt **time.Time) []any {
+	return []any{lastCheckedAt, &health.LastError, &health.ConsecutiveFailures}
+}
+
+// setLinkCheckedAt переносит время последней проверки в ссылку, если ссылка уже проверялась.
+func setLinkCheckedAt(link *domain.Link, lastCheckedAt *time.Time) {
+	if lastCheckedAt != nil {
+		link.Health.LastCheckedAt = *lastCheckedAt
+	}
+}
+
+// setLinkPause переводит поля active и muted_until трека в состояние паузы ссылки на момент now.
+func setLinkPause(link *domain.Link, active bool, mutedUntil *time.Time, now time.Time) {
+	switch {
+	case !active:
+		link.Paused = true
+	case mutedUntil != nil && mutedUntil.After(now):
+		link.Paused = true
+		link.PausedUntil = *mutedUntil
+	}
+}
+
+// prefixTSQuery собирает tsquery, в котором каждое слово ищется как префикс и все слова обязательны.
+// Слова запроса состоят только из букв и цифр, поэтому экранирование не требуется.
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+
+	return strings.Join(parts, " & ")
+}
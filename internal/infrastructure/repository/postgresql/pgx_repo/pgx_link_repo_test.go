@@ -64,6 +64,8 @@ func Test_LinkRepo(t *testing.T) {
 				// Сохраняем идентификатор для последующих тестов
 				testLink.ID = l.ID
 
+				assert.False(t, l.LastUpdated.IsZero(), "Время последнего обновления не заполнено")
+
 				break
 			}
 		}
@@ -152,6 +154,278 @@ func Test_LinkRepo(t *testing.T) {
 		assert.True(t, found, "Ожидаемая ссылка не найдена в выборке по времени")
 	})
 
+	t.Run("Link Metadata", func(t *testing.T) {
+		metadata := domain.LinkMetadata{Title: "owner/repo", Description: "Test repo", Stars: 42,
+			Status: domain.SourceArchived}
+
+		err := linkRepo.UpdateLinkMetadata(ctx, testLink.ID, &metadata)
+		require.NoError(t, err)
+
+		links, err := linkRepo.GetUserLinks(ctx, tgID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, metadata, links[0].Metadata)
+
+		linksAfter, err := linkRepo.GetLinksAfter(ctx, time.Time{}, 10)
+		require.NoError(t, err)
+		require.Len(t, linksAfter, 1)
+		assert.Equal(t, metadata, linksAfter[0].Metadata)
+	})
+
+	t.Run("Link Health", func(t *testing.T) {
+		checkedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		failures, err := linkRepo.RecordLinkCheck(ctx, testLink.ID, checkedAt, "status not ok")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), failures)
+
+		failures, err = linkRepo.RecordLinkCheck(ctx, testLink.ID, checkedAt, "status not ok")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), failures)
+
+		links, err := linkRepo.GetUserLinks(ctx, tgID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, checkedAt, links[0].Health.LastCheckedAt.UTC())
+		assert.Equal(t, "status not ok", links[0].Health.LastError)
+		assert.Equal(t, int64(2), links[0].Health.ConsecutiveFailures)
+
+		unhealthy, err := linkRepo.GetUnhealthyLinks(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, unhealthy, 1)
+		assert.Equal(t, testLink.ID, unhealthy[0].ID)
+
+		failures, err = linkRepo.RecordLinkCheck(ctx, testLink.ID, checkedAt, "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), failures)
+
+		unhealthy, err = linkRepo.GetUnhealthyLinks(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, unhealthy)
+	})
+
+	t.Run("Tracking Stats", func(t *testing.T) {
+		stats, err := linkRepo.GetTrackingStats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, domain.TrackingStats{Users: 1, Links: 1}, stats)
+	})
+
+	t.Run("Get Link By URL", func(t *testing.T) {
+		link, err := linkRepo.GetLinkByURL(ctx, testLink.URL)
+		require.NoError(t, err)
+		assert.Equal(t, testLink.ID, link.ID)
+		assert.Equal(t, testLink.URL, link.URL)
+
+		_, err = linkRepo.GetLinkByURL(ctx, "http://example.com/unknown")
+		assert.ErrorAs(t, err, &domain.ErrLinkNotExist{})
+	})
+
+	t.Run("Deleted Source Not Checked", func(t *testing.T) {
+		err := linkRepo.UpdateLinkMetadata(ctx, testLink.ID, &domain.LinkMetadata{Status: domain.SourceDeleted})
+		require.NoError(t, err)
+
+		linksAfter, err := linkRepo.GetLinksAfter(ctx, time.Time{}, 10)
+		require.NoError(t, err)
+		assert.Empty(t, linksAfter)
+
+		err = linkRepo.UpdateLinkMetadata(ctx, testLink.ID, &domain.LinkMetadata{Status: domain.SourceActive})
+		require.NoError(t, err)
+	})
+
+	t.Run("Move Link", func(t *testing.T) {
+		movedURL := "http://example.com/moved"
+
+		movedID, err := linkRepo.MoveLink(ctx, testLink.ID, movedURL)
+		require.NoError(t, err)
+		assert.Equal(t, testLink.ID, movedID)
+
+		links, err := linkRepo.GetUserLinks(ctx, tgID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, movedURL, links[0].URL)
+
+		// Перенос на уже отслеживаемый адрес объединяет ссылки
+		duplicate, err := linkRepo.AddLink(ctx, tgID, &domain.Link{URL: testLink.URL, Tags: []string{"duplicate"}})
+		require.NoError(t, err)
+
+		_, err = pool.Exec(ctx, "INSERT INTO pending_updates (tg_id, url_id, url, mode) VALUES ($1, $2, $3, 'digest')",
+			tgID, duplicate.ID, duplicate.URL)
+		require.NoError(t, err)
+
+		movedID, err = linkRepo.MoveLink(ctx, duplicate.ID, movedURL)
+		require.NoError(t, err)
+		assert.Equal(t, testLink.ID, movedID)
+
+		links, err = linkRepo.GetUserLinks(ctx, tgID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, []string{"updated_tag"}, links[0].Tags)
+
+		var (
+			pendingURLID int64
+			pendingURL   string
+		)
+
+		pendingSQL := "SELECT url_id, url FROM pending_updates WHERE tg_id = $1"
+
+		// Отложенные обновления переходят вместе с историей
+		err = pool.QueryRow(ctx, pendingSQL, tgID).Scan(&pendingURLID, &pendingURL)
+		require.NoError(t, err)
+		assert.Equal(t, testLink.ID, pendingURLID)
+		assert.Equal(t, movedURL, pendingURL)
+
+		movedID, err = linkRepo.MoveLink(ctx, testLink.ID, testLink.URL)
+		require.NoError(t, err)
+		assert.Equal(t, testLink.ID, movedID)
+
+		err = pool.QueryRow(ctx, pendingSQL, tgID).Scan(&pendingURLID, &pendingURL)
+		require.NoError(t, err)
+		assert.Equal(t, testLink.URL, pendingURL)
+
+		_, err = pool.Exec(ctx, "DELETE FROM pending_updates WHERE tg_id = $1", tgID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		// После обновления у ссылки единственный тег updated_tag
+		links, err := linkRepo.GetUserLinksByTag(ctx, tgID, "updated_tag")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, testLink.URL, links[0].URL)
+
+		links, err = linkRepo.GetUserLinksByTag(ctx, tgID, "missing")
+		require.NoError(t, err)
+		assert.Empty(t, links, "Ссылки без тега не должны попадать в выборку")
+
+		tags, err := linkRepo.GetUserTags(ctx, tgID)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TagCount{{Tag: "updated_tag", Links: 1}}, tags)
+
+		renamed, err := linkRepo.RenameTag(ctx, tgID, "updated_tag", "renamed_tag")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), renamed)
+
+		renamed, err = linkRepo.RenameTag(ctx, tgID, "updated_tag", "renamed_tag")
+		require.NoError(t, err)
+		assert.Zero(t, renamed, "Переименование отсутствующего тега не должно менять ссылки")
+
+		tags, err = linkRepo.GetUserTags(ctx, tgID)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TagCount{{Tag: "renamed_tag", Links: 1}}, tags)
+	})
+
+	t.Run("Tags And Filters Keep Order", func(t *testing.T) {
+		// Запятые и кавычки больше не ломают хранение, повторяющийся тег сохраняется один раз
+		orderedLink := &domain.Link{
+			URL:     "http://example.com/ordered",
+			Tags:    []string{"b", "a,c", "b"},
+			Filters: []string{"user=\"x\"", "user=\"x\""},
+		}
+
+		_, err := linkRepo.AddLink(ctx, tgID, orderedLink)
+		require.NoError(t, err)
+
+		links, err := linkRepo.GetUserLinksByTag(ctx, tgID, "a,c")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, []string{"b", "a,c"}, links[0].Tags)
+		assert.Equal(t, orderedLink.Filters, links[0].Filters)
+
+		deletedLink, err := linkRepo.DeleteLink(ctx, tgID, orderedLink)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "a,c"}, deletedLink.Tags)
+	})
+
+	t.Run("Pause And Resume Link", func(t *testing.T) {
+		// Бессрочная пауза скрывает пользователя из рассылки и ссылку из проверки
+		err := linkRepo.PauseLinks(ctx, tgID, []int64{testLink.ID}, time.Time{})
+		require.NoError(t, err)
+
+		tgIDs, err := linkRepo.GetUsersByLink(ctx, testLink.ID)
+		require.NoError(t, err)
+		assert.NotContains(t, tgIDs, tgID, "Пользователь на паузе не должен получать уведомления")
+
+		linksAfter, err := linkRepo.GetLinksAfter(ctx, time.Time{}, 10)
+		require.NoError(t, err)
+
+		for _, l := range linksAfter {
+			assert.NotEqual(t, testLink.ID, l.ID, "Ссылка без активных подписчиков не должна проверяться")
+		}
+
+		links, err := linkRepo.GetUserLinks(ctx, tgID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.True(t, links[0].Paused)
+		assert.True(t, links[0].PausedUntil.IsZero())
+
+		// Временная пауза видна до её окончания
+		until := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+		err = linkRepo.PauseLinks(ctx, tgID, []int64{testLink.ID}, until)
+		require.NoError(t, err)
+
+		links, err = linkRepo.GetUserLinks(ctx, tgID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.True(t, links[0].Paused)
+		assert.WithinDuration(t, until, links[0].PausedUntil, time.Second)
+
+		err = linkRepo.ResumeLinks(ctx, tgID, []int64{testLink.ID})
+		require.NoError(t, err)
+
+		tgIDs, err = linkRepo.GetUsersByLink(ctx, testLink.ID)
+		require.NoError(t, err)
+		assert.Contains(t, tgIDs, tgID, "После /resume пользователь снова получает уведомления")
+	})
+
+	t.Run("Update History", func(t *testing.T) {
+		updateRepo := pgxrepo.NewUpdateRepoPgx(pool)
+		createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		for _, title := range []string{"first", "second", "third"} {
+			err := updateRepo.AddUpdate(ctx, &domain.LinkUpdate{
+				Link:        testLink,
+				Description: "Обновление " + title,
+				Details:     domain.UpdateDetails{Kind: "Issue", Title: title, Author: "user", CreatedAt: createdAt},
+			})
+			require.NoError(t, err)
+		}
+
+		// История отдаётся от новых записей к старым
+		records, total, err := updateRepo.GetLinkUpdates(ctx, testLink.ID, 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, records, 2)
+		assert.Equal(t, "second", records[0].Details.Title)
+		assert.Equal(t, "first", records[1].Details.Title)
+		assert.Equal(t, testLink.ID, records[0].LinkID)
+		assert.True(t, createdAt.Equal(records[0].Details.CreatedAt))
+		assert.False(t, records[0].DetectedAt.IsZero())
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		// Адрес ищется по частям, тег и история — по словам с префиксом
+		results, err := linkRepo.SearchUserLinks(ctx, tgID, []string{"exam"}, 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, testLink.ID, results[0].Link.ID)
+		assert.Positive(t, results[0].Rank)
+
+		results, err = linkRepo.SearchUserLinks(ctx, tgID, []string{"renamed"}, 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+
+		// Из истории возвращается подходящее под запрос событие из подтеста Update History
+		results, err = linkRepo.SearchUserLinks(ctx, tgID, []string{"issue", "seco"}, 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.NotNil(t, results[0].Update)
+		assert.Equal(t, "second", results[0].Update.Details.Title)
+
+		results, err = linkRepo.SearchUserLinks(ctx, tgID, []string{"missing"}, 10)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
 	t.Run("Delete Link", func(t *testing.T) {
 		// Удаляем ссылку для пользователя
 		deletedLink, err := linkRepo.DeleteLink(ctx, tgID, &domain.Link{URL: testLink.URL})
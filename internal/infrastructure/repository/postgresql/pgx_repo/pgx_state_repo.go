@@ -2,6 +2,7 @@ package pgxrepo
 
 import (
 	"context"
+	"time"
 
 	"LinkTracker/internal/domain"
 
@@ -19,10 +20,12 @@ func NewStateRepoPgx(pool *pgxpool.Pool) *StateRepoPgx {
 	}
 }
 
-func (r *StateRepoPgx) CreateState(ctx context.Context, tgID int64, state int) error {
-	sql := "INSERT INTO states (tg_id, state) VALUES ($1, $2) ON CONFLICT(tg_id) DO UPDATE SET state = $2"
+// CreateState начинает диалог участника userID в чате tgID, заменяя незавершённый.
+func (r *StateRepoPgx) CreateState(ctx context.Context, tgID, userID int64, state int, updatedAt time.Time) error {
+	sql := `INSERT INTO states (tg_id, user_id, state, updated_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT(tg_id) DO UPDATE SET user_id = $2, state = $3, url = NULL, tags = NULL, filters = NULL, updated_at = $4`
 
-	_, err := r.pool.Exec(ctx, sql, tgID, state)
+	_, err := r.pool.Exec(ctx, sql, tgID, userID, state, updatedAt)
 	if err != nil {
 		return err
 	}
@@ -41,19 +44,21 @@ func (r *StateRepoPgx) DeleteState(ctx context.Context, tgID int64) error {
 	return nil
 }
 
-func (r *StateRepoPgx) GetState(ctx context.Context, tgID int64) (int, domain.Link, error) {
-	sqlSelect := "SELECT state,url,tags,filters FROM states WHERE tg_id = $1"
-	row := r.pool.QueryRow(ctx, sqlSelect, tgID)
+// GetState возвращает шаг диалога в чате tgID, начавшего его участника и собранные данные ссылки.
+func (r *StateRepoPgx) GetState(ctx context.Context, tgID int64, updatedAfter time.Time) (int, int64, domain.Link, error) {
+	sqlSelect := "SELECT state,user_id,url,tags,filters FROM states WHERE tg_id = $1 AND updated_at > $2"
+	row := r.pool.QueryRow(ctx, sqlSelect, tgID, updatedAfter)
 
 	var (
 		state   int
+		userID  int64
 		url     pgtype.Text
 		tags    pgtype.Array[string]
 		filters pgtype.Array[string]
 	)
 
-	if err := row.Scan(&state, &url, &tags, &filters); err != nil {
-		return -1, domain.Link{}, err
+	if err := row.Scan(&state, &userID, &url, &tags, &filters); err != nil {
+		return -1, 0, domain.Link{}, err
 	}
 
 	link := domain.Link{
@@ -75,20 +80,33 @@ func (r *StateRepoPgx) GetState(ctx context.Context, tgID int64) (int, domain.Li
 		link.Filters = filters.Elements
 	}
 
-	return state, link, nil
+	return state, userID, link, nil
 }
 
-func (r *StateRepoPgx) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link) error {
+// UpdateState сохраняет следующий шаг диалога. updated_at обновляется, чтобы срок жизни состояния
+// отсчитывался от последнего действия пользователя.
+func (r *StateRepoPgx) UpdateState(ctx context.Context, tgID int64, state int, link *domain.Link, updatedAt time.Time) error {
 	url := link.URL
 	tags := link.Tags
 	filters := link.Filters
 
-	sql := "UPDATE states SET state = $1, url=$2,tags = $3, filters= $4 WHERE tg_id = $5"
+	sql := "UPDATE states SET state = $1, url=$2,tags = $3, filters= $4, updated_at = $5 WHERE tg_id = $6"
 
-	_, err := r.pool.Exec(ctx, sql, state, url, tags, filters, tgID)
+	_, err := r.pool.Exec(ctx, sql, state, url, tags, filters, updatedAt, tgID)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (r *StateRepoPgx) DeleteExpiredStates(ctx context.Context, updatedBefore time.Time) (int64, error) {
+	sql := "DELETE FROM states WHERE updated_at <= $1"
+
+	result, err := r.pool.Exec(ctx, sql, updatedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
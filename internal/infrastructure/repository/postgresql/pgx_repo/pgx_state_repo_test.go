@@ -30,18 +30,24 @@ func Test_StateRepo(t *testing.T) {
 	// Используем некоторое тестовое значение tg_id
 	const tgID int64 = 55555
 
+	// Участник группы, начавший диалог
+	const userID int64 = 777
+
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
 	t.Run("CreateState and GetState", func(t *testing.T) {
 		// Создаём состояние для пользователя (без дополнительных данных для Link)
 		initialState := 1
-		err := stateRepo.CreateState(ctx, tgID, initialState)
+		err := stateRepo.CreateState(ctx, tgID, userID, initialState, createdAt)
 		require.NoError(t, err)
 
 		// Получаем состояние и проверяем, что оно соответствует ожидаемому.
 		// Поскольку при создании через CreateState передаётся только state, остальные поля (url, tags, filters)
 		// должны иметь значения по умолчанию (пустая строка и пустые срезы)
-		state, link, err := stateRepo.GetState(ctx, tgID)
+		state, stateUserID, link, err := stateRepo.GetState(ctx, tgID, time.Time{})
 		require.NoError(t, err)
 		assert.Equal(t, initialState, state)
+		assert.Equal(t, userID, stateUserID, "Ожидается участник, начавший диалог")
 		assert.Equal(t, "", link.URL, "Ожидается, что URL по умолчанию пустой")
 		assert.Empty(t, link.Tags, "Ожидается, что Tags по умолчанию пустой срез")
 		assert.Empty(t, link.Filters, "Ожидается, что Filters по умолчанию пустой срез")
@@ -55,13 +61,14 @@ func Test_StateRepo(t *testing.T) {
 			Tags:    []string{"state-tag1", "state-tag2"},
 			Filters: []string{"state-filter1"},
 		}
-		err := stateRepo.UpdateState(ctx, tgID, updatedState, updateLink)
+		err := stateRepo.UpdateState(ctx, tgID, updatedState, updateLink, createdAt.Add(time.Hour))
 		require.NoError(t, err)
 
-		// Проверяем, что обновление прошло успешно.
-		state, link, err := stateRepo.GetState(ctx, tgID)
+		// Проверяем, что обновление прошло успешно и срок жизни состояния отсчитывается от последнего шага.
+		state, stateUserID, link, err := stateRepo.GetState(ctx, tgID, createdAt.Add(30*time.Minute))
 		require.NoError(t, err)
 		assert.Equal(t, updatedState, state, "Ожидается обновлённое состояние")
+		assert.Equal(t, userID, stateUserID, "Шаг диалога не меняет его участника")
 		assert.Equal(t, updateLink.URL, link.URL, "URL не обновился")
 		assert.Equal(t, updateLink.Tags, link.Tags, "Tags не обновились")
 		assert.Equal(t, updateLink.Filters, link.Filters, "Filters не обновились")
@@ -73,9 +80,32 @@ func Test_StateRepo(t *testing.T) {
 		require.NoError(t, err)
 
 		// После удаления попытка получить состояние должна вернуть ошибку, поскольку запись отсутствует.
-		_, _, err = stateRepo.GetState(ctx, tgID)
+		_, _, _, err = stateRepo.GetState(ctx, tgID, time.Time{})
 		require.Error(t, err, "Ожидается ошибка при получении несуществующего состояния")
 		// Дополнительно можно проверить, что ошибка соответствует pgx.ErrNoRows
 		assert.Equal(t, pgx.ErrNoRows, err, "Ожидается pgx.ErrNoRows при отсутствии записи")
 	})
+
+	t.Run("GetState ignores expired state", func(t *testing.T) {
+		// Состояние, обновлённое раньше границы updatedAfter, считается просроченным
+		err := stateRepo.CreateState(ctx, tgID, userID, 1, createdAt)
+		require.NoError(t, err)
+
+		_, _, _, err = stateRepo.GetState(ctx, tgID, createdAt.Add(time.Minute))
+		assert.Equal(t, pgx.ErrNoRows, err, "Ожидается pgx.ErrNoRows для просроченного состояния")
+	})
+
+	t.Run("DeleteExpiredStates", func(t *testing.T) {
+		deleted, err := stateRepo.DeleteExpiredStates(ctx, createdAt.Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), deleted, "Состояние, обновлённое после границы, не удаляется")
+
+		// Удаляем все состояния, обновлённые до границы
+		deleted, err = stateRepo.DeleteExpiredStates(ctx, createdAt.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted, "Ожидается удаление одного просроченного состояния")
+
+		_, _, _, err = stateRepo.GetState(ctx, tgID, time.Time{})
+		assert.Equal(t, pgx.ErrNoRows, err, "Просроченное состояние не удалено")
+	})
 }
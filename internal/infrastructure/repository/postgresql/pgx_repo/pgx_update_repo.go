@@ -0,0 +1,93 @@
+package pgxrepo
+
+import (
+	"context"
+	"time"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UpdateRepoPgx struct {
+	pool *pgxpool.Pool
+}
+
+func NewUpdateRepoPgx(pool *pgxpool.Pool) *UpdateRepoPgx {
+	return &UpdateRepoPgx{pool: pool}
+}
+
+// AddUpdate сохраняет обнаруженное обновление ссылки в историю.
+func (r *UpdateRepoPgx) AddUpdate(ctx context.Context, update *domain.LinkUpdate) error {
+	sql := `INSERT INTO updates
+		(url_id, description, kind, title, item_url, author, author_url, event_at, preview, detected_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	details := &update.Details
+
+	var eventAt pgtype.Timestamp
+	if !details.CreatedAt.IsZero() {
+		eventAt = pgtype.Timestamp{Time: details.CreatedAt.UTC(), Valid: true}
+	}
+
+	_, err := r.pool.Exec(ctx, sql, update.Link.ID, update.Description, details.Kind, details.Title, details.URL,
+		details.Author, details.AuthorURL, eventAt, details.Preview, time.Now().UTC())
+
+	return err
+}
+
+// GetLinkUpdates возвращает страницу истории обновлений ссылки от новых к старым и общее число записей.
+func (r *UpdateRepoPgx) GetLinkUpdates(ctx context.Context, linkID, limit, offset int64) ([]domain.UpdateRecord, int64, error) {
+	var total int64
+
+	err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM updates WHERE url_id = $1", linkID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sql := `SELECT id, url_id, description, kind, title, item_url, author, author_url, event_at, preview, detected_at
+		FROM updates WHERE url_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3`
+
+	rows, err := r.pool.Query(ctx, sql, linkID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var records []domain.UpdateRecord
+
+	for rows.Next() {
+		var (
+			record  domain.UpdateRecord
+			eventAt pgtype.Timestamp
+		)
+
+		details := &record.Details
+
+		err := rows.Scan(&record.ID, &record.LinkID, &record.Description, &details.Kind, &details.Title, &details.URL,
+			&details.Author, &details.AuthorURL, &eventAt, &details.Preview, &record.DetectedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if eventAt.Valid {
+			details.CreatedAt = eventAt.Time
+		}
+
+		records = append(records, record)
+	}
+
+	return records, total, rows.Err()
+}
+
+// DeleteUpdatesBefore удаляет из истории обновления, обнаруженные не позже detectedBefore,
+// и возвращает число удалённых записей.
+func (r *UpdateRepoPgx) DeleteUpdatesBefore(ctx context.Context, detectedBefore time.Time) (int64, error) {
+	result, err := r.pool.Exec(ctx, "DELETE FROM updates WHERE detected_at <= $1", detectedBefore.UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
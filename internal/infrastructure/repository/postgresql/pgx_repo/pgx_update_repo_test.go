@@ -0,0 +1,99 @@
+package pgxrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"LinkTracker/internal/infrastructure/repository/postgresql"
+	pgxrepo "LinkTracker/internal/infrastructure/repository/postgresql/pgx_repo"
+
+	"LinkTracker/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateRepo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	pool, cleanup, err := postgresql.RunPostgresAndMigrateTestContainers(ctx)
+	require.NoError(t, err)
+	defer cleanup()
+
+	userRepo := pgxrepo.NewUserRepo(pool)
+	linkRepo := pgxrepo.NewLinkRepo(pool)
+	updateRepo := pgxrepo.NewUpdateRepoPgx(pool)
+
+	const tgID int64 = 88888
+
+	require.NoError(t, userRepo.CreateUser(ctx, tgID))
+
+	link, err := linkRepo.AddLink(ctx, tgID, &domain.Link{URL: "https://github.com/owner/repo", Tags: []string{}, Filters: []string{}})
+	require.NoError(t, err)
+
+	other, err := linkRepo.AddLink(ctx, tgID, &domain.Link{URL: "https://github.com/owner/other", Tags: []string{}, Filters: []string{}})
+	require.NoError(t, err)
+
+	t.Run("AddUpdate and GetLinkUpdates", func(t *testing.T) {
+		details := domain.UpdateDetails{
+			Kind:      "Issue",
+			Title:     "Title",
+			URL:       "https://github.com/owner/repo/issues/1",
+			Author:    "author",
+			AuthorURL: "https://github.com/author",
+			CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Preview:   "preview",
+		}
+		require.NoError(t, updateRepo.AddUpdate(ctx, &domain.LinkUpdate{Link: link, Description: "first", Details: details}))
+		require.NoError(t, updateRepo.AddUpdate(ctx, &domain.LinkUpdate{Link: other, Description: "other"}))
+
+		records, total, err := updateRepo.GetLinkUpdates(ctx, link.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total, "Обновления других ссылок не учитываются")
+		require.Len(t, records, 1)
+		assert.Equal(t, link.ID, records[0].LinkID)
+		assert.Equal(t, "first", records[0].Description)
+		assert.Equal(t, details, records[0].Details)
+		assert.WithinDuration(t, time.Now().UTC(), records[0].DetectedAt, time.Minute)
+	})
+
+	t.Run("GetLinkUpdates pagination", func(t *testing.T) {
+		require.NoError(t, updateRepo.AddUpdate(ctx, &domain.LinkUpdate{Link: link, Description: "second"}))
+		require.NoError(t, updateRepo.AddUpdate(ctx, &domain.LinkUpdate{Link: link, Description: "third"}))
+
+		records, total, err := updateRepo.GetLinkUpdates(ctx, link.ID, 2, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, records, 2)
+		assert.Equal(t, "third", records[0].Description, "Новые обновления идут первыми")
+		assert.Equal(t, "second", records[1].Description)
+		assert.True(t, records[1].Details.CreatedAt.IsZero(), "Пустое время события сохраняется как NULL")
+
+		records, total, err = updateRepo.GetLinkUpdates(ctx, link.ID, 2, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, records, 1)
+		assert.Equal(t, "first", records[0].Description)
+
+		records, _, err = updateRepo.GetLinkUpdates(ctx, link.ID, 2, 10)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("DeleteUpdatesBefore", func(t *testing.T) {
+		deleted, err := updateRepo.DeleteUpdatesBefore(ctx, time.Now().UTC().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), deleted, "Свежие обновления не удаляются")
+
+		deleted, err = updateRepo.DeleteUpdatesBefore(ctx, time.Now().UTC().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+
+		records, total, err := updateRepo.GetLinkUpdates(ctx, link.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Empty(t, records)
+	})
+}
@@ -2,6 +2,10 @@ package pgxrepo
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"LinkTracker/internal/domain"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -60,3 +64,58 @@ func (r *UserRepoPgx) GetAllUsers(ctx context.Context) ([]int64, error) {
 
 	return tgIDs, rows.Err()
 }
+
+// GetUsersLinkCounts возвращает всех пользователей с числом отслеживаемых ими ссылок, включая тех, у кого ссылок нет.
+func (r *UserRepoPgx) GetUsersLinkCounts(ctx context.Context) ([]domain.UserLinkCount, error) {
+	sql := `
+		SELECT u.tg_id, COUNT(t.url_id)
+		FROM users u
+		LEFT JOIN tracks t ON t.tg_id = u.tg_id
+		GROUP BY u.tg_id
+		ORDER BY u.tg_id
+	`
+
+	rows, err := r.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var users []domain.UserLinkCount
+
+	for rows.Next() {
+		var user domain.UserLinkCount
+		if err := rows.Scan(&user.TgID, &user.Links); err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// SaveTokenHash сохраняет хеш персонального токена пользователя, заменяя выпущенный ранее.
+func (r *UserRepoPgx) SaveTokenHash(ctx context.Context, tgID int64, tokenHash string) error {
+	sql := `INSERT INTO api_tokens (tg_id, token_hash, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT(tg_id) DO UPDATE SET token_hash = $2, created_at = $3`
+
+	_, err := r.pool.Exec(ctx, sql, tgID, tokenHash, time.Now().UTC())
+
+	return err
+}
+
+// GetUserByTokenHash возвращает tg_id владельца токена с хешем tokenHash или ErrInvalidToken, если такого токена нет.
+func (r *UserRepoPgx) GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	sql := "SELECT tg_id FROM api_tokens WHERE token_hash = $1"
+
+	var tgID int64
+
+	err := r.pool.QueryRow(ctx, sql, tokenHash).Scan(&tgID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrInvalidToken{}
+	}
+
+	return tgID, err
+}
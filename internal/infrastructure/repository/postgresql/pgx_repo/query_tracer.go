@@ -0,0 +1,51 @@
+package pgxrepo
+
+import (
+	"context"
+	"strings"
+
+	"LinkTracker/internal/tracing"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type querySpanKey struct{}
+
+// queryTracer создаёт спан на каждый запрос к базе. Запросы без родительского спана
+// (фоновая очистка, служебные запросы пула) не трейсятся, чтобы не плодить одиночные трейсы.
+type queryTracer struct{}
+
+func (queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	ctx, span := tracing.Start(ctx, "db "+queryOperation(data.SQL),
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", data.SQL),
+	)
+
+	return context.WithValue(ctx, querySpanKey{}, span)
+}
+
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span, ok := ctx.Value(querySpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	tracing.Finish(span, data.Err)
+}
+
+// queryOperation возвращает первое слово запроса: SELECT, INSERT, WITH и т.д.
+func queryOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+
+	return strings.ToUpper(fields[0])
+}
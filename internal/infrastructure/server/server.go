@@ -1,48 +1,134 @@
 package server
 
 import (
+	"crypto/tls"
 	"net/http"
 	"time"
 
 	"LinkTracker/internal/application/bot"
 
 	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/infrastructure/httpapi/admin"
+	"LinkTracker/internal/infrastructure/httpapi/health"
 	"LinkTracker/internal/infrastructure/httpapi/links"
+	"LinkTracker/internal/infrastructure/httpapi/publicapi"
+	"LinkTracker/internal/infrastructure/httpapi/search"
+	"LinkTracker/internal/infrastructure/httpapi/settings"
 	"LinkTracker/internal/infrastructure/httpapi/states"
+	"LinkTracker/internal/infrastructure/httpapi/tags"
 	"LinkTracker/internal/infrastructure/httpapi/tgchat"
 	"LinkTracker/internal/infrastructure/httpapi/updates"
+	"LinkTracker/internal/tracing"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func InitScrapperRouting(s *scrapper.Scrapper) *http.ServeMux {
+// InitScrapperRouting регистрирует маршруты scrapper. Служебный API /admin регистрируется,
+// только если задан adminToken.
+func InitScrapperRouting(s *scrapper.Scrapper, readiness []health.Component, adminToken string) *http.ServeMux {
 	mux := http.NewServeMux()
 	mux.Handle("GET /links", links.GetLinksHandler{LinkGetter: s})
 	mux.Handle("POST /links", links.PostLinksHandler{LinkAdder: s})
+	mux.Handle("POST /links/batch", links.PostLinksBatchHandler{LinksBatchAdder: s})
+	mux.Handle("POST /links/pause", links.PostLinksPauseHandler{LinksPauser: s})
+	mux.Handle("POST /links/resume", links.PostLinksResumeHandler{LinksResumer: s})
+	mux.Handle("GET /links/{id}/updates", links.GetLinkUpdatesHandler{LinkUpdatesGetter: s})
 	mux.Handle("DELETE /links", links.DeleteLinksHandler{LinkDeleter: s})
 	mux.Handle("PUT /links", links.PutLinksHandler{LinkUpdater: s})
 
 	mux.Handle("POST /tg-chat/{id}", tgchat.PostUserHandler{UserAdder: s})
 	mux.Handle("DELETE /tg-chat/{id}", tgchat.DeleteUserHandler{UserDeleter: s})
+	mux.Handle("POST /tg-chat/{id}/token", tgchat.PostTokenHandler{TokenIssuer: s})
 
 	mux.Handle("POST /states", states.PostStatesHandler{StateCreator: s})
 	mux.Handle("DELETE /states", states.DeleteStatesHandler{StateDeleter: s})
 	mux.Handle("PUT /states", states.PutStatesHandler{StateUpdater: s})
 	mux.Handle("GET /states", states.GetStatesHandler{StateGetter: s})
 
+	mux.Handle("GET /settings", settings.GetSettingsHandler{SettingsGetter: s})
+	mux.Handle("PUT /settings", settings.PutSettingsHandler{SettingsUpdater: s})
+	mux.Handle("PUT /settings/tags", settings.PutTagSettingsHandler{TagModeSetter: s})
+
+	mux.Handle("GET /tags", tags.GetTagsHandler{TagsGetter: s})
+	mux.Handle("PUT /tags/{tag}", tags.PutTagHandler{TagRenamer: s})
+	mux.Handle("DELETE /tags/{tag}/links", tags.DeleteTagLinksHandler{TagLinksDeleter: s})
+
+	mux.Handle("GET /search", search.GetSearchHandler{LinkSearcher: s})
+
+	mux.Handle("GET /metrics", promhttp.Handler())
+	mux.Handle("GET /healthz", health.GetLivenessHandler{})
+	mux.Handle("GET /readyz", health.GetReadinessHandler{Components: readiness})
+
+	if adminToken != "" {
+		initAdminRouting(mux, s, adminToken)
+	}
+
 	return mux
 }
 
-func InitBotRouting(b *bot.Bot) *http.ServeMux {
+func initAdminRouting(mux *http.ServeMux, s *scrapper.Scrapper, token string) {
+	mux.Handle("GET /admin/users", admin.RequireToken(token, admin.GetUsersHandler{UsersLister: s}))
+	mux.Handle("POST /admin/checks", admin.RequireToken(token, admin.PostChecksHandler{LinkChecker: s}))
+	mux.Handle("POST /admin/scrape", admin.RequireToken(token, admin.PostScrapeHandler{ScrapeTrigger: s}))
+	mux.Handle("GET /admin/scheduler", admin.RequireToken(token, admin.GetSchedulerHandler{SchedulerStatusGetter: s}))
+	mux.Handle("POST /admin/scheduler/pause", admin.RequireToken(token, admin.PostSchedulerPauseHandler{SchedulerPauser: s}))
+	mux.Handle("POST /admin/scheduler/resume", admin.RequireToken(token, admin.PostSchedulerResumeHandler{SchedulerResumer: s}))
+	mux.Handle("GET /admin/links/unhealthy", admin.RequireToken(token, admin.GetUnhealthyLinksHandler{UnhealthyLinksGetter: s}))
+}
+
+// InitPublicAPIRouting регистрирует маршруты публичного API /api/v1. Они обслуживаются теми же обработчиками,
+// что и запросы бота, а Tg-Chat-Id подставляет publicapi.RequireToken по токену пользователя. Внутренних
+// маршрутов здесь нет, поэтому публичный API обслуживается отдельным сервером.
+func InitPublicAPIRouting(s *scrapper.Scrapper) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("GET /links", links.GetLinksHandler{LinkGetter: s})
+	mux.Handle("POST /links", links.PostLinksHandler{LinkAdder: s})
+	mux.Handle("PUT /links", links.PutLinksHandler{LinkUpdater: s})
+	mux.Handle("DELETE /links", links.DeleteLinksHandler{LinkDeleter: s})
+
+	mux.Handle("GET /tags", tags.GetTagsHandler{TagsGetter: s})
+	mux.Handle("PUT /tags/{tag}", tags.PutTagHandler{TagRenamer: s})
+	mux.Handle("DELETE /tags/{tag}/links", tags.DeleteTagLinksHandler{TagLinksDeleter: s})
+
+	mux.Handle("GET /settings", settings.GetSettingsHandler{SettingsGetter: s})
+	mux.Handle("PUT /settings", settings.PutSettingsHandler{SettingsUpdater: s})
+	mux.Handle("PUT /settings/tags", settings.PutTagSettingsHandler{TagModeSetter: s})
+
+	publicMux := http.NewServeMux()
+	publicMux.Handle("/api/v1/", http.StripPrefix("/api/v1", publicapi.RequireToken(s, mux)))
+
+	return publicMux
+}
+
+func InitBotRouting(b *bot.Bot, readiness []health.Component) *http.ServeMux {
 	mux := http.NewServeMux()
 	mux.Handle("POST /updates", updates.PostUpdatesHandler{UpdateSender: b})
+	mux.Handle("POST /digests", updates.PostDigestsHandler{DigestSender: b})
+
+	mux.Handle("GET /metrics", promhttp.Handler())
+	mux.Handle("GET /healthz", health.GetLivenessHandler{})
+	mux.Handle("GET /readyz", health.GetReadinessHandler{Components: readiness})
 
 	return mux
 }
 
-func InitServer(addr string, handler http.Handler, readTimeout, writeTimeout time.Duration) *http.Server {
+// InitServer создаёт HTTP-сервер. Если tlsConfig не nil, сервер принимает только TLS-соединения.
+func InitServer(addr string, handler http.Handler, readTimeout, writeTimeout time.Duration, tlsConfig *tls.Config) *http.Server {
 	return &http.Server{
 		Addr:         addr,
-		Handler:      handler,
+		Handler:      tracing.Handler(handler),
 		ReadTimeout:  readTimeout,
 		WriteTimeout: writeTimeout,
+		TLSConfig:    tlsConfig,
+	}
+}
+
+// ListenAndServe запускает сервер по TLS, если у него есть TLSConfig, и по HTTP иначе.
+func ListenAndServe(serv *http.Server) error {
+	if serv.TLSConfig != nil {
+		// Сертификат уже загружен в TLSConfig
+		return serv.ListenAndServeTLS("", "")
 	}
+
+	return serv.ListenAndServe()
 }
@@ -0,0 +1,64 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"LinkTracker/internal/application/scrapper"
+	"LinkTracker/internal/application/scrapper/mocks"
+	"LinkTracker/internal/domain"
+	"LinkTracker/internal/infrastructure/server"
+)
+
+func Test_InitPublicAPIRouting_NoInternalRoutes(t *testing.T) {
+	userRepo := &mocks.UserRepo{}
+	userRepo.On("GetUserByTokenHash", mock.Anything, domain.HashAPIToken("lt_token")).Return(int64(5), nil)
+
+	s := scrapper.NewScrapper(userRepo, &mocks.LinkRepo{}, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+	routing := server.InitPublicAPIRouting(s)
+
+	for _, path := range []string{"/tg-chat/1/token", "/api/v1/tg-chat/1/token", "/admin/users", "/api/v1/states"} {
+		r := httptest.NewRequest(http.MethodPost, path, http.NoBody)
+		r.Header.Set("Authorization", "Bearer lt_token")
+
+		w := httptest.NewRecorder()
+
+		routing.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code, path)
+	}
+
+	userRepo.AssertNotCalled(t, "SaveTokenHash", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_InitScrapperRouting_UnhealthyLinksRequireAdminToken(t *testing.T) {
+	linkRepo := &mocks.LinkRepo{}
+	linkRepo.On("GetUnhealthyLinks", mock.Anything, int64(1)).Return([]domain.Link{}, nil).Once()
+
+	s := scrapper.NewScrapper(&mocks.UserRepo{}, linkRepo, &mocks.StateRepo{}, &mocks.DeliveryRepo{}, &mocks.UpdateRepo{},
+		time.Minute, time.Minute, &mocks.Notifier{}, &mocks.LinkChecker{})
+	routing := server.InitScrapperRouting(s, nil, "admin_token")
+
+	w := httptest.NewRecorder()
+	routing.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/links/unhealthy", http.NoBody))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	routing.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/links/unhealthy", http.NoBody))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	r := httptest.NewRequest(http.MethodGet, "/admin/links/unhealthy", http.NoBody)
+	r.Header.Set("Authorization", "Bearer admin_token")
+
+	w = httptest.NewRecorder()
+	routing.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	linkRepo.AssertExpectations(t)
+}
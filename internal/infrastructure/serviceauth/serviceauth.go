@@ -0,0 +1,162 @@
+// Package serviceauth аутентифицирует запросы между bot и scrapper: общий токен в заголовке Authorization
+// и, если заданы сертификаты, взаимный TLS, при котором клиент предъявляет сертификат, подписанный общим CA.
+package serviceauth
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"LinkTracker/internal/infrastructure/httpapi"
+)
+
+const bearerPrefix = "Bearer "
+
+// publicPaths открыты без аутентификации: их опрашивают сборщик метрик и проверки состояния контейнеров.
+var publicPaths = map[string]bool{
+	"/metrics": true,
+	"/healthz": true,
+	"/readyz":  true,
+}
+
+// adminPrefix — служебный API оператора, он защищён собственным токеном администратора.
+const adminPrefix = "/admin/"
+
+// Credentials — то, чем клиент подтверждает, что он bot или scrapper. Пустые поля не используются.
+type Credentials struct {
+	Token string
+	TLS   *tls.Config
+}
+
+// Transport возвращает транспорт HTTP-клиента, который предъявляет сертификат из TLS и добавляет токен к каждому запросу.
+func (c Credentials) Transport() http.RoundTripper {
+	var base http.RoundTripper = http.DefaultTransport
+
+	if c.TLS != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = c.TLS
+		base = transport
+	}
+
+	if c.Token == "" {
+		return base
+	}
+
+	return tokenTransport{token: c.Token, base: base}
+}
+
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t tokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// RoundTripper не должен менять исходный запрос
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", bearerPrefix+t.token)
+
+	return t.base.RoundTrip(r)
+}
+
+// BearerToken возвращает токен из заголовка "Authorization: Bearer <token>" и false, если заголовка нет.
+func BearerToken(r *http.Request) (string, bool) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), bearerPrefix)
+
+	return token, found && token != ""
+}
+
+// HasBearerToken сообщает, передан ли в запросе заголовок "Authorization: Bearer <token>".
+// Токен сравнивается за постоянное время, чтобы его нельзя было подобрать по времени ответа.
+func HasBearerToken(r *http.Request, token string) bool {
+	provided, found := BearerToken(r)
+
+	return found && subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+}
+
+// Middleware пропускает к next только запросы другого сервиса: с токеном token, если он задан, и с проверенным
+// клиентским сертификатом, если requireClientCert. Метрики, проверки состояния и /admin/ не проверяются.
+func Middleware(token string, requireClientCert bool, next http.Handler) http.Handler {
+	if token == "" && !requireClientCert {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if publicPaths[r.URL.Path] || strings.HasPrefix(r.URL.Path, adminPrefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if requireClientCert && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+			httpapi.SendErrorResponse(w, http.StatusUnauthorized, "401",
+				"Client certificate required", "verified client certificate required", "UNAUTHORIZED")
+
+			return
+		}
+
+		if token != "" && !HasBearerToken(r, token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			httpapi.SendErrorResponse(w, http.StatusUnauthorized, "401",
+				"Invalid or missing service token", "service token required", "UNAUTHORIZED")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Setup собирает учётные данные клиента и TLS-конфигурацию сервера. Если certFile не задан, взаимный TLS
+// выключен и serverTLS равен nil: сервер работает по HTTP, а клиент использует системные настройки.
+func Setup(token, certFile, keyFile, caFile string) (credentials Credentials, serverTLS *tls.Config, err error) {
+	credentials.Token = token
+
+	if certFile == "" {
+		return credentials, nil, nil
+	}
+
+	cert, pool, err := loadCertificates(certFile, keyFile, caFile)
+	if err != nil {
+		return Credentials{}, nil, err
+	}
+
+	// Клиент проверяет сертификат сервера по общему CA и предъявляет свой
+	credentials.TLS = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+	}
+
+	// Клиентский сертификат не обязателен на уровне TLS, чтобы метрики и проверки состояния были доступны
+	// без него; для остальных путей его требует Middleware
+	serverTLS = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	}
+
+	return credentials, serverTLS, nil
+}
+
+func loadCertificates(certFile, keyFile, caFile string) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("could not load service certificate: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("could not read service CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no certificates found in service CA %s", caFile)
+	}
+
+	return cert, pool, nil
+}
@@ -0,0 +1,188 @@
+package serviceauth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"LinkTracker/internal/infrastructure/serviceauth"
+)
+
+func okHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return mux
+}
+
+func Test_Middleware_Token(t *testing.T) {
+	handler := serviceauth.Middleware("secret", false, okHandler())
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantCode      int
+	}{
+		{name: "valid token", path: "/links", authorization: "Bearer secret", wantCode: http.StatusOK},
+		{name: "missing token", path: "/links", wantCode: http.StatusUnauthorized},
+		{name: "wrong token", path: "/tg-chat/1", authorization: "Bearer other", wantCode: http.StatusUnauthorized},
+		{name: "health without token", path: "/healthz", wantCode: http.StatusOK},
+		{name: "metrics without token", path: "/metrics", wantCode: http.StatusOK},
+		{name: "admin has own token", path: "/admin/users", wantCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
+
+func Test_Middleware_Disabled(t *testing.T) {
+	w := httptest.NewRecorder()
+	serviceauth.Middleware("", false, okHandler()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/links", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func Test_Credentials_Transport_SendsToken(t *testing.T) {
+	server := httptest.NewServer(serviceauth.Middleware("secret", false, okHandler()))
+	defer server.Close()
+
+	client := &http.Client{Transport: serviceauth.Credentials{Token: "secret"}.Transport()}
+
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/links", http.NoBody)
+	require.NoError(t, err)
+
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Empty(t, request.Header.Get("Authorization"), "исходный запрос не должен меняться")
+}
+
+func Test_Setup_MutualTLS(t *testing.T) {
+	certFile, keyFile, caFile := writeCertificates(t)
+
+	credentials, serverTLS, err := serviceauth.Setup("secret", certFile, keyFile, caFile)
+	require.NoError(t, err)
+	require.NotNil(t, serverTLS)
+
+	server := httptest.NewUnstartedServer(serviceauth.Middleware("secret", true, okHandler()))
+	server.TLS = serverTLS
+	server.StartTLS()
+
+	defer server.Close()
+
+	get := func(client *http.Client, path string) int {
+		response, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		return response.StatusCode
+	}
+
+	client := &http.Client{Transport: credentials.Transport()}
+	assert.Equal(t, http.StatusOK, get(client, "/links"))
+
+	// Без клиентского сертификата доступны только служебные пути
+	withoutCert := credentials
+	withoutCert.TLS = credentials.TLS.Clone()
+	withoutCert.TLS.Certificates = nil
+	client = &http.Client{Transport: withoutCert.Transport()}
+
+	assert.Equal(t, http.StatusUnauthorized, get(client, "/links"))
+	assert.Equal(t, http.StatusOK, get(client, "/healthz"))
+}
+
+func Test_Setup_WithoutTLS(t *testing.T) {
+	credentials, serverTLS, err := serviceauth.Setup("secret", "", "", "")
+	require.NoError(t, err)
+	assert.Nil(t, serverTLS)
+	assert.Equal(t, serviceauth.Credentials{Token: "secret"}, credentials)
+}
+
+// writeCertificates создаёт CA и подписанный им сертификат сервиса для 127.0.0.1 и возвращает пути к файлам.
+func writeCertificates(t *testing.T) (certFile, keyFile, caFile string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "LinkTracker CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	serviceKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serviceTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "scrapper"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	serviceDER, err := x509.CreateCertificate(rand.Reader, serviceTemplate, caTemplate, &serviceKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	serviceKeyDER, err := x509.MarshalECPrivateKey(serviceKey)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "service.crt")
+	keyFile = filepath.Join(dir, "service.key")
+	caFile = filepath.Join(dir, "ca.crt")
+
+	writePEM(t, certFile, "CERTIFICATE", serviceDER)
+	writePEM(t, keyFile, "EC PRIVATE KEY", serviceKeyDER)
+	writePEM(t, caFile, "CERTIFICATE", caDER)
+
+	return certFile, keyFile, caFile
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+}
@@ -0,0 +1,128 @@
+// Package metrics описывает метрики Prometheus, которые отдают scrapper и bot на /metrics.
+package metrics
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "linktracker"
+
+// Исходы проверки ссылки для CheckOutcome.
+const (
+	CheckUpdated     = "updated"
+	CheckNoUpdates   = "no_updates"
+	CheckDeleted     = "deleted"
+	CheckUnsupported = "unsupported"
+	CheckError       = "error"
+)
+
+// Виды уведомлений для ObserveNotification.
+const (
+	NotificationUpdate = "update"
+	NotificationDigest = "digest"
+)
+
+var (
+	linkChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "link_checks_total",
+		Help:      "Проверки ссылок по источнику и исходу.",
+	}, []string{"source", "outcome"})
+
+	linkCheckDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "link_check_duration_seconds",
+		Help:      "Длительность проверки ссылки, включая ожидание лимита запросов к API.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"source"})
+
+	rateLimiterWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rate_limiter_wait_seconds",
+		Help:      "Время ожидания разрешения от лимита запросов к внешнему API.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 12),
+	}, []string{"client"})
+
+	notifications = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "notifications_total",
+		Help:      "Отправка уведомлений и сводок в bot по исходу.",
+	}, []string{"kind", "outcome"})
+
+	telegramErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "telegram_errors_total",
+		Help:      "Ошибки запросов к Telegram Bot API по методу.",
+	}, []string{"method"})
+
+	activeUsers = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_users",
+		Help:      "Пользователи, которые отслеживают хотя бы одну ссылку.",
+	})
+
+	trackedLinks = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tracked_links",
+		Help:      "Ссылки, которые отслеживает хотя бы один пользователь.",
+	})
+)
+
+// ObserveCheck учитывает проверку ссылки источника source с исходом outcome.
+func ObserveCheck(source, outcome string, duration time.Duration) {
+	linkChecks.WithLabelValues(source, outcome).Inc()
+	linkCheckDuration.WithLabelValues(source).Observe(duration.Seconds())
+}
+
+// ObserveRateLimiterWait учитывает время ожидания лимита запросов клиента client.
+func ObserveRateLimiterWait(client string, wait time.Duration) {
+	rateLimiterWait.WithLabelValues(client).Observe(wait.Seconds())
+}
+
+// ObserveNotification учитывает отправку уведомления вида kind, err == nil означает успех.
+func ObserveNotification(kind string, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+
+	notifications.WithLabelValues(kind, outcome).Inc()
+}
+
+// ObserveTelegramError учитывает ошибку запроса method к Telegram Bot API.
+func ObserveTelegramError(method string) {
+	telegramErrors.WithLabelValues(method).Inc()
+}
+
+// SetTrackingStats обновляет число активных пользователей и отслеживаемых ссылок.
+func SetTrackingStats(users, links int64) {
+	activeUsers.Set(float64(users))
+	trackedLinks.Set(float64(links))
+}
+
+// RegisterLinkUpdatesQueue регистрирует глубину очереди обнаруженных обновлений, которую возвращает depth.
+// Повторная регистрация заменяет прежнюю функцию.
+func RegisterLinkUpdatesQueue(depth func() int) {
+	gauge := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "link_updates_queue_depth",
+		Help:      "Обновления, которые ждут записи в историю и доставки.",
+	}, func() float64 { return float64(depth()) })
+
+	err := prometheus.Register(gauge)
+
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		prometheus.Unregister(registered.ExistingCollector)
+		err = prometheus.Register(gauge)
+	}
+
+	if err != nil {
+		slog.Error("Register link updates queue metric failed", "error", err.Error())
+	}
+}
@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"LinkTracker/internal/metrics"
+)
+
+func Test_ObserveNotification(t *testing.T) {
+	before := notificationsValue(t, metrics.NotificationDigest, "failure")
+
+	metrics.ObserveNotification(metrics.NotificationDigest, errors.New("bot unavailable"))
+	metrics.ObserveNotification(metrics.NotificationDigest, nil)
+
+	assert.InDelta(t, before+1, notificationsValue(t, metrics.NotificationDigest, "failure"), 0)
+}
+
+func Test_ObserveCheck(t *testing.T) {
+	metrics.ObserveCheck("github.com", metrics.CheckUpdated, 10*time.Millisecond)
+
+	count, err := testutil.GatherAndCount(prometheus.DefaultGatherer, "linktracker_link_checks_total")
+	assert.NoError(t, err)
+	assert.Positive(t, count)
+}
+
+func Test_RegisterLinkUpdatesQueue_Reregister(t *testing.T) {
+	metrics.RegisterLinkUpdatesQueue(func() int { return 1 })
+	metrics.RegisterLinkUpdatesQueue(func() int { return 7 })
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() == "linktracker_link_updates_queue_depth" {
+			assert.InDelta(t, 7, family.GetMetric()[0].GetGauge().GetValue(), 0)
+			return
+		}
+	}
+
+	t.Fatal("link updates queue metric not registered")
+}
+
+func notificationsValue(t *testing.T, kind, outcome string) float64 {
+	t.Helper()
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+
+	value := 0.0
+
+	for _, family := range families {
+		if family.GetName() != "linktracker_notifications_total" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["kind"] == kind && labels["outcome"] == outcome {
+				value = metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,106 @@
+// Package tracing настраивает OpenTelemetry: экспорт трейсов по OTLP, распространение контекста
+// между bot и scrapper через заголовки traceparent и спаны HTTP-серверов и клиентов.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "LinkTracker"
+
+// untracedPaths — служебные запросы сборщика метрик и проверок состояния, которые только засоряли бы трейсы.
+var untracedPaths = map[string]bool{
+	"/metrics": true,
+	"/healthz": true,
+	"/readyz":  true,
+}
+
+// Setup задаёт глобальные провайдер трейсов и пропагатор для сервиса serviceName.
+// Пропагатор задаётся всегда, чтобы контекст трейса проходил через сервис, даже если он сам трейсы не пишет.
+// При пустом endpoint экспорт выключен. sampleRatio - доля новых трейсов, которые попадут в экспорт;
+// 0 и значения больше 1 означают все трейсы. Возвращённую функцию нужно вызвать при остановке сервиса,
+// чтобы отправить накопленные спаны.
+func Setup(ctx context.Context, serviceName, endpoint string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("could not create otlp exporter: %w", err)
+	}
+
+	if sampleRatio <= 0 || sampleRatio > 1 {
+		sampleRatio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start начинает спан name, дочерний к спану из ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Finish завершает спан, отмечая его ошибкой, если err не nil.
+func Finish(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Handler оборачивает обработчик HTTP-сервера: извлекает контекст трейса из заголовков запроса
+// и называет спан шаблоном маршрута ServeMux, например "GET /links/{id}/updates". Служебные пути не трейсятся.
+func Handler(next http.Handler) http.Handler {
+	routed := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+
+		// ServeMux записывает шаблон маршрута в тот же запрос, который получил
+		if r.Pattern != "" {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Pattern)
+			span.SetAttributes(semconv.HTTPRoute(r.Pattern))
+		}
+	})
+
+	return otelhttp.NewHandler(routed, "http.server",
+		otelhttp.WithFilter(traced),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string { return r.Method }),
+	)
+}
+
+// Transport оборачивает транспорт HTTP-клиента: на каждый запрос создаётся спан,
+// а контекст трейса передаётся в заголовках запроса.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base, otelhttp.WithFilter(traced))
+}
+
+func traced(r *http.Request) bool {
+	return !untracedPaths[r.URL.Path]
+}
@@ -0,0 +1,80 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"LinkTracker/internal/tracing"
+)
+
+func Test_Tracing_PropagatesThroughHTTP(t *testing.T) {
+	ctx := context.Background()
+
+	shutdown, err := tracing.Setup(ctx, "test", "", 1)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(ctx))
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /links/{id}/updates", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(tracing.Handler(mux))
+	defer server.Close()
+
+	client := &http.Client{Transport: tracing.Transport(http.DefaultTransport)}
+
+	ctx, span := tracing.Start(ctx, "bot.handle_message")
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/links/7/updates", http.NoBody)
+	require.NoError(t, err)
+
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	var serverSpan *tracetest.SpanStub
+
+	for i := range spans {
+		if spans[i].Name == "GET /links/{id}/updates" {
+			serverSpan = &spans[i]
+		}
+
+		assert.Equal(t, span.SpanContext().TraceID(), spans[i].SpanContext.TraceID())
+	}
+
+	require.NotNil(t, serverSpan, "спан сервера не назван шаблоном маршрута")
+}
+
+func Test_Tracing_ServicePathsNotTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metrics", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	recorder := httptest.NewRecorder()
+	tracing.Handler(mux).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, exporter.GetSpans())
+}
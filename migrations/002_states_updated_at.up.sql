@@ -0,0 +1,5 @@
+-- Время последнего шага диалога: срок жизни состояния отсчитывается от него
+ALTER TABLE "states"
+    ADD COLUMN "updated_at" TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc');
+
+CREATE INDEX idx_states_updated_at ON states (updated_at);
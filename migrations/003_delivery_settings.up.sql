@@ -0,0 +1,49 @@
+CREATE TABLE "delivery_settings"
+(
+    "tg_id"       BIGINT  NOT NULL,
+    "mode"        TEXT    NOT NULL DEFAULT 'immediate',
+    "digest_time" INTEGER NOT NULL DEFAULT 540,
+    "timezone"    TEXT    NOT NULL DEFAULT 'UTC',
+    PRIMARY KEY ("tg_id")
+);
+
+CREATE TABLE "tag_delivery_modes"
+(
+    "tg_id" BIGINT NOT NULL,
+    "tag"   TEXT   NOT NULL,
+    "mode"  TEXT   NOT NULL,
+    PRIMARY KEY ("tg_id", "tag")
+);
+
+CREATE TABLE "pending_updates"
+(
+    "id"          BIGINT    NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "tg_id"       BIGINT    NOT NULL,
+    "url_id"      INTEGER   NOT NULL,
+    "url"         TEXT      NOT NULL,
+    "mode"        TEXT      NOT NULL,
+    "description" TEXT      NOT NULL DEFAULT '',
+    "kind"        TEXT      NOT NULL DEFAULT '',
+    "title"       TEXT      NOT NULL DEFAULT '',
+    "item_url"    TEXT      NOT NULL DEFAULT '',
+    "author"      TEXT      NOT NULL DEFAULT '',
+    "author_url"  TEXT      NOT NULL DEFAULT '',
+    "event_at"    TIMESTAMP,
+    "preview"     TEXT      NOT NULL DEFAULT '',
+    "created_at"  TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_pending_updates_tg_id_mode ON pending_updates (tg_id, mode, created_at);
+
+ALTER TABLE "delivery_settings"
+    ADD FOREIGN KEY ("tg_id") REFERENCES "users" ("tg_id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "tag_delivery_modes"
+    ADD FOREIGN KEY ("tg_id") REFERENCES "users" ("tg_id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "pending_updates"
+    ADD FOREIGN KEY ("tg_id") REFERENCES "users" ("tg_id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
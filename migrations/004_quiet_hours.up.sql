@@ -0,0 +1,3 @@
+ALTER TABLE "delivery_settings"
+    ADD COLUMN "quiet_start" INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN "quiet_end"   INTEGER NOT NULL DEFAULT 0;
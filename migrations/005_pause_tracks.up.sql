@@ -0,0 +1,5 @@
+ALTER TABLE "tracks"
+    ADD COLUMN "active"      BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN "muted_until" TIMESTAMP;
+
+CREATE INDEX idx_tracks_url_id ON tracks (url_id);
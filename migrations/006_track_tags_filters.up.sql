@@ -0,0 +1,43 @@
+CREATE TABLE "track_tags"
+(
+    "tg_id"    BIGINT  NOT NULL,
+    "url_id"   INTEGER NOT NULL,
+    "tag"      TEXT    NOT NULL,
+    "position" INTEGER NOT NULL,
+    PRIMARY KEY ("tg_id", "url_id", "tag")
+);
+
+CREATE INDEX idx_track_tags_tg_id_tag ON track_tags (tg_id, tag);
+
+CREATE TABLE "track_filters"
+(
+    "tg_id"    BIGINT  NOT NULL,
+    "url_id"   INTEGER NOT NULL,
+    "position" INTEGER NOT NULL,
+    "filter"   TEXT    NOT NULL,
+    PRIMARY KEY ("tg_id", "url_id", "position")
+);
+
+ALTER TABLE "track_tags"
+    ADD FOREIGN KEY ("tg_id", "url_id") REFERENCES "tracks" ("tg_id", "url_id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "track_filters"
+    ADD FOREIGN KEY ("tg_id", "url_id") REFERENCES "tracks" ("tg_id", "url_id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+-- Повторяющиеся теги одной ссылки схлопываются, порядок тегов и фильтров сохраняется
+INSERT INTO track_tags (tg_id, url_id, tag, position)
+SELECT t.tg_id, t.url_id, tt.value, MIN(tt.position)
+FROM tracks t, unnest(t.tags) WITH ORDINALITY AS tt(value, position)
+WHERE tt.value IS NOT NULL AND tt.value <> ''
+GROUP BY t.tg_id, t.url_id, tt.value;
+
+INSERT INTO track_filters (tg_id, url_id, position, filter)
+SELECT t.tg_id, t.url_id, tf.position, tf.value
+FROM tracks t, unnest(t.filters) WITH ORDINALITY AS tf(value, position)
+WHERE tf.value IS NOT NULL AND tf.value <> '';
+
+ALTER TABLE "tracks"
+    DROP COLUMN "filters",
+    DROP COLUMN "tags";
@@ -0,0 +1,21 @@
+CREATE TABLE "updates"
+(
+    "id"          BIGINT    NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "url_id"      INTEGER   NOT NULL,
+    "description" TEXT      NOT NULL DEFAULT '',
+    "kind"        TEXT      NOT NULL DEFAULT '',
+    "title"       TEXT      NOT NULL DEFAULT '',
+    "item_url"    TEXT      NOT NULL DEFAULT '',
+    "author"      TEXT      NOT NULL DEFAULT '',
+    "author_url"  TEXT      NOT NULL DEFAULT '',
+    "event_at"    TIMESTAMP,
+    "preview"     TEXT      NOT NULL DEFAULT '',
+    "detected_at" TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_updates_url_id_id ON updates (url_id, id DESC);
+
+ALTER TABLE "updates"
+    ADD FOREIGN KEY ("url_id") REFERENCES "urls" ("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,17 @@
+-- Адрес ссылки разбивается на слова, чтобы находить её по владельцу, репозиторию или номеру вопроса
+ALTER TABLE "urls"
+    ADD COLUMN "search_vector" TSVECTOR GENERATED ALWAYS AS
+        (to_tsvector('simple', translate("url", '/:.-_?=&#', '         '))) STORED;
+
+CREATE INDEX idx_urls_search_vector ON urls USING GIN (search_vector);
+
+-- Заголовок события весит больше автора, а автор больше текста описания
+ALTER TABLE "updates"
+    ADD COLUMN "search_vector" TSVECTOR GENERATED ALWAYS AS
+        (setweight(to_tsvector('simple', "title"), 'A') ||
+         setweight(to_tsvector('simple', "kind" || ' ' || "author"), 'B') ||
+         setweight(to_tsvector('simple', "description" || ' ' || "preview"), 'C')) STORED;
+
+CREATE INDEX idx_updates_search_vector ON updates USING GIN (search_vector);
+
+CREATE INDEX idx_track_tags_search ON track_tags USING GIN (to_tsvector('simple', "tag"));
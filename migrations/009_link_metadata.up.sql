@@ -0,0 +1,8 @@
+-- Метаданные источника обновляются при каждой проверке ссылки. Пустой статус означает,
+-- что источник ещё не проверялся
+ALTER TABLE "urls"
+    ADD COLUMN "title"       TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN "description" TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN "stars"       BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "answers"     BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "status"      TEXT   NOT NULL DEFAULT '';
@@ -0,0 +1,105 @@
+-- Адреса приводятся к тому же виду, что и domain.CanonicalURL: https, хост без www, для GitHub —
+-- владелец и репозиторий в нижнем регистре, для StackOverflow — только номер вопроса.
+-- Записи с одинаковым каноническим адресом объединяются в запись с наименьшим id
+CREATE TEMPORARY TABLE url_canonical AS
+SELECT c.id,
+       c.canonical,
+       MIN(c.id) OVER (PARTITION BY c.canonical)          AS keeper_id,
+       MAX(c.last_update) OVER (PARTITION BY c.canonical) AS last_update
+FROM (SELECT u.id,
+             u.last_update,
+             CASE
+                 WHEN u.url ~* '^https?://(www\.)?github\.com/[^/?#]+/[^/?#]+'
+                     THEN 'https://github.com/' ||
+                          lower(substring(u.url FROM '(?i)^https?://(?:www\.)?github\.com/([^/?#]+)')) || '/' ||
+                          regexp_replace(
+                                  lower(substring(u.url FROM '(?i)^https?://(?:www\.)?github\.com/[^/?#]+/([^/?#]+)')),
+                                  '\.git$', '')
+                 WHEN u.url ~* '^https?://(www\.)?stackoverflow\.com/(questions|q)/[0-9]+'
+                     THEN 'https://stackoverflow.com/questions/' ||
+                          substring(u.url FROM '(?i)^https?://(?:www\.)?stackoverflow\.com/(?:questions|q)/([0-9]+)')
+                 ELSE u.url
+                 END AS canonical
+      FROM urls u) c;
+
+-- Подписчики дубликатов, ещё не подписанные на основную запись. Если пользователь подписан
+-- на несколько дубликатов, трек основной записи получает состояние паузы первого из них
+CREATE TEMPORARY TABLE moved_tracks AS
+SELECT DISTINCT ON (t.tg_id, c.keeper_id) t.tg_id, t.url_id, c.keeper_id
+FROM tracks t
+         JOIN url_canonical c ON c.id = t.url_id
+WHERE c.id <> c.keeper_id
+  AND NOT EXISTS (SELECT 1 FROM tracks k WHERE k.tg_id = t.tg_id AND k.url_id = c.keeper_id)
+ORDER BY t.tg_id, c.keeper_id, t.url_id;
+
+INSERT INTO tracks (tg_id, url_id, active, muted_until)
+SELECT m.tg_id, m.keeper_id, t.active, t.muted_until
+FROM moved_tracks m
+         JOIN tracks t ON t.tg_id = m.tg_id AND t.url_id = m.url_id;
+
+-- Теги и фильтры всех дубликатов добавляются к треку основной записи после его собственных.
+-- Совпадающие теги и фильтры не повторяются, порядок внутри дубликата сохраняется
+INSERT INTO track_tags (tg_id, url_id, tag, position)
+SELECT d.tg_id,
+       d.keeper_id,
+       d.tag,
+       COALESCE((SELECT MAX(k.position) FROM track_tags k WHERE k.tg_id = d.tg_id AND k.url_id = d.keeper_id), 0) +
+       ROW_NUMBER() OVER (PARTITION BY d.tg_id, d.keeper_id ORDER BY d.url_id, d.position)
+FROM (SELECT DISTINCT ON (tt.tg_id, c.keeper_id, tt.tag) tt.tg_id, c.keeper_id, tt.url_id, tt.tag, tt.position
+      FROM track_tags tt
+               JOIN url_canonical c ON c.id = tt.url_id
+      WHERE c.id <> c.keeper_id
+      ORDER BY tt.tg_id, c.keeper_id, tt.tag, tt.url_id, tt.position) d
+WHERE NOT EXISTS (SELECT 1 FROM track_tags k WHERE k.tg_id = d.tg_id AND k.url_id = d.keeper_id AND k.tag = d.tag)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO track_filters (tg_id, url_id, position, filter)
+SELECT d.tg_id,
+       d.keeper_id,
+       COALESCE((SELECT MAX(k.position) FROM track_filters k WHERE k.tg_id = d.tg_id AND k.url_id = d.keeper_id), 0) +
+       ROW_NUMBER() OVER (PARTITION BY d.tg_id, d.keeper_id ORDER BY d.url_id, d.position),
+       d.filter
+FROM (SELECT DISTINCT ON (tf.tg_id, c.keeper_id, tf.filter) tf.tg_id, c.keeper_id, tf.url_id, tf.position, tf.filter
+      FROM track_filters tf
+               JOIN url_canonical c ON c.id = tf.url_id
+      WHERE c.id <> c.keeper_id
+      ORDER BY tf.tg_id, c.keeper_id, tf.filter, tf.url_id, tf.position) d
+WHERE NOT EXISTS (SELECT 1
+                  FROM track_filters k
+                  WHERE k.tg_id = d.tg_id AND k.url_id = d.keeper_id AND k.filter = d.filter)
+ON CONFLICT DO NOTHING;
+
+UPDATE updates u
+SET url_id = c.keeper_id
+FROM url_canonical c
+WHERE u.url_id = c.id
+  AND c.id <> c.keeper_id;
+
+UPDATE pending_updates p
+SET url_id = c.keeper_id,
+    url    = c.canonical
+FROM url_canonical c
+WHERE p.url_id = c.id
+  AND (c.id <> c.keeper_id OR p.url <> c.canonical);
+
+DELETE
+FROM tracks t
+    USING url_canonical c
+WHERE t.url_id = c.id
+  AND c.id <> c.keeper_id;
+
+DELETE
+FROM urls u
+    USING url_canonical c
+WHERE u.id = c.id
+  AND c.id <> c.keeper_id;
+
+UPDATE urls u
+SET url         = c.canonical,
+    last_update = c.last_update
+FROM url_canonical c
+WHERE u.id = c.id
+  AND (u.url <> c.canonical OR u.last_update <> c.last_update);
+
+DROP TABLE moved_tracks;
+DROP TABLE url_canonical;
@@ -0,0 +1,8 @@
+-- Результат последней проверки ссылки. last_checked_at пуст, пока ссылка не проверялась,
+-- consecutive_failures сбрасывается в 0 при первой успешной проверке
+ALTER TABLE "urls"
+    ADD COLUMN "last_checked_at"      TIMESTAMP,
+    ADD COLUMN "last_error"           TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN "consecutive_failures" BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX "idx_urls_consecutive_failures" ON "urls" ("consecutive_failures") WHERE "consecutive_failures" > 0;
@@ -0,0 +1,14 @@
+-- Персональные токены публичного API. Хранится только SHA-256 токена: сам токен показывается
+-- пользователю один раз, а новый выпуск заменяет старый
+CREATE TABLE "api_tokens"
+(
+    "tg_id"      BIGINT    NOT NULL,
+    "token_hash" TEXT      NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc'),
+    PRIMARY KEY ("tg_id"),
+    UNIQUE ("token_hash")
+);
+
+ALTER TABLE "api_tokens"
+    ADD FOREIGN KEY ("tg_id") REFERENCES "users" ("tg_id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+-- Участник, начавший диалог. В группе ответы других участников не продолжают чужой диалог
+ALTER TABLE "states"
+    ADD COLUMN "user_id" BIGINT NOT NULL DEFAULT 0;